@@ -12,8 +12,17 @@ base_dir=$(dirname "$loc")
 spec_dir="$base_dir/dropbox-api-spec"
 gen_dir=$(dirname ${base_dir})/v$version/dropbox
 
+# go_types.stoneg.py emits the setters and validation of the route arguments
+# into types.go, replacing the args.go files maintained by hand until then.
+rm -f "$gen_dir"/*/args.go
 stone -v -a :all go_types.stoneg.py "$gen_dir" "$spec_dir"/*.stone
-stone -v -a :all go_client.stoneg.py "$gen_dir" "$spec_dir"/*.stone
+# CONTEXT_ONLY=1 leaves the methods without a context out of the Client
+# interfaces, keeping them as deprecated shims.
+client_args=()
+if [[ -n "${CONTEXT_ONLY:-}" ]]; then
+    client_args=(-- --context-only)
+fi
+stone -v -a :all go_client.stoneg.py "$gen_dir" "$spec_dir"/*.stone ${client_args[@]+"${client_args[@]}"}
 
 # Update SDK and API spec versions
 sdk_version=${1}
@@ -1,3 +1,4 @@
+import argparse
 import os
 
 from stone.backend import CodeBackend
@@ -8,17 +9,31 @@ from stone.ir import (
 
 from go_helpers import (
     HEADER,
+    find_field,
     fmt_type,
     fmt_var,
     generate_doc,
 )
 
+_cmdline_parser = argparse.ArgumentParser(prog='go-client-backend')
+_cmdline_parser.add_argument(
+    '--context-only',
+    action='store_true',
+    help='Only list the Context methods of routes in the Client interfaces. '
+         'The methods without a context are kept as deprecated shims.',
+)
+
 
 class GoClientBackend(CodeBackend):
+    cmdline_parser = _cmdline_parser
+
     def generate(self, api):
         for namespace in api.namespaces.values():
             if len(namespace.routes) > 0:
                 self._generate_client(namespace)
+                self._generate_iterators(namespace)
+                self._generate_scopes(namespace)
+                self._generate_mock(namespace)
 
     def _generate_client(self, namespace):
         file_name = os.path.join(self.target_folder_path, namespace.name,
@@ -33,7 +48,8 @@ class GoClientBackend(CodeBackend):
             with self.block('type Client interface'):
                 for route in namespace.routes:
                     generate_doc(self, route)
-                    self.emit(self._generate_route_signature(namespace, route))
+                    if not self.args.context_only:
+                        self.emit(self._generate_route_signature(namespace, route))
                     self.emit(self._generate_route_signature_context(namespace, route))
             self.emit()
 
@@ -97,16 +113,21 @@ class GoClientBackend(CodeBackend):
             out('EndpointError {err} `json:"error"`'.format(err=err))
         out()
 
+        out('// Unwrap returns the dropbox.APIError of the error')
+        with self.block('func (e {fn}APIError) Unwrap() error'.format(fn=fn)):
+            out('return e.APIError')
+        out()
+
         signature_context = 'func (dbx *apiImpl) ' + self._generate_route_signature_context(
             namespace, route)
         with self.block(signature_context):
             if route.deprecated is not None:
-                out('log.Printf("WARNING: API `%s` is deprecated")' % fn)
+                replacement_fn = ''
                 if route.deprecated.by is not None:
                     replacement_fn = fmt_var(route.deprecated.by.name)
                     if route.deprecated.by.version != 1:
                         replacement_fn += "V%d" % route.deprecated.by.version
-                    out('log.Printf("Use API `%s` instead")' % replacement_fn)
+                out('(*dropbox.Context)(dbx).WarnDeprecated("%s", "%s")' % (fn, replacement_fn))
                 out()
 
             args = {
@@ -123,7 +144,7 @@ class GoClientBackend(CodeBackend):
 
                 out("Arg: {arg},".format(arg="arg" if not is_void_type(route.arg_data_type) else "nil"))
                 out("ExtraHeaders: {headers},".format(
-                    headers="arg.ExtraHeaders" if fmt_var(route.name) == "Download" else "nil"))
+                    headers="arg.ExtraHeaders" if fmt_var(route.name) in ("Download", "GetSharedLinkFile") else "nil"))
             out()
 
             out("var resp []byte")
@@ -163,6 +184,7 @@ class GoClientBackend(CodeBackend):
             out('return')
         out()
 
+        self._generate_deprecated_shim_doc(fn)
         signature = 'func (dbx *apiImpl) ' + self._generate_route_signature(
                     namespace, route)
         with self.block(signature):
@@ -173,3 +195,213 @@ class GoClientBackend(CodeBackend):
                 args.append('content')
             out('return dbx.' + fn + 'Context(' + ", ".join(args) + ');')
         out('')
+
+    def _generate_deprecated_shim_doc(self, fn):
+        if self.args.context_only:
+            self.emit('// {fn} calls {fn}Context with context.Background().'.format(fn=fn))
+            self.emit('//')
+            self.emit('// Deprecated: Use {fn}Context instead.'.format(fn=fn))
+
+    def _route_call_args(self, route):
+        args = []
+        if not is_void_type(route.arg_data_type):
+            args.append('arg')
+        if route.attrs.get('style', 'rpc') == 'upload':
+            args.append('content')
+        return args
+
+    def _generate_mock(self, namespace):
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'mock.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            self.emit()
+
+            self.emit('// Mock is a Client for tests. Each route calls the function field of the')
+            self.emit('// same name with a Func suffix, for both the Context and the plain method;')
+            self.emit('// routes whose field is nil return `dropbox.ErrNotMocked`.')
+            with self.block('type Mock struct'):
+                for route in namespace.routes:
+                    sig = self._generate_route_signature_context(namespace, route)
+                    fn = sig[:sig.index('Context(')]
+                    self.emit('{fn}Func func{params}'.format(
+                        fn=fn, params=sig[len(fn + 'Context'):]))
+            self.emit()
+            self.emit('var _ Client = (*Mock)(nil)')
+            self.emit()
+
+            for route in namespace.routes:
+                sig = self._generate_route_signature_context(namespace, route)
+                fn = sig[:sig.index('Context(')]
+                args = self._route_call_args(route)
+                with self.block('func (m *Mock) ' + sig):
+                    with self.block('if m.%sFunc == nil' % fn):
+                        self.emit('err = dropbox.ErrNotMocked')
+                        self.emit('return')
+                    self.emit('return m.{fn}Func({args})'.format(
+                        fn=fn, args=', '.join(['ctx'] + args)))
+                self.emit()
+                self._generate_deprecated_shim_doc(fn)
+                with self.block('func (m *Mock) ' + self._generate_route_signature(namespace, route)):
+                    self.emit('return m.{fn}Context({args})'.format(
+                        fn=fn, args=', '.join(['context.Background()'] + args)))
+                self.emit()
+
+    def _paginated_routes(self, namespace):
+        """Returns (route, continue_route) pairs for all routes returning a
+        cursor. Routes that take the cursor in their own argument continue
+        with themselves."""
+        pages = []
+        for route in namespace.routes:
+            if route.name.endswith(('/continue', '_continue')):
+                continue
+            res = route.result_data_type
+            if not is_struct_type(res) or find_field(res, 'cursor') is None:
+                continue
+            cont = None
+            for r in namespace.routes:
+                if r.name in (route.name + '/continue', route.name + '_continue') and \
+                        r.result_data_type == res:
+                    cont = r
+            if cont is None and is_struct_type(route.arg_data_type) and \
+                    find_field(route.arg_data_type, 'cursor') is not None:
+                cont = route
+            if cont is not None:
+                pages.append((route, cont))
+        return pages
+
+    def _generate_scopes(self, namespace):
+        routes = [r for r in namespace.routes if r.attrs.get('scope')]
+        if len(routes) == 0:
+            return
+
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'scopes.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            self.emit()
+            self.emit('// RequiredScopes maps the routes of this namespace, as in')
+            self.emit('// dropbox.Request.Route, to the scope an access token needs to call them.')
+            with self.block('var RequiredScopes = map[string]string'):
+                for route in routes:
+                    route_name = route.name
+                    if route.version != 1:
+                        route_name += '_v%d' % route.version
+                    self.emit('"%s": "%s",' % (route_name, route.attrs['scope']))
+
+    def _generate_iterators(self, namespace):
+        pages = self._paginated_routes(namespace)
+        if len(pages) == 0:
+            return
+
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'iterators.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            self.emit()
+            for route, cont in pages:
+                self._generate_iterator(namespace, route, cont)
+
+    def _generate_iterator(self, namespace, route, cont):
+        out = self.emit
+
+        fn = fmt_var(route.name)
+        if route.version != 1:
+            fn += 'V%d' % route.version
+        cont_fn = fmt_var(cont.name)
+        if cont.version != 1:
+            cont_fn += 'V%d' % cont.version
+        it = fn + 'Iterator'
+        arg = fmt_type(route.arg_data_type, namespace)
+        res = fmt_type(route.result_data_type, namespace)
+
+        if cont is route:
+            out('// {it} iterates over the pages returned by `{fn}`.'.format(it=it, fn=fn))
+        else:
+            out('// {it} iterates over the pages returned by `{fn}` and'.format(it=it, fn=fn))
+            out('// `{cont_fn}`.'.format(cont_fn=cont_fn))
+        with self.block('type %s struct' % it):
+            out('client Client')
+            out('arg %s' % arg)
+            out('cursor string')
+            out('started bool')
+            out('hasMore bool')
+        out()
+
+        arg_type = route.arg_data_type
+        out('// New{it} returns a new {it} instance.'.format(it=it))
+        if arg_type.all_required_fields:
+            out('// arg is required, as `{fn}` has required arguments.'.format(fn=fn))
+        else:
+            out('// A nil arg lists with the default arguments.')
+        with self.block('func New{it}(client Client, arg {arg}) *{it}'.format(
+                it=it, arg=arg)):
+            with self.block('if arg == nil'):
+                if arg_type.all_required_fields:
+                    out('panic("%s: New%s called with a nil arg")' % (namespace.name, it))
+                else:
+                    out('arg = New%s()' % arg_type.name)
+            out('return &%s{client: client, arg: arg}' % it)
+        out()
+
+        out('// HasMore returns false once the last page has been fetched')
+        with self.block('func (it *%s) HasMore() bool' % it):
+            out('return !it.started || it.hasMore')
+        out()
+
+        out('// Cursor returns the cursor of the last page fetched')
+        with self.block('func (it *%s) Cursor() string' % it):
+            out('return it.cursor')
+        out()
+
+        out('// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once')
+        out('// all pages have been fetched.')
+        with self.block('func (it *{it}) Next(ctx context.Context) (res {res}, err error)'.format(
+                it=it, res=res)):
+            with self.block('if !it.HasMore()'):
+                out('err = dropbox.ErrNoMorePages')
+                out('return')
+            if cont is route:
+                out('arg := *it.arg')
+                with self.block('if it.started'):
+                    out('arg.Cursor = it.cursor')
+                out('res, err = it.client.%sContext(ctx, &arg)' % fn)
+            else:
+                cont_arg = cont.arg_data_type
+                params = []
+                for field in cont_arg.all_required_fields:
+                    if field.name == 'cursor':
+                        params.append('it.cursor')
+                    else:
+                        params.append('it.arg.%s' % fmt_var(field.name))
+                with self.block('switch'):
+                    with self.block('case !it.started:', delim=(None, None)):
+                        out('res, err = it.client.%sContext(ctx, it.arg)' % fn)
+                    with self.block('default:', delim=(None, None)):
+                        out('arg := New%s(%s)' % (cont_arg.name, ', '.join(params)))
+                        if find_field(cont_arg, 'cursor') not in cont_arg.all_required_fields:
+                            out('arg.Cursor = it.cursor')
+                        out('res, err = it.client.%sContext(ctx, arg)' % cont_fn)
+            with self.block('if err != nil'):
+                out('return')
+            out('it.started = true')
+            res_type = route.result_data_type
+            cursor = find_field(res_type, 'cursor')
+            if is_struct_type(cursor.data_type):
+                out('it.cursor = ""')
+                with self.block('if res.Cursor != nil'):
+                    out('it.cursor = res.Cursor.Value')
+            else:
+                out('it.cursor = res.Cursor')
+            if find_field(res_type, 'has_more') is not None:
+                out('it.hasMore = res.HasMore')
+            else:
+                out('it.hasMore = it.cursor != ""')
+            out('return')
+        out()
@@ -161,3 +161,11 @@ def needs_base_type(struct):
         if _needs_base_type(field.data_type):
             return True
     return False
+
+
+def find_field(struct, name):
+    data_type, _ = unwrap_nullable(struct)
+    for field in data_type.all_fields:
+        if field.name == name:
+            return field
+    return None
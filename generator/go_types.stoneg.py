@@ -1,4 +1,5 @@
 import os
+import re
 import shutil
 
 from stone.backend import CodeBackend
@@ -6,11 +7,13 @@ from stone.ir import (
     is_boolean_type,
     is_list_type,
     is_nullable_type,
+    is_numeric_type,
     is_primitive_type,
     is_string_type,
     is_struct_type,
     is_union_type,
     is_void_type,
+    unwrap_nullable,
 )
 
 from go_helpers import (
@@ -28,6 +31,11 @@ class GoTypesBackend(CodeBackend):
         rsrc_folder = os.path.join(os.path.dirname(__file__), 'go_rsrc')
         shutil.copy(os.path.join(rsrc_folder, 'sdk.go'),
                     self.target_folder_path)
+        # The arguments of routes get setters and client-side validation.
+        self._route_args = set()
+        for namespace in api.namespaces.values():
+            for route in namespace.routes:
+                self._route_args.add(route.arg_data_type)
         for namespace in api.namespaces.values():
             self._generate_namespace(namespace)
 
@@ -77,8 +85,29 @@ class GoTypesBackend(CodeBackend):
                 for field in fields:
                     with self.block('case "%s":' % field.name, delim=(None, None)):
                         self.emit("return t.{0}, nil".format(fmt_var(field.name)))
-            # FIX THIS
-            self.emit("return nil, nil")
+            with self.block('if t.Tag == "" && string(bytes.TrimSpace(data)) == "null"'):
+                self.emit("return nil, nil")
+            self.emit("unknown := &Unknown{0}{{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}}".format(t))
+            with self.block("if err := json.Unmarshal(data, &unknown.{0}); err != nil".format(t)):
+                self.emit("return nil, err")
+            self.emit("return unknown, nil")
+        self.emit()
+
+        self.emit("// Unknown{0} is a {0} whose tag is unknown to this version of the".format(t))
+        self.emit("// SDK, e.g. a subtype added to the API since, as returned by")
+        self.emit("// Is{0}FromJSON. It keeps the common fields along with the raw JSON.".format(t))
+        with self.block("type Unknown%s struct" % t):
+            self.emit(t)
+            self.emit("// Tag is the unknown `.tag` of the {0}.".format(t))
+            self.emit("Tag string")
+            self.emit("// Raw is the JSON the {0} was decoded from.".format(t))
+            self.emit("Raw json.RawMessage")
+        self.emit()
+        self.emit("// MarshalJSON returns Raw, or the common fields if Raw is empty")
+        with self.block("func (u Unknown%s) MarshalJSON() ([]byte, error)" % t):
+            with self.block("if len(u.Raw) > 0"):
+                self.emit("return u.Raw, nil")
+            self.emit("return json.Marshal(u.%s)" % t)
 
     def _generate_struct(self, struct):
         with self.block('type %s struct' % struct.name):
@@ -86,11 +115,14 @@ class GoTypesBackend(CodeBackend):
                 self.emit(fmt_type(struct.parent_type, struct.namespace).lstrip('*'))
             for field in struct.fields:
                 self._generate_field(field, namespace=struct.namespace)
-            if struct.name in ('DownloadArg',):
+            if struct.name in ('DownloadArg', 'GetSharedLinkMetadataArg'):
                 self.emit('// ExtraHeaders can be used to pass Range, If-None-Match headers')
                 self.emit('ExtraHeaders map[string]string `json:"-"`')
         self._generate_struct_builder(struct)
         self.emit()
+        if struct in self._route_args:
+            self._generate_struct_setters(struct)
+            self._generate_struct_validate(struct)
         if needs_base_type(struct):
             self.emit('// UnmarshalJSON deserializes into a %s instance' % struct.name)
             with self.block('func (u *%s) UnmarshalJSON(b []byte) error' % struct.name):
@@ -154,6 +186,38 @@ class GoTypesBackend(CodeBackend):
             self.emit('return s')
         self.emit()
 
+    def _generate_struct_setters(self, struct):
+        for field in struct.all_optional_fields:
+            field_name = fmt_var(field.name)
+            type_name = fmt_type(field.data_type, struct.namespace, use_interface=True)
+            self.emit('// With{0} sets {0} and returns u'.format(field_name))
+            with self.block('func (u *{0}) With{1}({1} {2}) *{0}'.format(
+                    struct.name, field_name, type_name)):
+                self.emit('u.{0} = {0}'.format(field_name))
+                self.emit('return u')
+            self.emit()
+
+    def _generate_struct_validate(self, struct):
+        fields = [_field_checks(field) for field in struct.all_fields]
+        fields = [(cond, checks) for cond, checks in fields if checks]
+        if not fields:
+            return
+        self.emit('// Validate checks u against the constraints of the API spec')
+        with self.block('func (u *%s) Validate() error' % struct.name):
+            for cond, checks in fields:
+                if cond:
+                    with self.block('if %s' % cond):
+                        self._generate_checks(checks)
+                else:
+                    self._generate_checks(checks)
+            self.emit('return nil')
+        self.emit()
+
+    def _generate_checks(self, checks):
+        for check in checks:
+            with self.block('if err := %s; err != nil' % check):
+                self.emit('return err')
+
     def _generate_field(self, field, union_field=False, namespace=None, raw=False):
         generate_doc(self, field)
         field_name = fmt_var(field.name)
@@ -196,22 +260,28 @@ class GoTypesBackend(CodeBackend):
             return
 
         self.emit('// UnmarshalJSON deserializes into a %s instance' % name)
+        # pure structures are flattened in the containing union json blob and thus are loaded from body
+        wrap_fields = [f for f in fields if not is_void_type(f.data_type) and not (
+            is_struct_type(f.data_type) and not _needs_base_type(f.data_type))]
         with self.block('func (u *%s) UnmarshalJSON(body []byte) error' % name):
-            with self.block('type wrap struct'):
-                self.emit('dropbox.Tagged')
-                for field in fields:
-                    if is_void_type(field.data_type) or (
-                            is_struct_type(field.data_type) and not _needs_base_type(field.data_type)):
-                        # pure structures are flattened in the containing union json blob and thus are loaded from body
-                        continue
-                    # sub-unions must be handled as RawMessage, which will be loaded into correct implementation later
-                    self._generate_field(field, union_field=True,
-                                         namespace=namespace, raw=_needs_base_type(field.data_type))
-            self.emit('var w wrap')
-            self.emit('var err error')
-            with self.block('if err = json.Unmarshal(body, &w); err != nil'):
-                self.emit('return err')
-            self.emit('u.Tag = w.Tag')
+            if not wrap_fields:
+                # only the tag is needed ahead of decoding the member, which
+                # dropbox.DecodeTag scans without reflection
+                self.emit('var err error')
+                with self.block('if u.Tag, err = dropbox.DecodeTag(body); err != nil'):
+                    self.emit('return err')
+            else:
+                with self.block('type wrap struct'):
+                    self.emit('dropbox.Tagged')
+                    for field in wrap_fields:
+                        # sub-unions must be handled as RawMessage, which will be loaded into correct implementation later
+                        self._generate_field(field, union_field=True,
+                                             namespace=namespace, raw=_needs_base_type(field.data_type))
+                self.emit('var w wrap')
+                self.emit('var err error')
+                with self.block('if err = json.Unmarshal(body, &w); err != nil'):
+                    self.emit('return err')
+                self.emit('u.Tag = w.Tag')
             with self.block('switch u.Tag'):
                 for field in fields:
                     if is_void_type(field.data_type):
@@ -230,3 +300,55 @@ class GoTypesBackend(CodeBackend):
                             self.emit('u.{0} = w.{0}'.format(field_name))
             self.emit('return nil')
         self.emit()
+
+
+def _go_string(s):
+    if '`' in s:
+        return '"%s"' % s.replace('\\', '\\\\').replace('"', '\\"')
+    return '`%s`' % s
+
+
+def _bound(v):
+    return -1 if v is None else v
+
+
+# Constructs of the Python patterns of the spec that Go's RE2 doesn't
+# support.
+_NOT_RE2 = re.compile(r'\(\?(?:[=!>]|<[=!])|\\[1-9]')
+
+
+def _check_re2(field, pattern):
+    """Fails the generation for a pattern that dropbox.ValidateString can't
+    compile, rather than emitting a check failing every request."""
+    m = _NOT_RE2.search(pattern)
+    if m:
+        raise ValueError('pattern of %s uses %r, which RE2 does not support: %s'
+                         % (field.name, m.group(0), pattern))
+
+
+def _field_checks(field):
+    """Returns the checks validating field, and the condition skipping them
+    when field is nullable and unset."""
+    data_type, nullable = unwrap_nullable(field.data_type)
+    value = 'u.%s' % fmt_var(field.name)
+    cond, checks = None, []
+    if is_string_type(data_type):
+        if data_type.pattern:
+            _check_re2(field, data_type.pattern)
+        if data_type.min_length is not None or data_type.max_length is not None or data_type.pattern:
+            checks.append('dropbox.ValidateString("%s", %s, %d, %d, %s)' % (
+                field.name, value, _bound(data_type.min_length),
+                _bound(data_type.max_length), _go_string(data_type.pattern or '')))
+        cond = '%s != ""' % value
+    elif is_numeric_type(data_type):
+        if data_type.min_value is not None:
+            checks.append('dropbox.ValidateMin("%s", %s, %s)' % (field.name, value, data_type.min_value))
+        if data_type.max_value is not None:
+            checks.append('dropbox.ValidateMax("%s", %s, %s)' % (field.name, value, data_type.max_value))
+        cond = '%s != 0' % value
+    elif is_list_type(data_type):
+        if data_type.min_items is not None or data_type.max_items is not None:
+            checks.append('dropbox.ValidateItems("%s", len(%s), %d, %d)' % (
+                field.name, value, _bound(data_type.min_items), _bound(data_type.max_items)))
+        cond = '%s != nil' % value
+    return (cond if nullable else None), checks
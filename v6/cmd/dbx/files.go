@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+var (
+	lsFlags     = flag.NewFlagSet("ls", flag.ContinueOnError)
+	lsRecursive = lsFlags.Bool("r", false, "list recursively")
+
+	getFlags = flag.NewFlagSet("get", flag.ContinueOnError)
+
+	putFlags     = flag.NewFlagSet("put", flag.ContinueOnError)
+	putOverwrite = putFlags.Bool("f", false, "overwrite an existing file")
+	putChunkSize = putFlags.Int64("chunk", files.DefaultUploadChunkSize, "size of the chunks of large uploads, in bytes")
+	putParallel  = putFlags.Int("parallel", 4, "number of chunks uploaded in parallel")
+)
+
+var lsCommand = &command{
+	usage: "ls [-r] [path]",
+	help:  "list a folder",
+	flags: lsFlags,
+	run:   runLs,
+}
+
+var getCommand = &command{
+	usage: "get path [file]",
+	help:  "download a file",
+	flags: getFlags,
+	run:   runGet,
+}
+
+var putCommand = &command{
+	usage: "put [-f] [-chunk size] [-parallel n] file path",
+	help:  "upload a file, in chunks if large",
+	flags: putFlags,
+	run:   runPut,
+}
+
+// newFilesClient returns the client of the files commands, a fake in the
+// tests.
+var newFilesClient = files.New
+
+func filesClient() (files.Client, error) {
+	config, err := config(false)
+	if err != nil {
+		return nil, err
+	}
+	return newFilesClient(config), nil
+}
+
+// runLs lists the entries of a folder with a files.ListFolderIterator.
+func runLs(ctx context.Context, args []string) error {
+	if len(args) > 1 {
+		return errUsage
+	}
+	dbx, err := filesClient()
+	if err != nil {
+		return err
+	}
+	root := ""
+	if len(args) == 1 && args[0] != "/" {
+		root = args[0]
+	}
+	w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	defer w.Flush()
+	it := files.NewListFolderIterator(dbx, files.NewListFolderArg(root).WithRecursive(*lsRecursive))
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, entry := range res.Entries {
+			switch e := entry.(type) {
+			case *files.FileMetadata:
+				fmt.Fprintf(w, "%d\t%s\t %s\n", e.Size, e.ServerModified.Local().Format(time.Stamp), e.PathDisplay)
+			case *files.FolderMetadata:
+				fmt.Fprintf(w, "-\t-\t %s/\n", e.PathDisplay)
+			}
+		}
+	}
+	return nil
+}
+
+// runGet downloads a file with a files.Downloader, which resumes the
+// transfer if it is interrupted.
+func runGet(ctx context.Context, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errUsage
+	}
+	dbx, err := filesClient()
+	if err != nil {
+		return err
+	}
+	name := path.Base(args[0])
+	if len(args) == 2 {
+		name = args[1]
+	}
+	ctx = dropbox.WithProgress(ctx, printProgress)
+	_, report, err := files.NewDownloader(dbx).DownloadFile(ctx, args[0], name)
+	fmt.Fprintln(stderr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "%s: %d bytes in %v\n", name, report.Bytes, report.Duration.Round(time.Millisecond))
+	return nil
+}
+
+// runPut uploads a file with a files.Uploader, which uses an upload session
+// with chunks sent in parallel for large files.
+func runPut(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	dbx, err := filesClient()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	commit := files.NewCommitInfo(args[1])
+	if *putOverwrite {
+		commit.Mode = &files.WriteMode{Tagged: dropbox.Tagged{Tag: files.WriteModeOverwrite}}
+	}
+	u := files.NewUploader(dbx)
+	u.ChunkSize = *putChunkSize
+	u.Concurrency = *putParallel
+	ctx = dropbox.WithProgress(ctx, printProgress)
+	res, report, err := u.Upload(ctx, f, info.Size(), commit)
+	fmt.Fprintln(stderr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "%s: %d bytes in %d chunks, %v\n", res.PathDisplay, report.Bytes, report.Chunks, report.Duration.Round(time.Millisecond))
+	return nil
+}
+
+func printProgress(p dropbox.Progress) {
+	if p.Total > 0 {
+		fmt.Fprintf(stderr, "\r%d/%d bytes (%.0f%%)", p.Bytes, p.Total, float64(p.Bytes)*100/float64(p.Total))
+	} else {
+		fmt.Fprintf(stderr, "\r%d bytes", p.Bytes)
+	}
+}
@@ -0,0 +1,140 @@
+// Command dbx is a command line client for Dropbox built on the SDK. It is
+// meant as much as a usable tool as an example of the high-level helpers of
+// the SDK: every command is a few lines around one of them.
+//
+// The access token is read from the DROPBOX_TOKEN environment variable, or
+// DROPBOX_TEAM_TOKEN for the team commands.
+//
+//	dbx ls -r /Photos
+//	dbx put backup.tar /Backups/backup.tar
+//	dbx get /Backups/backup.tar
+//	dbx link /Backups/backup.tar
+//	dbx share /Project alice@example.com
+//	dbx members add bob@example.com
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// command is a subcommand of dbx.
+type command struct {
+	usage string
+	help  string
+	flags *flag.FlagSet
+	run   func(ctx context.Context, args []string) error
+}
+
+var commands = map[string]*command{
+	"ls":      lsCommand,
+	"get":     getCommand,
+	"put":     putCommand,
+	"link":    linkCommand,
+	"share":   shareCommand,
+	"members": membersCommand,
+}
+
+// errUsage is returned by the commands called with the wrong arguments.
+var errUsage = errors.New("invalid arguments")
+
+// errUnknownCommand is returned by dispatch for a command that doesn't exist.
+var errUnknownCommand = errors.New("unknown command")
+
+// stdout and stderr are the outputs of the commands, replaced by the tests.
+var stdout, stderr io.Writer = os.Stdout, os.Stderr
+
+var verbose = flag.Bool("v", false, "log requests")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: dbx [-v] command [arguments]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n    \t%s\n", commands[name].usage, commands[name].help)
+	}
+	os.Exit(2)
+}
+
+// config returns the configuration of the clients, with the user token or
+// the team token.
+func config(team bool) (dropbox.Config, error) {
+	name := "DROPBOX_TOKEN"
+	if team {
+		name = "DROPBOX_TEAM_TOKEN"
+	}
+	token := os.Getenv(name)
+	if token == "" {
+		return dropbox.Config{}, fmt.Errorf("%s is not set", name)
+	}
+	config := dropbox.Config{
+		Token:       token,
+		RetryPolicy: &dropbox.RetryPolicy{MaxAttempts: 5, Jitter: dropbox.JitterFull},
+	}
+	if *verbose {
+		config.LogLevel = dropbox.LogInfo
+	}
+	return config, nil
+}
+
+// dispatch parses the flags of the command name and runs it with the
+// remaining arguments. If they are invalid, it prints the usage of the
+// command and returns errUsage.
+func dispatch(ctx context.Context, name string, args []string) error {
+	cmd, ok := commands[name]
+	if !ok {
+		return errUnknownCommand
+	}
+	fs := cmd.flags
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: dbx %s\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		// Parse has printed the usage.
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	err := cmd.run(ctx, fs.Args())
+	if err == errUsage {
+		fs.Usage()
+	}
+	return err
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	err := dispatch(ctx, flag.Arg(0), flag.Args()[1:])
+	switch {
+	case err == errUnknownCommand:
+		usage()
+	case err == flag.ErrHelp:
+		os.Exit(0)
+	case err == errUsage:
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "dbx %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+// fakes are the clients returned to the commands, and the number of clients
+// the commands created.
+type fakes struct {
+	files   *files.Mock
+	sharing *sharing.Mock
+	team    *team.Mock
+	created int
+}
+
+// setup replaces the clients of the commands by fakes and sets the tokens.
+func setup(t *testing.T) *fakes {
+	f := &fakes{files: &files.Mock{}, sharing: &sharing.Mock{}, team: &team.Mock{}}
+	t.Setenv("DROPBOX_TOKEN", "token")
+	t.Setenv("DROPBOX_TEAM_TOKEN", "team-token")
+	oldFiles, oldSharing, oldTeam := newFilesClient, newSharingClient, newTeamClient
+	newFilesClient = func(config dropbox.Config) files.Client {
+		f.created++
+		if config.Token != "token" {
+			t.Errorf("Unexpected token: %v\n", config.Token)
+		}
+		return f.files
+	}
+	newSharingClient = func(config dropbox.Config) sharing.Client {
+		f.created++
+		if config.Token != "token" {
+			t.Errorf("Unexpected token: %v\n", config.Token)
+		}
+		return f.sharing
+	}
+	newTeamClient = func(config dropbox.Config) team.Client {
+		f.created++
+		if config.Token != "team-token" {
+			t.Errorf("Unexpected token: %v\n", config.Token)
+		}
+		return f.team
+	}
+	t.Cleanup(func() {
+		newFilesClient, newSharingClient, newTeamClient = oldFiles, oldSharing, oldTeam
+	})
+	return f
+}
+
+// runDbx runs dbx with args, returning what the command printed on stdout
+// and stderr. The flags of the commands are reset afterwards.
+func runDbx(args ...string) (string, string, error) {
+	var out, errOut bytes.Buffer
+	stdout, stderr = &out, &errOut
+	defer func() {
+		stdout, stderr = os.Stdout, os.Stderr
+		for _, cmd := range commands {
+			cmd.flags.VisitAll(func(f *flag.Flag) { f.Value.Set(f.DefValue) })
+		}
+	}()
+	err := dispatch(context.Background(), args[0], args[1:])
+	return out.String(), errOut.String(), err
+}
+
+func TestDispatchUsage(t *testing.T) {
+	f := setup(t)
+
+	if _, _, err := runDbx("frob"); err != errUnknownCommand {
+		t.Errorf("Unexpected error for an unknown command: %v\n", err)
+	}
+	for _, args := range [][]string{
+		{"ls", "-x"},
+		{"ls", "/a", "/b"},
+		{"get"},
+		{"get", "/a", "a", "b"},
+		{"put", "a"},
+		{"put", "-chunk", "big", "a", "/a"},
+		{"link"},
+		{"link", "/a", "/b"},
+		{"share", "/a"},
+		{"members"},
+		{"members", "list", "a@example.com"},
+		{"members", "add"},
+		{"members", "-keep", "remove"},
+		{"members", "frob", "a@example.com"},
+	} {
+		_, errOut, err := runDbx(args...)
+		if err != errUsage {
+			t.Errorf("Unexpected error for %v: %v\n", args, err)
+		}
+		if !strings.Contains(errOut, "usage: dbx "+args[0]) {
+			t.Errorf("Unexpected usage for %v: %q\n", args, errOut)
+		}
+	}
+	if _, errOut, err := runDbx("put", "-h"); err != flag.ErrHelp || !strings.Contains(errOut, "-parallel") {
+		t.Errorf("Unexpected help: %v, %q\n", err, errOut)
+	}
+	if f.created != 0 {
+		t.Errorf("Unexpected clients created for invalid arguments: %v\n", f.created)
+	}
+
+	t.Setenv("DROPBOX_TOKEN", "")
+	if _, _, err := runDbx("ls"); err == nil || err.Error() != "DROPBOX_TOKEN is not set" {
+		t.Errorf("Unexpected error without a token: %v\n", err)
+	}
+	t.Setenv("DROPBOX_TEAM_TOKEN", "")
+	if _, _, err := runDbx("members", "list"); err == nil || err.Error() != "DROPBOX_TEAM_TOKEN is not set" {
+		t.Errorf("Unexpected error without a team token: %v\n", err)
+	}
+}
+
+func TestLs(t *testing.T) {
+	f := setup(t)
+	var got []*files.ListFolderArg
+	f.files.ListFolderFunc = func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+		got = append(got, arg)
+		file := &files.FileMetadata{Size: 42}
+		file.PathDisplay = "/Photos/a.jpg"
+		folder := &files.FolderMetadata{}
+		folder.PathDisplay = "/Photos/b"
+		return &files.ListFolderResult{Entries: []files.IsMetadata{file, folder}}, nil
+	}
+
+	out, _, err := runDbx("ls", "-r", "/Photos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Path != "/Photos" || !got[0].Recursive {
+		t.Errorf("Unexpected list folder args: %+v\n", got)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(strings.TrimSpace(lines[0]), "42") || !strings.HasSuffix(lines[0], " /Photos/a.jpg") || !strings.HasSuffix(lines[1], " /Photos/b/") {
+		t.Errorf("Unexpected listing: %q\n", out)
+	}
+
+	got = nil
+	if _, _, err = runDbx("ls", "/"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Path != "" || got[0].Recursive {
+		t.Errorf("Unexpected list folder args of the root: %+v\n", got)
+	}
+
+	f.files.ListFolderFunc = func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+		return nil, errors.New("boom")
+	}
+	if _, _, err = runDbx("ls"); err == nil || err.Error() != "boom" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
+
+func TestGetPut(t *testing.T) {
+	f := setup(t)
+	dir := t.TempDir()
+	local := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(local, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var commits []*files.CommitInfo
+	f.files.UploadFunc = func(ctx context.Context, arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+		commit := arg.CommitInfo
+		commits = append(commits, &commit)
+		data, err := io.ReadAll(content)
+		if err != nil || string(data) != "hello" {
+			t.Errorf("Unexpected content: %q, %v\n", data, err)
+		}
+		res := &files.FileMetadata{Size: uint64(len(data))}
+		res.PathDisplay = arg.Path
+		return res, nil
+	}
+	if _, errOut, err := runDbx("put", local, "/Notes/notes.txt"); err != nil || !strings.Contains(errOut, "/Notes/notes.txt: 5 bytes") {
+		t.Errorf("Unexpected put: %v, %q\n", err, errOut)
+	}
+	if _, _, err := runDbx("put", "-f", local, "/Notes/notes.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("Unexpected uploads: %v\n", len(commits))
+	}
+	if commits[0].Path != "/Notes/notes.txt" || (commits[0].Mode != nil && commits[0].Mode.Tag == files.WriteModeOverwrite) {
+		t.Errorf("Unexpected commit without -f: %+v\n", commits[0])
+	}
+	if commits[1].Mode == nil || commits[1].Mode.Tag != files.WriteModeOverwrite {
+		t.Errorf("Unexpected commit with -f: %+v\n", commits[1])
+	}
+	if _, _, err := runDbx("put", filepath.Join(dir, "missing"), "/a"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Unexpected error for a missing file: %v\n", err)
+	}
+
+	f.files.DownloadFunc = func(ctx context.Context, arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+		if arg.Path != "/Notes/notes.txt" {
+			t.Errorf("Unexpected download path: %v\n", arg.Path)
+		}
+		return &files.FileMetadata{Size: 5, Rev: "0123456789"}, io.NopCloser(strings.NewReader("world")), nil
+	}
+	copied := filepath.Join(dir, "copy.txt")
+	if _, _, err := runDbx("get", "/Notes/notes.txt", copied); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(copied); err != nil || string(data) != "world" {
+		t.Errorf("Unexpected download: %q, %v\n", data, err)
+	}
+}
+
+func TestLinkShare(t *testing.T) {
+	f := setup(t)
+	var settings []*sharing.SharedLinkSettings
+	f.sharing.CreateSharedLinkWithSettingsFunc = func(ctx context.Context, arg *sharing.CreateSharedLinkWithSettingsArg) (sharing.IsSharedLinkMetadata, error) {
+		if arg.Path != "/Notes" {
+			t.Errorf("Unexpected link path: %v\n", arg.Path)
+		}
+		settings = append(settings, arg.Settings)
+		link := &sharing.FolderLinkMetadata{}
+		link.Url = "https://www.dropbox.com/s/notes"
+		return link, nil
+	}
+	out, _, err := runDbx("link", "/Notes")
+	if err != nil || out != "https://www.dropbox.com/s/notes\n" {
+		t.Errorf("Unexpected link: %q, %v\n", out, err)
+	}
+	if _, _, err = runDbx("link", "-team", "/Notes"); err != nil {
+		t.Fatal(err)
+	}
+	if len(settings) != 2 || settings[0] != nil {
+		t.Errorf("Unexpected link settings: %+v\n", settings)
+	} else if settings[1] == nil || settings[1].Audience == nil || settings[1].Audience.Tag != sharing.LinkAudienceTeam {
+		t.Errorf("Unexpected link settings with -team: %+v\n", settings[1])
+	}
+
+	f.sharing.ShareFolderFunc = func(ctx context.Context, arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error) {
+		folder := &sharing.SharedFolderMetadata{}
+		folder.SharedFolderId = "84528192421"
+		folder.PathLower = strings.ToLower(arg.Path)
+		return &sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderLaunchComplete}, Complete: folder}, nil
+	}
+	var added []*sharing.AddFolderMemberArg
+	f.sharing.AddFolderMemberFunc = func(ctx context.Context, arg *sharing.AddFolderMemberArg) error {
+		added = append(added, arg)
+		return nil
+	}
+	out, _, err = runDbx("share", "-ro", "/Project", "alice@example.com", "bob@example.com")
+	if err != nil || out != "/project shared as 84528192421\n" {
+		t.Errorf("Unexpected share: %q, %v\n", out, err)
+	}
+	if _, _, err = runDbx("share", "/Project", "carol@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 2 {
+		t.Fatalf("Unexpected add folder member calls: %v\n", len(added))
+	}
+	for i, want := range []struct {
+		emails []string
+		level  string
+	}{
+		{[]string{"alice@example.com", "bob@example.com"}, sharing.AccessLevelViewer},
+		{[]string{"carol@example.com"}, sharing.AccessLevelEditor},
+	} {
+		arg := added[i]
+		if arg.SharedFolderId != "84528192421" || len(arg.Members) != len(want.emails) {
+			t.Errorf("Unexpected add folder member arg: %+v\n", arg)
+			continue
+		}
+		for j, m := range arg.Members {
+			if m.Member.Email != want.emails[j] || m.AccessLevel.Tag != want.level {
+				t.Errorf("Unexpected member: %v as %v\n", m.Member.Email, m.AccessLevel.Tag)
+			}
+		}
+	}
+}
+
+func TestMembers(t *testing.T) {
+	f := setup(t)
+	f.team.MembersListV2Func = func(ctx context.Context, arg *team.MembersListArg) (*team.MembersListV2Result, error) {
+		profile := &team.TeamMemberProfile{}
+		profile.Email = "alice@example.com"
+		profile.TeamMemberId = "dbmid:alice"
+		profile.Status = &team.TeamMemberStatus{Tagged: dropbox.Tagged{Tag: team.TeamMemberStatusActive}}
+		return &team.MembersListV2Result{Members: []*team.TeamMemberInfoV2{team.NewTeamMemberInfoV2(profile)}}, nil
+	}
+	out, _, err := runDbx("members", "list")
+	if err != nil || strings.Join(strings.Fields(out), " ") != "alice@example.com active dbmid:alice" {
+		t.Errorf("Unexpected members: %q, %v\n", out, err)
+	}
+
+	var removed []*team.MembersRemoveArg
+	f.team.MembersRemoveFunc = func(ctx context.Context, arg *team.MembersRemoveArg) (*async.LaunchEmptyResult, error) {
+		if arg.User.Email == "bob@example.com" {
+			return nil, errors.New("boom")
+		}
+		removed = append(removed, arg)
+		return &async.LaunchEmptyResult{Tagged: dropbox.Tagged{Tag: async.LaunchEmptyResultComplete}}, nil
+	}
+	out, _, err = runDbx("members", "-keep", "remove", "alice@example.com")
+	if err != nil || out != "alice@example.com: removed\n" {
+		t.Errorf("Unexpected removal: %q, %v\n", out, err)
+	}
+	if len(removed) != 1 || !removed[0].KeepAccount || removed[0].User.Tag != team.UserSelectorArgEmail {
+		t.Errorf("Unexpected removal args: %+v\n", removed)
+	}
+	out, _, err = runDbx("members", "remove", "carol@example.com", "bob@example.com")
+	if err == nil || err.Error() != "1 of 2 members not removed" || !strings.Contains(out, "bob@example.com: boom") {
+		t.Errorf("Unexpected partial removal: %q, %v\n", out, err)
+	}
+	if len(removed) != 2 || removed[1].KeepAccount {
+		t.Errorf("Unexpected removal args without -keep: %+v\n", removed)
+	}
+}
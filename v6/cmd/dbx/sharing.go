@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+var (
+	linkFlags    = flag.NewFlagSet("link", flag.ContinueOnError)
+	linkTeamOnly = linkFlags.Bool("team", false, "restrict the link to the members of the team")
+
+	shareFlags    = flag.NewFlagSet("share", flag.ContinueOnError)
+	shareReadOnly = shareFlags.Bool("ro", false, "give the members read-only access")
+)
+
+var linkCommand = &command{
+	usage: "link [-team] path",
+	help:  "print the shared link of a file or folder",
+	flags: linkFlags,
+	run:   runLink,
+}
+
+var shareCommand = &command{
+	usage: "share [-ro] folder email...",
+	help:  "share a folder with the given members",
+	flags: shareFlags,
+	run:   runShare,
+}
+
+// newSharingClient returns the client of the sharing commands, a fake in the
+// tests.
+var newSharingClient = sharing.New
+
+func sharingClient() (sharing.Client, error) {
+	config, err := config(false)
+	if err != nil {
+		return nil, err
+	}
+	return newSharingClient(config), nil
+}
+
+// runLink prints the shared link of a file or folder, created by
+// sharing.EnsureSharedLink if it doesn't exist yet.
+func runLink(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	dbx, err := sharingClient()
+	if err != nil {
+		return err
+	}
+	opts := &sharing.EnsureLinkOptions{}
+	if *linkTeamOnly {
+		opts.Settings = sharing.NewSharedLinkSettings()
+		opts.Settings.Audience = &sharing.LinkAudience{Tagged: dropbox.Tagged{Tag: sharing.LinkAudienceTeam}}
+	}
+	link, err := sharing.EnsureSharedLink(ctx, dbx, args[0], opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, sharing.SharedLinkBase(link).Url)
+	return nil
+}
+
+// runShare shares a folder with sharing.ShareFolderAndWait, which waits for
+// Dropbox to complete the share, and adds the members to it.
+func runShare(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+	dbx, err := sharingClient()
+	if err != nil {
+		return err
+	}
+	folder, err := sharing.ShareFolderAndWait(ctx, dbx, sharing.NewShareFolderArg(args[0]), nil)
+	if err != nil {
+		return err
+	}
+	level := sharing.NewAccessLevel(sharing.AccessLevelEditor)
+	if *shareReadOnly {
+		level = sharing.NewAccessLevel(sharing.AccessLevelViewer)
+	}
+	members := make([]*sharing.AddMember, len(args)-1)
+	for i, email := range args[1:] {
+		members[i] = sharing.NewAddMember(sharing.NewEmailMemberSelector(email))
+		members[i].AccessLevel = level
+	}
+	if err = dbx.AddFolderMemberContext(ctx, sharing.NewAddFolderMemberArg(folder.SharedFolderId, members)); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "%s shared as %s\n", folder.PathLower, folder.SharedFolderId)
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+var (
+	membersFlags     = flag.NewFlagSet("members", flag.ContinueOnError)
+	membersKeep      = membersFlags.Bool("keep", false, "keep the account of removed members as a Basic account")
+	membersNoWelcome = membersFlags.Bool("quiet", false, "don't send a welcome email to added members")
+)
+
+var membersCommand = &command{
+	usage: "members [-keep] [-quiet] list|add|remove [email...]",
+	help:  "list, add or remove team members",
+	flags: membersFlags,
+	run:   runMembers,
+}
+
+// newTeamClient returns the client of the team commands, a fake in the tests.
+var newTeamClient = team.New
+
+func runMembers(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	switch {
+	case args[0] == "list" && len(args) == 1:
+	case (args[0] == "add" || args[0] == "remove") && len(args) > 1:
+	default:
+		return errUsage
+	}
+	config, err := config(true)
+	if err != nil {
+		return err
+	}
+	dbx := newTeamClient(config)
+	switch args[0] {
+	case "add":
+		return addMembers(ctx, dbx, args[1:])
+	case "remove":
+		return removeMembers(ctx, dbx, args[1:])
+	}
+	return listMembers(ctx, dbx)
+}
+
+// listMembers lists the members of the team with a
+// team.MembersListV2Iterator.
+func listMembers(ctx context.Context, dbx team.Client) error {
+	w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	defer w.Flush()
+	it := team.NewMembersListV2Iterator(dbx, team.NewMembersListArg())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range res.Members {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", m.Profile.Email, m.Profile.Status.Tag, m.Profile.TeamMemberId)
+		}
+	}
+	return nil
+}
+
+// addMembers adds members with team.AddMembers, which batches them and
+// waits for the jobs of Dropbox to complete.
+func addMembers(ctx context.Context, dbx team.Client, emails []string) error {
+	args := make([]*team.MemberAddV2Arg, len(emails))
+	for i, email := range emails {
+		args[i] = team.NewMemberAddV2Arg(email)
+		args[i].SendWelcomeEmail = !*membersNoWelcome
+	}
+	outcomes, err := team.AddMembers(ctx, dbx, args, nil)
+	for _, o := range outcomes {
+		if o.Failure != nil {
+			fmt.Fprintf(stdout, "%s: %s\n", o.Member.MemberEmail, o.Failure.Tag)
+		} else {
+			fmt.Fprintf(stdout, "%s: added as %s\n", o.Member.MemberEmail, o.Info.Profile.TeamMemberId)
+		}
+	}
+	return err
+}
+
+// removeMembers removes members with team.RemoveMembers, which removes them
+// in parallel and reports the outcome of each.
+func removeMembers(ctx context.Context, dbx team.Client, emails []string) error {
+	args := make([]*team.MembersRemoveArg, len(emails))
+	for i, email := range emails {
+		args[i] = team.NewMembersRemoveArg(&team.UserSelectorArg{Tagged: dropbox.Tagged{Tag: team.UserSelectorArgEmail}, Email: email})
+		args[i].KeepAccount = *membersKeep
+	}
+	failed := 0
+	for _, o := range team.RemoveMembers(ctx, dbx, args, nil) {
+		if o.Err != nil {
+			failed++
+			fmt.Fprintf(stdout, "%s: %v\n", o.Member.User.Email, o.Err)
+		} else {
+			fmt.Fprintf(stdout, "%s: removed\n", o.Member.User.Email)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d members not removed", failed, len(emails))
+	}
+	return nil
+}
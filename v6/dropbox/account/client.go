@@ -45,6 +45,11 @@ type SetProfilePhotoAPIError struct {
 	EndpointError *SetProfilePhotoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SetProfilePhotoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SetProfilePhotoContext(ctx context.Context, arg *SetProfilePhotoArg) (res *SetProfilePhotoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
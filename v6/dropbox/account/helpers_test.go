@@ -0,0 +1,7 @@
+package account_test
+
+import "fmt"
+
+func generateURL(base string, namespace string, route string) string {
+	return fmt.Sprintf("%s/%s/%s", base, namespace, route)
+}
@@ -0,0 +1,51 @@
+package account
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// MaxProfilePhotoSize is the largest image, in bytes, SetProfilePhoto
+// uploads.
+const MaxProfilePhotoSize = 10 << 20
+
+// SetProfilePhoto sets the JPEG or PNG image read from r as the profile
+// photo of the user and returns the URL of the new photo. Images that are
+// too large or of another format are rejected without a request, with a
+// SetProfilePhotoAPIError tagged file_size_error or file_type_error.
+func SetProfilePhoto(ctx context.Context, client Client, r io.Reader) (string, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, MaxProfilePhotoSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > MaxProfilePhotoSize {
+		return "", setProfilePhotoError(SetProfilePhotoErrorFileSizeError)
+	}
+	switch http.DetectContentType(data) {
+	case "image/jpeg", "image/png":
+	default:
+		return "", setProfilePhotoError(SetProfilePhotoErrorFileTypeError)
+	}
+
+	photo := &PhotoSourceArg{
+		Tagged:     dropbox.Tagged{Tag: PhotoSourceArgBase64Data},
+		Base64Data: base64.StdEncoding.EncodeToString(data),
+	}
+	res, err := client.SetProfilePhotoContext(ctx, NewSetProfilePhotoArg(photo))
+	if err != nil {
+		return "", err
+	}
+	return res.ProfilePhotoUrl, nil
+}
+
+func setProfilePhotoError(tag string) SetProfilePhotoAPIError {
+	return SetProfilePhotoAPIError{
+		APIError:      dropbox.APIError{ErrorSummary: tag},
+		EndpointError: &SetProfilePhotoError{Tagged: dropbox.Tagged{Tag: tag}},
+	}
+}
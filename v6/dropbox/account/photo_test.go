@@ -0,0 +1,52 @@
+package account_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/account"
+)
+
+func TestSetProfilePhoto(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg account.SetProfilePhotoArg
+			if err := json.NewDecoder(r.Body).Decode(&arg); err != nil {
+				t.Errorf("Unexpected body: %v\n", err)
+			}
+			if arg.Photo == nil || arg.Photo.Base64Data != base64.StdEncoding.EncodeToString(png) {
+				t.Errorf("Unexpected photo: %+v\n", arg.Photo)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"profile_photo_url": "https://dl-web.dropbox.com/account_photo/get/photo"}`))
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := account.New(config)
+	url, err := account.SetProfilePhoto(context.Background(), dbx, bytes.NewReader(png))
+	if err != nil || url != "https://dl-web.dropbox.com/account_photo/get/photo" {
+		t.Errorf("Unexpected result: %v %v\n", url, err)
+	}
+
+	_, err = account.SetProfilePhoto(context.Background(), dbx, strings.NewReader("not an image"))
+	if apiErr, ok := err.(account.SetProfilePhotoAPIError); !ok || apiErr.EndpointError.Tag != account.SetProfilePhotoErrorFileTypeError {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	large := append(png, make([]byte, account.MaxProfilePhotoSize)...)
+	_, err = account.SetProfilePhoto(context.Background(), dbx, bytes.NewReader(large))
+	if apiErr, ok := err.(account.SetProfilePhotoAPIError); !ok || apiErr.EndpointError.Tag != account.SetProfilePhotoErrorFileSizeError {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
@@ -0,0 +1,197 @@
+package aferofs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/aferofs"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/afero"
+)
+
+func TestAferoFs(t *testing.T) {
+	var mu sync.Mutex
+	content := map[string][]byte{"/a/b.txt": []byte("hello world")}
+	folders := map[string]bool{"/a": true}
+	lookups := 0
+	notFound := func() error {
+		return dropbox.APIError{ErrorSummary: "path/not_found/"}
+	}
+	metadata := func(p string) files.IsMetadata {
+		if folders[p] {
+			return &files.FolderMetadata{Metadata: files.Metadata{Name: path.Base(p), PathLower: p}}
+		}
+		if data, ok := content[p]; ok {
+			return &files.FileMetadata{Metadata: files.Metadata{Name: path.Base(p), PathLower: p}, Size: uint64(len(data))}
+		}
+		return nil
+	}
+	dbx := &files.Mock{
+		GetMetadataFunc: func(ctx context.Context, arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			lookups++
+			if m := metadata(arg.Path); m != nil {
+				return m, nil
+			}
+			return nil, notFound()
+		},
+		DownloadFunc: func(ctx context.Context, arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			data, ok := content[arg.Path]
+			if !ok {
+				return nil, nil, notFound()
+			}
+			if r, ok := arg.ExtraHeaders["Range"]; ok {
+				var start, end int
+				if n, _ := fmt.Sscanf(r, "bytes=%d-%d", &start, &end); n == 2 {
+					data = data[start : end+1]
+				} else {
+					data = data[start:]
+				}
+			}
+			return metadata(arg.Path).(*files.FileMetadata), io.NopCloser(bytes.NewReader(data)), nil
+		},
+		UploadFunc: func(ctx context.Context, arg *files.UploadArg, r io.Reader) (*files.FileMetadata, error) {
+			if arg.Mode.Tag != files.WriteModeOverwrite {
+				t.Errorf("Unexpected mode: %v\n", arg.Mode.Tag)
+			}
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			content[arg.Path] = data
+			return metadata(arg.Path).(*files.FileMetadata), nil
+		},
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			res := &files.ListFolderResult{}
+			for p := range content {
+				if path.Dir(p) == arg.Path {
+					res.Entries = append(res.Entries, metadata(p))
+				}
+			}
+			sort.Slice(res.Entries, func(i, j int) bool {
+				return res.Entries[i].(*files.FileMetadata).Name < res.Entries[j].(*files.FileMetadata).Name
+			})
+			return res, nil
+		},
+		CreateFolderV2Func: func(ctx context.Context, arg *files.CreateFolderArg) (*files.CreateFolderResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if metadata(arg.Path) != nil {
+				return nil, dropbox.APIError{ErrorSummary: "path/conflict/folder/"}
+			}
+			folders[arg.Path] = true
+			return &files.CreateFolderResult{}, nil
+		},
+		MoveV2Func: func(ctx context.Context, arg *files.RelocationArg) (*files.RelocationResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			data, ok := content[arg.FromPath]
+			if !ok {
+				return nil, notFound()
+			}
+			delete(content, arg.FromPath)
+			content[arg.ToPath] = data
+			return &files.RelocationResult{}, nil
+		},
+		DeleteV2Func: func(ctx context.Context, arg *files.DeleteArg) (*files.DeleteResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if metadata(arg.Path) == nil {
+				return nil, notFound()
+			}
+			for p := range content {
+				if p == arg.Path || strings.HasPrefix(p, arg.Path+"/") {
+					delete(content, p)
+				}
+			}
+			delete(folders, arg.Path)
+			return &files.DeleteResult{}, nil
+		},
+	}
+	afs := aferofs.NewFs(context.Background(), dbx)
+
+	data, err := afero.ReadFile(afs, "/a/b.txt")
+	if err != nil || string(data) != "hello world" {
+		t.Errorf("Unexpected content: %q, %v\n", data, err)
+	}
+	f, err := afs.Open("a/b.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 10)
+	if n, err := f.ReadAt(buf, 6); n != 5 || err != io.EOF || string(buf[:n]) != "world" {
+		t.Errorf("Unexpected ReadAt: %q, %v\n", buf[:n], err)
+	}
+	if _, err = f.Write([]byte("x")); err == nil {
+		t.Errorf("Unexpected write to a file opened for reading\n")
+	}
+	f.Close()
+	if info, err := afs.Stat("/a/b.txt"); err != nil || info.Size() != 11 || info.IsDir() {
+		t.Errorf("Unexpected info: %+v, %v\n", info, err)
+	}
+	if lookups != 1 {
+		t.Errorf("Unexpected lookups: %d\n", lookups)
+	}
+	if _, err = afs.Stat("/a/missing"); !os.IsNotExist(err) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	if err = afero.WriteFile(afs, "/a/c.txt", []byte("new"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := afs.Stat("/a/c.txt"); err != nil || info.Size() != 3 {
+		t.Errorf("Unexpected info: %+v, %v\n", info, err)
+	}
+	f, err = afs.OpenFile("/a/b.txt", os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("!")
+	if err = f.Close(); err != nil || string(content["/a/b.txt"]) != "hello world!" {
+		t.Errorf("Unexpected content: %q, %v\n", content["/a/b.txt"], err)
+	}
+	if _, err = afs.OpenFile("/a/c.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644); !os.IsExist(err) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	names, err := afero.ReadDir(afs, "/a")
+	if err != nil || len(names) != 2 || names[0].Name() != "b.txt" || names[1].Name() != "c.txt" {
+		t.Errorf("Unexpected entries: %v, %v\n", names, err)
+	}
+	if err = afs.MkdirAll("/a", 0755); err != nil {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if err = afs.Rename("/a/c.txt", "/a/b.txt"); err != nil || string(content["/a/b.txt"]) != "new" {
+		t.Errorf("Unexpected content: %q, %v\n", content["/a/b.txt"], err)
+	}
+	if err = afs.Remove("/a"); !errors.Is(err, syscall.ENOTEMPTY) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if err = afs.RemoveAll("/a"); err != nil || len(content) != 0 {
+		t.Errorf("Unexpected content: %v, %v\n", content, err)
+	}
+	if err = afs.RemoveAll("/a"); err != nil {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if err = afs.Chmod("/a", 0600); !errors.Is(err, aferofs.ErrNotSupported) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
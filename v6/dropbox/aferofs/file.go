@@ -0,0 +1,412 @@
+package aferofs
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/afero"
+)
+
+// File is a file or folder of an Fs. A file opened for reading streams its
+// content from Dropbox; a file opened for writing is a local copy uploaded
+// by Sync and Close.
+type File struct {
+	fs   *Fs
+	name string
+	path string
+	meta files.IsMetadata
+
+	mu     sync.Mutex
+	closed bool
+
+	// offset and body are the position and the content being streamed of a
+	// file opened for reading.
+	offset int64
+	body   io.ReadCloser
+
+	// entries are the entries of a folder, listed on the first call to
+	// Readdir, and read the entries returned so far.
+	entries []os.FileInfo
+	listed  bool
+	read    int
+
+	// tmp is the local copy of a file opened for writing, dirty telling
+	// whether it differs from the file in Dropbox.
+	tmp    *os.File
+	append bool
+	dirty  bool
+}
+
+var _ afero.File = (*File)(nil)
+
+// Name returns the name the file was opened with.
+func (f *File) Name() string {
+	return f.name
+}
+
+func (f *File) isDir() bool {
+	_, ok := f.meta.(*files.FolderMetadata)
+	return ok
+}
+
+// size returns the size of a file opened for reading.
+func (f *File) size() int64 {
+	if m, ok := f.meta.(*files.FileMetadata); ok {
+		return int64(m.Size)
+	}
+	return 0
+}
+
+// check returns the error of op on a closed file or folder, or on a folder
+// if op only applies to files.
+func (f *File) check(op string, fileOnly bool) error {
+	switch {
+	case f.closed:
+		return pathError(op, f.name, fs.ErrClosed)
+	case fileOnly && f.isDir():
+		return pathError(op, f.name, syscall.EISDIR)
+	}
+	return nil
+}
+
+// download returns the content of the file from offset on, up to end
+// excluded if end is positive.
+func (f *File) download(offset int64, end int64) (io.ReadCloser, error) {
+	arg := files.NewDownloadArg(f.path)
+	if end > 0 {
+		arg.ExtraHeaders = map[string]string{"Range": fmt.Sprintf("bytes=%d-%d", offset, end-1)}
+	} else if offset > 0 {
+		arg.ExtraHeaders = map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}
+	}
+	_, content, err := f.fs.client.DownloadContext(f.fs.ctx, arg)
+	return content, err
+}
+
+// Read reads from the current offset.
+func (f *File) Read(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("read", true); err != nil {
+		return 0, err
+	}
+	if f.tmp != nil {
+		return f.tmp.Read(p)
+	}
+	if f.offset >= f.size() {
+		return 0, io.EOF
+	}
+	if f.body == nil {
+		body, err := f.download(f.offset, 0)
+		if err != nil {
+			return 0, pathError("read", f.name, err)
+		}
+		f.body = body
+	}
+	n, err := f.body.Read(p)
+	f.offset += int64(n)
+	if err != nil {
+		// The next read, if any, starts a new download.
+		f.body.Close()
+		f.body = nil
+		switch {
+		case err == io.EOF && n > 0:
+			err = nil
+		case err == io.EOF && f.offset < f.size():
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+// ReadAt reads len(p) bytes from off, with a ranged download for a file
+// opened for reading.
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("read", true); err != nil {
+		return 0, err
+	}
+	if f.tmp != nil {
+		return f.tmp.ReadAt(p, off)
+	}
+	if off < 0 {
+		return 0, pathError("readat", f.name, syscall.EINVAL)
+	}
+	size := f.size()
+	if off >= size || len(p) == 0 {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+	end := off + int64(len(p))
+	if end > size {
+		end = size
+	}
+	content, err := f.download(off, end)
+	if err != nil {
+		return 0, pathError("read", f.name, err)
+	}
+	defer content.Close()
+	n, err := io.ReadFull(content, p[:end-off])
+	if err == nil && n < len(p) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Seek sets the offset of the next Read or Write.
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("seek", false); err != nil {
+		return 0, err
+	}
+	if f.tmp != nil {
+		return f.tmp.Seek(offset, whence)
+	}
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.size()
+	}
+	if offset < 0 {
+		return 0, pathError("seek", f.name, syscall.EINVAL)
+	}
+	if offset != f.offset && f.body != nil {
+		f.body.Close()
+		f.body = nil
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// writable returns the error of op on a file not opened for writing.
+func (f *File) writable(op string) error {
+	if err := f.check(op, true); err != nil {
+		return err
+	}
+	if f.tmp == nil {
+		return pathError(op, f.name, syscall.EBADF)
+	}
+	return nil
+}
+
+// Write writes p at the current offset, or at the end of the file if it
+// was opened with os.O_APPEND.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.writable("write"); err != nil {
+		return 0, err
+	}
+	if f.append {
+		if _, err := f.tmp.Seek(0, io.SeekEnd); err != nil {
+			return 0, err
+		}
+	}
+	f.dirty = true
+	return f.tmp.Write(p)
+}
+
+// WriteAt writes p at off.
+func (f *File) WriteAt(p []byte, off int64) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.writable("write"); err != nil {
+		return 0, err
+	}
+	f.dirty = true
+	return f.tmp.WriteAt(p, off)
+}
+
+// WriteString writes s at the current offset.
+func (f *File) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+// Truncate changes the size of the file.
+func (f *File) Truncate(size int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.writable("truncate"); err != nil {
+		return err
+	}
+	f.dirty = true
+	return f.tmp.Truncate(size)
+}
+
+// Sync uploads the file if it was changed since it was opened or last
+// synced.
+func (f *File) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("sync", false); err != nil {
+		return err
+	}
+	return f.upload()
+}
+
+func (f *File) upload() error {
+	if !f.dirty {
+		return nil
+	}
+	info, err := f.tmp.Stat()
+	if err != nil {
+		return err
+	}
+	commit := files.NewCommitInfo(f.path)
+	commit.Mode = &files.WriteMode{Tagged: dropbox.Tagged{Tag: files.WriteModeOverwrite}}
+	res, _, err := f.fs.Uploader.Upload(f.fs.ctx, io.NewSectionReader(f.tmp, 0, info.Size()), info.Size(), commit)
+	f.fs.Cache.Invalidate(f.path)
+	if err != nil {
+		return pathError("sync", f.name, err)
+	}
+	f.meta = res
+	f.dirty = false
+	return nil
+}
+
+// Close uploads the file if it was changed, and releases its resources.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("close", false); err != nil {
+		return err
+	}
+	f.closed = true
+	if f.body != nil {
+		f.body.Close()
+		f.body = nil
+	}
+	if f.tmp == nil {
+		return nil
+	}
+	err := f.upload()
+	f.removeTemp()
+	return err
+}
+
+func (f *File) removeTemp() {
+	f.tmp.Close()
+	os.Remove(f.tmp.Name())
+}
+
+// Stat returns the FileInfo of the file, reflecting the writes not uploaded
+// yet.
+func (f *File) Stat() (os.FileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("stat", false); err != nil {
+		return nil, err
+	}
+	if f.tmp == nil {
+		return newFileInfo(f.meta), nil
+	}
+	local, err := f.tmp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	info := &fileInfo{name: path.Base(f.path), size: local.Size(), modTime: local.ModTime(), meta: f.meta}
+	if m, ok := f.meta.(*files.FileMetadata); ok && !f.dirty {
+		info.modTime = m.ServerModified
+	}
+	return info, nil
+}
+
+// Readdir returns the next count entries of the folder, or all the
+// remaining ones if count is not positive, as os.File.Readdir does.
+func (f *File) Readdir(count int) ([]os.FileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.check("readdir", false); err != nil {
+		return nil, err
+	}
+	if !f.isDir() {
+		return nil, pathError("readdir", f.name, syscall.ENOTDIR)
+	}
+	if !f.listed {
+		it := files.NewListFolderIterator(f.fs.client, files.NewListFolderArg(f.path))
+		for it.HasMore() {
+			res, err := it.Next(f.fs.ctx)
+			if err != nil {
+				return nil, pathError("readdir", f.name, err)
+			}
+			for _, entry := range res.Entries {
+				f.entries = append(f.entries, newFileInfo(entry))
+			}
+		}
+		f.listed = true
+	}
+
+	rest := f.entries[f.read:]
+	if count <= 0 {
+		f.read = len(f.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	f.read += count
+	return rest[:count], nil
+}
+
+// Readdirnames returns the names of the next n entries of the folder, or
+// of all the remaining ones if n is not positive.
+func (f *File) Readdirnames(n int) ([]string, error) {
+	infos, err := f.Readdir(n)
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.Name()
+	}
+	return names, err
+}
+
+// fileInfo is the os.FileInfo of a file or folder, whose Sys method returns
+// its files.IsMetadata.
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+	meta    files.IsMetadata
+}
+
+func newFileInfo(m files.IsMetadata) *fileInfo {
+	switch m := m.(type) {
+	case *files.FileMetadata:
+		return &fileInfo{name: m.Name, size: int64(m.Size), modTime: m.ServerModified, meta: m}
+	case *files.FolderMetadata:
+		return &fileInfo{name: m.Name, dir: true, meta: m}
+	case *files.DeletedMetadata:
+		return &fileInfo{name: m.Name, meta: m}
+	case *files.UnknownMetadata:
+		return &fileInfo{name: m.Name, meta: m}
+	}
+	return &fileInfo{meta: m}
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.size }
+func (i *fileInfo) ModTime() time.Time { return i.modTime }
+func (i *fileInfo) IsDir() bool        { return i.dir }
+func (i *fileInfo) Sys() interface{}   { return i.meta }
+
+func (i *fileInfo) Mode() os.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0755
+	}
+	return 0644
+}
@@ -0,0 +1,282 @@
+// Package aferofs is an afero.Fs backed by the files of a Dropbox account,
+// so that code written against afero can read and write Dropbox storage:
+//
+//	fs := aferofs.NewFs(ctx, files.New(config))
+//	data, err := afero.ReadFile(fs, "/Notes/todo.txt")
+//
+// Files opened for reading stream their content with ranged downloads.
+// Files opened for writing are kept in a local temporary file and uploaded
+// when they are synced or closed, replacing the file in Dropbox. Metadata is
+// cached, and invalidated by the changes made through the Fs; the changes
+// made elsewhere are only seen while the Watch method of Cache runs.
+//
+// Dropbox has no permissions, owners or access times: Chmod, Chown and
+// Chtimes fail with ErrNotSupported.
+//
+// aferofs is a module of its own, so that the SDK doesn't depend on afero.
+package aferofs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/spf13/afero"
+)
+
+// ErrNotSupported is returned by the operations Dropbox has no equivalent
+// for.
+var ErrNotSupported = errors.New("operation not supported by Dropbox")
+
+// Fs is an afero.Fs storing its files in Dropbox. Paths are absolute
+// Dropbox paths, "/" being the root of the account, or of the app folder for
+// apps with the app folder access type. An Fs is safe for concurrent use.
+type Fs struct {
+	ctx    context.Context
+	client files.Client
+
+	// Cache holds the metadata of the files and folders looked up. Run its
+	// Watch method to keep it up to date with the changes made outside of the
+	// Fs.
+	Cache *files.MetadataCache
+	// Uploader uploads the files written.
+	Uploader *files.Uploader
+	// Downloader downloads the content of the files opened for writing
+	// without truncation.
+	Downloader *files.Downloader
+	// TempDir is the directory of the local copies of the files open for
+	// writing, os.TempDir() if empty.
+	TempDir string
+}
+
+var _ afero.Fs = (*Fs)(nil)
+
+// NewFs returns an Fs using client, all requests being made with ctx.
+func NewFs(ctx context.Context, client files.Client) *Fs {
+	return &Fs{
+		ctx:        ctx,
+		client:     client,
+		Cache:      files.NewMetadataCache(client),
+		Uploader:   files.NewUploader(client),
+		Downloader: files.NewDownloader(client),
+	}
+}
+
+// Name returns the name of the Fs.
+func (f *Fs) Name() string {
+	return "DropboxFs"
+}
+
+// dropboxPath returns the Dropbox path of name, "" for the root.
+func dropboxPath(name string) string {
+	p := path.Clean("/" + name)
+	if p == "/" {
+		return ""
+	}
+	return p
+}
+
+// fsError maps the errors of the API to their fs equivalent, so that
+// os.IsNotExist and os.IsExist work.
+func fsError(err error) error {
+	switch {
+	case errors.Is(err, dropbox.ErrNotFound):
+		return fs.ErrNotExist
+	case errors.Is(err, dropbox.ErrConflict):
+		return fs.ErrExist
+	case errors.Is(err, dropbox.ErrNoPermission):
+		return fs.ErrPermission
+	}
+	return err
+}
+
+// pathError returns err as the *fs.PathError of op on name.
+func pathError(op string, name string, err error) error {
+	return &fs.PathError{Op: op, Path: name, Err: fsError(err)}
+}
+
+// stat returns the metadata of the Dropbox path p.
+func (f *Fs) stat(p string) (files.IsMetadata, error) {
+	if p == "" {
+		// get_metadata doesn't support the root.
+		return &files.FolderMetadata{Metadata: files.Metadata{Name: "/", PathDisplay: "/"}}, nil
+	}
+	return f.Cache.GetMetadata(f.ctx, p)
+}
+
+// Stat returns the FileInfo of the file or folder name.
+func (f *Fs) Stat(name string) (os.FileInfo, error) {
+	m, err := f.stat(dropboxPath(name))
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	return newFileInfo(m), nil
+}
+
+// Create creates or truncates the file name.
+func (f *Fs) Create(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// Open opens the file or folder name for reading.
+func (f *Fs) Open(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// OpenFile opens the file or folder name. The permissions are ignored.
+// Opening a file for writing fetches its content, unless it is truncated.
+func (f *Fs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	p := dropboxPath(name)
+	m, err := f.stat(p)
+	if err != nil && !errors.Is(err, dropbox.ErrNotFound) {
+		return nil, pathError("open", name, err)
+	}
+	exists := err == nil
+	if _, deleted := m.(*files.DeletedMetadata); deleted {
+		exists = false
+	}
+	switch {
+	case !exists && flag&os.O_CREATE == 0:
+		return nil, pathError("open", name, fs.ErrNotExist)
+	case exists && flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		return nil, pathError("open", name, fs.ErrExist)
+	}
+	if _, ok := m.(*files.FolderMetadata); ok && exists {
+		if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+			return nil, pathError("open", name, syscall.EISDIR)
+		}
+		return &File{fs: f, name: name, path: p, meta: m}, nil
+	}
+	if exists && flag&(os.O_WRONLY|os.O_RDWR|os.O_APPEND|os.O_TRUNC) == 0 {
+		return &File{fs: f, name: name, path: p, meta: m}, nil
+	}
+
+	tmp, err := os.CreateTemp(f.TempDir, "dropbox-")
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+	file := &File{fs: f, name: name, path: p, meta: m, tmp: tmp, append: flag&os.O_APPEND != 0}
+	if exists && flag&os.O_TRUNC == 0 {
+		if _, _, err = f.Downloader.Download(f.ctx, p, tmp); err != nil {
+			file.removeTemp()
+			return nil, pathError("open", name, err)
+		}
+	} else {
+		// Creating or truncating the file is a change even if nothing is
+		// written.
+		file.dirty = true
+	}
+	return file, nil
+}
+
+// Mkdir creates the folder name. The permissions are ignored.
+func (f *Fs) Mkdir(name string, perm os.FileMode) error {
+	p := dropboxPath(name)
+	if p == "" {
+		return pathError("mkdir", name, fs.ErrExist)
+	}
+	_, err := f.client.CreateFolderV2Context(f.ctx, files.NewCreateFolderArg(p))
+	f.Cache.Invalidate(p)
+	if err != nil {
+		return pathError("mkdir", name, err)
+	}
+	return nil
+}
+
+// MkdirAll creates the folder name along with its missing parents, which
+// Dropbox does in a single request. It does nothing if the folder exists.
+func (f *Fs) MkdirAll(name string, perm os.FileMode) error {
+	err := f.Mkdir(name, perm)
+	if err == nil || !errors.Is(err, fs.ErrExist) {
+		return err
+	}
+	if info, statErr := f.Stat(name); statErr == nil && info.IsDir() {
+		return nil
+	}
+	return err
+}
+
+// Remove removes the file or the empty folder name.
+func (f *Fs) Remove(name string) error {
+	p := dropboxPath(name)
+	m, err := f.stat(p)
+	if err != nil {
+		return pathError("remove", name, err)
+	}
+	if _, ok := m.(*files.FolderMetadata); ok {
+		res, err := f.client.ListFolderContext(f.ctx, files.NewListFolderArg(p).WithLimit(1))
+		if err != nil {
+			return pathError("remove", name, err)
+		}
+		if len(res.Entries) > 0 {
+			return pathError("remove", name, syscall.ENOTEMPTY)
+		}
+	}
+	return f.delete("remove", name)
+}
+
+// RemoveAll removes the file or folder name along with its content. It does
+// nothing if name doesn't exist.
+func (f *Fs) RemoveAll(name string) error {
+	if err := f.delete("removeall", name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *Fs) delete(op string, name string) error {
+	p := dropboxPath(name)
+	if p == "" {
+		return pathError(op, name, fs.ErrPermission)
+	}
+	_, err := f.client.DeleteV2Context(f.ctx, files.NewDeleteArg(p))
+	f.Cache.Invalidate(p)
+	if err != nil {
+		return pathError(op, name, err)
+	}
+	return nil
+}
+
+// Rename moves the file or folder oldname to newname, replacing the file
+// newname if there is one.
+func (f *Fs) Rename(oldname string, newname string) error {
+	from, to := dropboxPath(oldname), dropboxPath(newname)
+	if from == "" || to == "" {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: fs.ErrPermission}
+	}
+	if target, err := f.stat(to); err == nil {
+		if _, ok := target.(*files.FileMetadata); ok {
+			if err = f.delete("rename", newname); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := f.client.MoveV2Context(f.ctx, files.NewRelocationArg(from, to))
+	f.Cache.Invalidate(from)
+	f.Cache.Invalidate(to)
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: fsError(err)}
+	}
+	return nil
+}
+
+// Chmod returns ErrNotSupported.
+func (f *Fs) Chmod(name string, mode os.FileMode) error {
+	return pathError("chmod", name, ErrNotSupported)
+}
+
+// Chown returns ErrNotSupported.
+func (f *Fs) Chown(name string, uid int, gid int) error {
+	return pathError("chown", name, ErrNotSupported)
+}
+
+// Chtimes returns ErrNotSupported.
+func (f *Fs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return pathError("chtimes", name, ErrNotSupported)
+}
@@ -0,0 +1,19 @@
+module github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/aferofs
+
+go 1.18
+
+require (
+	github.com/dropbox/dropbox-sdk-go-unofficial/v6 v6.0.0-00010101000000-000000000000
+	github.com/spf13/afero v1.10.0
+)
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/oauth2 v0.7.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+)
+
+replace github.com/dropbox/dropbox-sdk-go-unofficial/v6 => ../..
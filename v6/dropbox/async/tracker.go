@@ -0,0 +1,203 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTrackerClosed is returned by JobTracker.Track once the tracker has been
+// closed.
+var ErrTrackerClosed = errors.New("job tracker is closed")
+
+// PollFunc checks the status of an async job, typically by calling one of the
+// `*CheckJobStatus` routes with arg. It returns done once the job has
+// finished, along with the job's result or error.
+type PollFunc func(ctx context.Context, arg *PollArg) (result interface{}, done bool, err error)
+
+// JobEvent reports the completion of a tracked job.
+type JobEvent struct {
+	// JobId is the async job ID passed to Track.
+	JobId string
+	// Result is the result returned by the job's PollFunc.
+	Result interface{}
+	// Err is set if the job failed or could not be polled.
+	Err error
+}
+
+// JobTrackerOptions configures a JobTracker.
+type JobTrackerOptions struct {
+	// PollInterval is the delay between two status checks of the same job.
+	// Defaults to one second.
+	PollInterval time.Duration
+	// MaxPollInterval, if greater than PollInterval, makes the delay
+	// between two status checks of the same job double after every check,
+	// up to MaxPollInterval, so that long jobs are polled less often.
+	MaxPollInterval time.Duration
+	// MinRequestInterval is the minimum delay between two status checks
+	// across all jobs, so that tracking many jobs doesn't exhaust the rate
+	// limit. Defaults to no limit.
+	MinRequestInterval time.Duration
+}
+
+// JobTracker polls any number of async jobs concurrently and emits an event
+// on its Events channel as each one completes.
+//
+//	t := async.NewJobTracker(ctx, nil)
+//	t.Track(launch.AsyncJobId, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+//		res, err := dbx.CheckJobStatusContext(ctx, arg)
+//		if err != nil {
+//			return nil, true, err
+//		}
+//		return res, res.Tag != sharing.JobStatusInProgress, nil
+//	})
+//	t.Close()
+//	for ev := range t.Events() {
+//		...
+//	}
+type JobTracker struct {
+	ctx    context.Context
+	opts   JobTrackerOptions
+	events chan JobEvent
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+	next   time.Time
+}
+
+// NewJobTracker returns a JobTracker whose polling stops when ctx is done.
+// opts may be nil.
+func NewJobTracker(ctx context.Context, opts *JobTrackerOptions) *JobTracker {
+	t := &JobTracker{ctx: ctx, events: make(chan JobEvent)}
+	if opts != nil {
+		t.opts = *opts
+	}
+	if t.opts.PollInterval <= 0 {
+		t.opts.PollInterval = time.Second
+	}
+	return t
+}
+
+// Events returns the channel on which completion events are delivered. It
+// is closed once the tracker has been closed and all tracked jobs have
+// completed.
+func (t *JobTracker) Events() <-chan JobEvent {
+	return t.events
+}
+
+// Track starts polling the job with the given ID using poll.
+func (t *JobTracker) Track(jobID string, poll PollFunc) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return ErrTrackerClosed
+	}
+	t.wg.Add(1)
+	go t.run(jobID, poll)
+	return nil
+}
+
+// Close signals that no more jobs will be tracked. Events is closed once the
+// jobs already tracked have completed.
+func (t *JobTracker) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	go func() {
+		t.wg.Wait()
+		close(t.events)
+	}()
+}
+
+func (t *JobTracker) run(jobID string, poll PollFunc) {
+	defer t.wg.Done()
+	arg := NewPollArg(jobID)
+	ev := JobEvent{JobId: jobID}
+	for interval := t.opts.PollInterval; ; {
+		if ev.Err = t.wait(interval); ev.Err != nil {
+			break
+		}
+		if interval < t.opts.MaxPollInterval {
+			interval *= 2
+			if interval > t.opts.MaxPollInterval {
+				interval = t.opts.MaxPollInterval
+			}
+		}
+		if ev.Err = t.throttle(); ev.Err != nil {
+			break
+		}
+		var done bool
+		ev.Result, done, ev.Err = poll(t.ctx, arg)
+		if done || ev.Err != nil {
+			break
+		}
+	}
+	select {
+	case t.events <- ev:
+	case <-t.ctx.Done():
+	}
+}
+
+// throttle blocks until the next status check may be sent.
+func (t *JobTracker) throttle() error {
+	if t.opts.MinRequestInterval <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	now := time.Now()
+	at := t.next
+	if at.Before(now) {
+		at = now
+	}
+	t.next = at.Add(t.opts.MinRequestInterval)
+	t.mu.Unlock()
+	return t.wait(at.Sub(now))
+}
+
+func (t *JobTracker) wait(d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-t.ctx.Done():
+		return t.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// JobOptions controls how Await polls a job.
+type JobOptions struct {
+	// PollInterval is the delay before the first status check of a job.
+	// Defaults to one second.
+	PollInterval time.Duration
+	// MaxPollInterval is the delay between status checks that PollInterval
+	// doubles up to. Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+}
+
+// Await polls the job with the given ID with poll until it completes and
+// returns its result. opts may be nil.
+func Await(ctx context.Context, jobID string, opts *JobOptions, poll PollFunc) (interface{}, error) {
+	if opts == nil {
+		opts = &JobOptions{}
+	}
+	topts := &JobTrackerOptions{PollInterval: opts.PollInterval, MaxPollInterval: opts.MaxPollInterval}
+	if topts.MaxPollInterval <= 0 {
+		topts.MaxPollInterval = 30 * time.Second
+	}
+	t := NewJobTracker(ctx, topts)
+	if err := t.Track(jobID, poll); err != nil {
+		return nil, err
+	}
+	t.Close()
+	ev, ok := <-t.Events()
+	if !ok {
+		return nil, ctx.Err()
+	}
+	return ev.Result, ev.Err
+}
@@ -0,0 +1,94 @@
+package async_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+func TestJobTracker(t *testing.T) {
+	// A job in progress is polled until it completes, the delay between the
+	// checks doubling up to MaxPollInterval.
+	var polls []time.Time
+	start := time.Now()
+	tracker := async.NewJobTracker(context.Background(),
+		&async.JobTrackerOptions{PollInterval: 8 * time.Millisecond, MaxPollInterval: 30 * time.Millisecond})
+	err := tracker.Track("job1", func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		if arg.AsyncJobId != "job1" {
+			t.Errorf("Unexpected job: %v\n", arg.AsyncJobId)
+		}
+		polls = append(polls, time.Now())
+		if len(polls) < 6 {
+			return "in_progress", false, nil
+		}
+		return "complete", true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker.Close()
+	if err = tracker.Track("job2", nil); err != async.ErrTrackerClosed {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	var events []async.JobEvent
+	for ev := range tracker.Events() {
+		events = append(events, ev)
+	}
+	if len(events) != 1 || events[0].JobId != "job1" || events[0].Result != "complete" || events[0].Err != nil {
+		t.Fatalf("Unexpected events: %+v\n", events)
+	}
+	want := []time.Duration{8, 16, 30, 30, 30, 30}
+	prev := start
+	for i, at := range polls {
+		gap := at.Sub(prev)
+		if gap < want[i]*time.Millisecond {
+			t.Errorf("Unexpected delay before check %d: %v\n", i, gap)
+		}
+		prev = at
+	}
+	// Without the cap, the last delay would be 256ms.
+	if gap := polls[5].Sub(polls[4]); gap >= 120*time.Millisecond {
+		t.Errorf("Unexpected delay before the last check: %v\n", gap)
+	}
+
+	// Cancelling the context stops the polling and closes Events.
+	ctx, cancel := context.WithCancel(context.Background())
+	tracker = async.NewJobTracker(ctx, &async.JobTrackerOptions{PollInterval: time.Hour})
+	checked := false
+	if err = tracker.Track("job3", func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		checked = true
+		return nil, true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tracker.Close()
+	cancel()
+	select {
+	case ev, ok := <-tracker.Events():
+		// The event of the cancelled job may be dropped.
+		if ok && !errors.Is(ev.Err, context.Canceled) {
+			t.Errorf("Unexpected event: %+v\n", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Events not closed after cancellation")
+	}
+	for range tracker.Events() {
+	}
+	if checked {
+		t.Error("Job checked after cancellation")
+	}
+
+	// Await returns the result of the job.
+	n := 0
+	res, err := async.Await(context.Background(), "job4", &async.JobOptions{PollInterval: time.Millisecond},
+		func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+			n++
+			return n, n == 3, nil
+		})
+	if err != nil || res != 3 {
+		t.Errorf("Unexpected result: %v %v\n", res, err)
+	}
+}
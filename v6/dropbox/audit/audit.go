@@ -0,0 +1,193 @@
+// Package audit records the mutating route calls made through a client, so
+// that applications keep a trail of what they changed in Dropbox.
+//
+//	f, _ := os.OpenFile("audit.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+//	config = audit.Enable(config, audit.NewJSONSink(f), nil)
+//	dbx := files.New(config)
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Redacted replaces the value of sensitive fields in Entry.Arg.
+const Redacted = "[REDACTED]"
+
+// sensitiveFields are always redacted from arguments.
+var sensitiveFields = []string{
+	"password", "link_password", "new_password", "access_token",
+	"refresh_token", "oauth1_token", "oauth1_token_secret", "secret",
+}
+
+// readVerbs start the path segments of routes that don't change anything.
+var readVerbs = []string{"get", "list", "search", "check", "download", "export", "preview", "count"}
+
+// Entry records a single route call.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Namespace string    `json:"namespace"`
+	Route     string    `json:"route"`
+	// Actor is the account the call was made as or on behalf of, if known.
+	Actor string `json:"actor,omitempty"`
+	// Arg is the route argument with sensitive fields redacted.
+	Arg interface{} `json:"arg,omitempty"`
+	// StatusCode of the response, 0 if none was received.
+	StatusCode int    `json:"status_code"`
+	RequestID  string `json:"request_id,omitempty"`
+	// Error is set if no response was received.
+	Error string `json:"error,omitempty"`
+}
+
+// Sink receives audit entries, e.g. to append them to a file or a database.
+type Sink interface {
+	Write(entry *Entry) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(entry *Entry) error
+
+// Write implements Sink.
+func (f SinkFunc) Write(entry *Entry) error {
+	return f(entry)
+}
+
+type jsonSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONSink returns a Sink writing entries to w as JSON, one per line.
+func NewJSONSink(w io.Writer) Sink {
+	return &jsonSink{enc: json.NewEncoder(w)}
+}
+
+func (s *jsonSink) Write(entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(entry)
+}
+
+// Options controls which calls are recorded and how.
+type Options struct {
+	// Actor is recorded as the actor of every call. Defaults to the
+	// Config's AsMemberID or AsAdminID.
+	Actor string
+	// Redact lists additional argument fields to redact.
+	Redact []string
+	// All records read-only calls as well.
+	All bool
+	// OnError is called if the sink fails to record an entry. Defaults to
+	// logging the error through the Config.
+	OnError func(err error)
+}
+
+// Enable returns a copy of config that records calls to sink. opts may be
+// nil. Any ResponseHook already set on config is still called.
+func Enable(config dropbox.Config, sink Sink, opts *Options) dropbox.Config {
+	if opts == nil {
+		opts = &Options{}
+	}
+	actor := opts.Actor
+	if actor == "" {
+		actor = config.AsMemberID
+	}
+	if actor == "" {
+		actor = config.AsAdminID
+	}
+	redact := make(map[string]bool)
+	for _, f := range sensitiveFields {
+		redact[f] = true
+	}
+	for _, f := range opts.Redact {
+		redact[f] = true
+	}
+	onError := opts.OnError
+	if onError == nil {
+		logConfig := config
+		onError = func(err error) {
+			logConfig.LogInfo("Failed to record audit entry: %v", err)
+		}
+	}
+
+	next := config.ResponseHook
+	config.ResponseHook = func(ctx context.Context, req dropbox.Request, resp dropbox.Response) {
+		if next != nil {
+			next(ctx, req, resp)
+		}
+		if !opts.All && !IsMutating(req.Route) {
+			return
+		}
+		entry := &Entry{
+			Time:       time.Now(),
+			Namespace:  req.Namespace,
+			Route:      req.Route,
+			Actor:      actor,
+			Arg:        summarize(req.Arg, redact),
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.RequestID,
+		}
+		if resp.Err != nil {
+			entry.Error = resp.Err.Error()
+		}
+		if err := sink.Write(entry); err != nil {
+			onError(err)
+		}
+	}
+	return config
+}
+
+// IsMutating reports whether route, e.g. "upload_session/finish" or
+// "members/list/continue", may change anything. Routes whose path contains
+// a read verb such as "get" or "list" are considered read-only.
+func IsMutating(route string) bool {
+	for _, segment := range strings.Split(route, "/") {
+		for _, verb := range readVerbs {
+			if segment == verb || strings.HasPrefix(segment, verb+"_") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// summarize returns arg as generic JSON values with the fields in redact
+// replaced by Redacted.
+func summarize(arg interface{}, redact map[string]bool) interface{} {
+	if arg == nil {
+		return nil
+	}
+	b, err := json.Marshal(arg)
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return redactValue(v, redact)
+}
+
+func redactValue(v interface{}, redact map[string]bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if redact[k] {
+				v[k] = Redacted
+			} else {
+				v[k] = redactValue(e, redact)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e, redact)
+		}
+	}
+	return v
+}
@@ -0,0 +1,119 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/audit"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestEnable(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Dropbox-Request-Id", "req1")
+			switch r.URL.Path {
+			case "/files/get_metadata":
+				_, _ = w.Write([]byte(`{".tag": "file", "name": "a.txt", "path_lower": "/a.txt"}`))
+			case "/files/delete_v2":
+				_, _ = w.Write([]byte(`{"metadata": {".tag": "file", "name": "a.txt", "path_lower": "/a.txt"}}`))
+			case "/sharing/create_shared_link_with_settings":
+				_, _ = w.Write([]byte(`{".tag": "file", "url": "https://www.dropbox.com/s/a", "name": "a.txt"}`))
+			}
+		}))
+	defer ts.Close()
+
+	var hooked []string
+	var buf bytes.Buffer
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug, AsMemberID: "dbmid:1",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return fmt.Sprintf("%s/%s/%s", ts.URL, namespace, route)
+		},
+		ResponseHook: func(ctx context.Context, req dropbox.Request, resp dropbox.Response) {
+			hooked = append(hooked, req.Route)
+		}}
+	config = audit.Enable(config, audit.NewJSONSink(&buf), &audit.Options{Redact: []string{"path"}})
+
+	dbx := files.New(config)
+	if _, err := dbx.GetMetadata(files.NewGetMetadataArg("/a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.DeleteV2(files.NewDeleteArg("/a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	arg := sharing.NewCreateSharedLinkWithSettingsArg("/a.txt")
+	arg.Settings = sharing.NewSharedLinkSettings()
+	arg.Settings.LinkPassword = "hunter2"
+	if _, err := sharing.New(config).CreateSharedLinkWithSettings(arg); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(hooked, ",") != "get_metadata,delete_v2,create_shared_link_with_settings" {
+		t.Errorf("Unexpected routes of the previous hook: %v\n", hooked)
+	}
+
+	if strings.Contains(buf.String(), "hunter2") || strings.Contains(buf.String(), "/a.txt") {
+		t.Errorf("Sensitive fields recorded: %s\n", buf.String())
+	}
+	var entries []*audit.Entry
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var e audit.Entry
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, &e)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Unexpected entries: %v\n", len(entries))
+	}
+	if e := entries[0]; e.Namespace != "files" || e.Route != "delete_v2" || e.Actor != "dbmid:1" ||
+		e.StatusCode != http.StatusOK || e.RequestID != "req1" || e.Error != "" || e.Time.IsZero() {
+		t.Errorf("Unexpected entry: %+v\n", e)
+	}
+	if e := entries[0]; e.Arg.(map[string]interface{})["path"] != audit.Redacted {
+		t.Errorf("Unexpected argument: %v\n", e.Arg)
+	}
+	settings := entries[1].Arg.(map[string]interface{})["settings"].(map[string]interface{})
+	if settings["link_password"] != audit.Redacted {
+		t.Errorf("Unexpected settings: %v\n", settings)
+	}
+
+	var errs []error
+	failing := audit.SinkFunc(func(entry *audit.Entry) error { return errors.New("disk full") })
+	config = audit.Enable(dropbox.Config{Client: ts.Client(), URLGenerator: config.URLGenerator}, failing,
+		&audit.Options{All: true, OnError: func(err error) { errs = append(errs, err) }})
+	if _, err := files.New(config).GetMetadata(files.NewGetMetadataArg("/a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Error() != "disk full" {
+		t.Errorf("Unexpected sink errors: %v\n", errs)
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	for route, want := range map[string]bool{
+		"upload":                true,
+		"upload_session/finish": true,
+		"delete_v2":             true,
+		"get_metadata":          false,
+		"list_folder/continue":  false,
+		"members/list/continue": false,
+		"search_v2":             false,
+		"download":              false,
+		"check/app":             false,
+	} {
+		if got := audit.IsMutating(route); got != want {
+			t.Errorf("IsMutating(%q) = %v\n", route, got)
+		}
+	}
+}
@@ -51,6 +51,11 @@ type TokenFromOauth1APIError struct {
 	EndpointError *TokenFromOAuth1Error `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TokenFromOauth1APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -93,6 +98,11 @@ type TokenRevokeAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TokenRevokeAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TokenRevokeContext(ctx context.Context) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
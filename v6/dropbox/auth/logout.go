@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Logout signs out: it revokes the access token of client, removes the
+// stored token from store, which may be nil, and invalidates client so that
+// its subsequent calls fail with dropbox.ErrInvalidated. Other clients built
+// from the same Config fail with an auth error once the token is revoked.
+// The local state is cleared even if revoking the token fails, in which case
+// the revocation error is returned.
+func Logout(ctx context.Context, client Client, store dropbox.TokenStore) error {
+	err := client.TokenRevokeContext(ctx)
+	if impl, ok := client.(*apiImpl); ok {
+		(*dropbox.Context)(impl).Invalidate()
+	}
+	if store != nil {
+		if clearErr := store.Clear(); err == nil {
+			err = clearErr
+		}
+	}
+	return err
+}
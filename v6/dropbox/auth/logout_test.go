@@ -0,0 +1,63 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"golang.org/x/oauth2"
+)
+
+type memoryTokenStore struct {
+	tok   *oauth2.Token
+	loads int
+}
+
+func (s *memoryTokenStore) Load() (*oauth2.Token, error) {
+	s.loads++
+	return s.tok, nil
+}
+
+func (s *memoryTokenStore) Save(tok *oauth2.Token) error {
+	s.tok = tok
+	return nil
+}
+
+func (s *memoryTokenStore) Clear() error {
+	s.tok = nil
+	return nil
+}
+
+func TestLogout(t *testing.T) {
+	var routes []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			routes = append(routes, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`null`))
+		}))
+	defer ts.Close()
+
+	store := &memoryTokenStore{tok: &oauth2.Token{AccessToken: "stored"}}
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := auth.New(config)
+	if err := auth.Logout(context.Background(), client, store); err != nil {
+		t.Fatal(err)
+	}
+	if store.tok != nil {
+		t.Error("Token was not cleared")
+	}
+	if err := client.TokenRevoke(); err != dropbox.ErrInvalidated {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if strings.Join(routes, ",") != "/auth/token/revoke" {
+		t.Errorf("Unexpected routes: %v\n", routes)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	TokenFromOauth1Func func(ctx context.Context, arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error)
+	TokenRevokeFunc     func(ctx context.Context) (err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
+	if m.TokenFromOauth1Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TokenFromOauth1Func(ctx, arg)
+}
+
+func (m *Mock) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
+	return m.TokenFromOauth1Context(context.Background(), arg)
+}
+
+func (m *Mock) TokenRevokeContext(ctx context.Context) (err error) {
+	if m.TokenRevokeFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TokenRevokeFunc(ctx)
+}
+
+func (m *Mock) TokenRevoke() (err error) {
+	return m.TokenRevokeContext(context.Background())
+}
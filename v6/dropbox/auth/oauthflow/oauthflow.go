@@ -0,0 +1,212 @@
+// Package oauthflow implements the OAuth2 authorization code flow with PKCE
+// for Dropbox, as used by CLI and desktop apps that can't keep an app secret.
+//
+//	flow := &oauthflow.Flow{AppKey: appKey, TokenAccessType: oauthflow.Offline}
+//	config, _, err := flow.RunLocalServer(ctx, "127.0.0.1:53682", func(url string) error {
+//		fmt.Println("Open", url)
+//		return nil
+//	})
+//	dbx := users.New(config)
+package oauthflow
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"golang.org/x/oauth2"
+)
+
+// Values for Flow.TokenAccessType
+const (
+	// Online requests a short-lived access token only.
+	Online = "online"
+	// Offline additionally requests a long-lived refresh token.
+	Offline = "offline"
+)
+
+// Flow describes an authorization code flow with PKCE.
+type Flow struct {
+	// AppKey of the app, from the app console.
+	AppKey string
+	// AppSecret of the app. Not needed with PKCE; leave empty for apps that
+	// are distributed to users.
+	AppSecret string
+	// Scopes to request. Defaults to the scopes configured for the app.
+	Scopes []string
+	// TokenAccessType is either Online or Offline. Defaults to the
+	// app's configured access type.
+	TokenAccessType string
+	// RedirectURL the user is sent to after authorizing the app. Must be
+	// registered in the app console. Leave empty for the out-of-band flow,
+	// where the user copies the code shown by Dropbox.
+	RedirectURL string
+	// No need to set -- for testing only
+	Domain string
+}
+
+func (f *Flow) config() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     f.AppKey,
+		ClientSecret: f.AppSecret,
+		Endpoint:     dropbox.OAuthEndpoint(f.Domain),
+		RedirectURL:  f.RedirectURL,
+	}
+}
+
+// NewVerifier returns a random PKCE code verifier.
+func NewVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Challenge returns the S256 code challenge for verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL of the page where the user authorizes the app.
+// verifier must be kept to exchange the returned code.
+func (f *Flow) AuthCodeURL(state string, verifier string) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", Challenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+	if f.TokenAccessType != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("token_access_type", f.TokenAccessType))
+	}
+	if len(f.Scopes) > 0 {
+		opts = append(opts, oauth2.SetAuthURLParam("scope", strings.Join(f.Scopes, " ")))
+	}
+	return f.config().AuthCodeURL(state, opts...)
+}
+
+// Exchange exchanges an authorization code for a token.
+func (f *Flow) Exchange(ctx context.Context, code string, verifier string) (*oauth2.Token, error) {
+	return f.config().Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+}
+
+// Config returns a dropbox.Config using tok. If tok has a refresh token, the
+// returned Config refreshes the access token when it expires.
+func (f *Flow) Config(tok *oauth2.Token) dropbox.Config {
+	return dropbox.Config{
+		Token:        tok.AccessToken,
+		TokenExpiry:  tok.Expiry,
+		RefreshToken: tok.RefreshToken,
+		AppKey:       f.AppKey,
+		AppSecret:    f.AppSecret,
+		Domain:       f.Domain,
+	}
+}
+
+// RunOutOfBand runs the flow without a redirect: it writes the authorization
+// URL to w and reads the code the user pastes from r.
+func (f *Flow) RunOutOfBand(ctx context.Context, w io.Writer, r io.Reader) (dropbox.Config, *oauth2.Token, error) {
+	verifier, err := NewVerifier()
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+	fmt.Fprintf(w, "1. Go to %v\n", f.AuthCodeURL("", verifier))
+	fmt.Fprintf(w, "2. Click \"Allow\" (you might have to log in first).\n")
+	fmt.Fprintf(w, "3. Copy the authorization code.\n")
+	fmt.Fprintf(w, "Enter the authorization code here: ")
+
+	code, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !(err == io.EOF && code != "") {
+		return dropbox.Config{}, nil, err
+	}
+	tok, err := f.Exchange(ctx, strings.TrimSpace(code), verifier)
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+	return f.Config(tok), tok, nil
+}
+
+// RunLocalServer runs the flow with a redirect to a local HTTP server
+// listening on addr, e.g. "127.0.0.1:53682". If f.RedirectURL is empty it
+// defaults to "http://<addr>/". open is called with the authorization URL,
+// typically to launch a browser or print the URL. RunLocalServer returns
+// once the redirect has been received and the code exchanged, or ctx is
+// done.
+func (f *Flow) RunLocalServer(ctx context.Context, addr string, open func(url string) error) (dropbox.Config, *oauth2.Token, error) {
+	verifier, err := NewVerifier()
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+	state, err := NewVerifier()
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+	flow := *f
+	if flow.RedirectURL == "" {
+		flow.RedirectURL = "http://" + l.Addr().String() + "/"
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+		switch {
+		case subtle.ConstantTimeCompare([]byte(q.Get("state")), []byte(state)) != 1:
+			http.Error(w, "invalid state", http.StatusBadRequest)
+			return
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization failed: %s: %s", q.Get("error"), q.Get("error_description"))
+			fmt.Fprintln(w, "Authorization failed, you can close this window.")
+		case q.Get("code") == "":
+			res.err = errors.New("authorization failed: no code in redirect")
+			fmt.Fprintln(w, "Authorization failed, you can close this window.")
+		default:
+			res.code = q.Get("code")
+			fmt.Fprintln(w, "Authorization complete, you can close this window.")
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})}
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Close()
+
+	if err = open(flow.AuthCodeURL(state, verifier)); err != nil {
+		return dropbox.Config{}, nil, err
+	}
+
+	var res result
+	select {
+	case <-ctx.Done():
+		return dropbox.Config{}, nil, ctx.Err()
+	case res = <-results:
+	}
+	if res.err != nil {
+		return dropbox.Config{}, nil, res.err
+	}
+	tok, err := flow.Exchange(ctx, res.code, verifier)
+	if err != nil {
+		return dropbox.Config{}, nil, err
+	}
+	return flow.Config(tok), tok, nil
+}
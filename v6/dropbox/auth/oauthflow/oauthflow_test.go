@@ -0,0 +1,104 @@
+package oauthflow_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/oauthflow"
+	"golang.org/x/oauth2"
+)
+
+func TestOAuthFlowPKCE(t *testing.T) {
+	// The example of RFC 7636, appendix B.
+	if c := oauthflow.Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"); c != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("Unexpected challenge: %v\n", c)
+	}
+	verifier, err := oauthflow.NewVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	if len(verifier) != 43 || oauthflow.Challenge(verifier) != challenge {
+		t.Errorf("Unexpected verifier: %v %v\n", verifier, oauthflow.Challenge(verifier))
+	}
+
+	flow := &oauthflow.Flow{AppKey: "key", Scopes: []string{"files.content.read", "account_info.read"},
+		TokenAccessType: oauthflow.Offline, RedirectURL: "http://127.0.0.1:53682/", Domain: ".example.com"}
+	u, err := url.Parse(flow.AuthCodeURL("state1", verifier))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if u.Host != "meta.example.com" || q.Get("code_challenge") != challenge || q.Get("code_challenge_method") != "S256" ||
+		q.Get("client_id") != "key" || q.Get("state") != "state1" || q.Get("token_access_type") != "offline" ||
+		q.Get("scope") != "files.content.read account_info.read" || q.Get("code_verifier") != "" {
+		t.Errorf("Unexpected authorization URL: %v\n", u)
+	}
+
+	// The exchange sends the verifier, not the challenge.
+	var verifiers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		verifiers = append(verifiers, r.PostForm.Get("code_verifier"))
+		if user, _, _ := r.BasicAuth(); user != "key" && r.PostForm.Get("client_id") != "key" {
+			t.Errorf("Unexpected client: %v\n", r.Header)
+		}
+		if r.URL.Path != "/1/oauth2/token" || r.PostForm.Get("grant_type") != "authorization_code" ||
+			r.PostForm.Get("code") != "code1" || r.PostForm.Get("code_challenge") != "" {
+			t.Errorf("Unexpected exchange: %v %v\n", r.URL.Path, r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "at", "token_type": "bearer", "expires_in": 14400, "refresh_token": "rt"}`))
+	}))
+	defer srv.Close()
+	target, _ := url.Parse(srv.URL)
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: &redirectTransport{target}})
+
+	tok, err := flow.Exchange(ctx, "code1", verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(verifiers) != 1 || verifiers[0] != verifier {
+		t.Errorf("Unexpected verifiers: %v\n", verifiers)
+	}
+	config := flow.Config(tok)
+	if config.Token != "at" || config.RefreshToken != "rt" || config.AppKey != "key" || config.TokenExpiry.IsZero() {
+		t.Errorf("Unexpected config: %+v\n", config)
+	}
+
+	// RunOutOfBand exchanges the pasted code with the verifier of the URL it
+	// printed.
+	var out bytes.Buffer
+	if _, tok, err = flow.RunOutOfBand(ctx, &out, strings.NewReader("code1\n")); err != nil || tok.AccessToken != "at" {
+		t.Fatalf("Unexpected result: %v %v\n", tok, err)
+	}
+	printed := strings.Fields(strings.SplitN(out.String(), "\n", 2)[0])
+	u, err = url.Parse(printed[len(printed)-1])
+	if err != nil || len(verifiers) != 2 {
+		t.Fatalf("Unexpected output: %q %v\n", out.String(), err)
+	}
+	if c := u.Query().Get("code_challenge"); c != oauthflow.Challenge(verifiers[1]) || c == challenge {
+		t.Errorf("Unexpected challenge: %v %v\n", u, verifiers[1])
+	}
+}
+
+// redirectTransport sends all requests to the server at url.
+type redirectTransport struct {
+	url *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host = t.url.Scheme, t.url.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
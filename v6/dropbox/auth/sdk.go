@@ -2,9 +2,15 @@ package auth
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"golang.org/x/oauth2"
 )
 
 // AuthAPIError wraps AuthError
@@ -13,23 +19,110 @@ type AuthAPIError struct {
 	AuthError *AuthError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AuthAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// FatalAuth reports that retrying can't fix the error, as the access token
+// was rejected; see dropbox.IsFatalAuth.
+func (e AuthAPIError) FatalAuth() bool {
+	return true
+}
+
+// MissingScopeError is returned instead of AuthAPIError when the access
+// token lacks the scope required by a route. The scope must be enabled in
+// the app console and the user must authorize the app again.
+type MissingScopeError struct {
+	AuthAPIError
+	// RequiredScope is the scope to add, e.g. "files.content.write".
+	RequiredScope string
+}
+
+// Unwrap returns the AuthAPIError of the error, so that errors.As matches
+// it as it did before missing scopes got their own error.
+func (e MissingScopeError) Unwrap() error {
+	return e.AuthAPIError
+}
+
+func (e MissingScopeError) Error() string {
+	return fmt.Sprintf("missing scope %q: %s", e.RequiredScope, e.ErrorSummary)
+}
+
+// GrantedScopes returns the scopes granted to tok, as returned along with it
+// by the token endpoint.
+func GrantedScopes(tok *oauth2.Token) []string {
+	scope, _ := tok.Extra("scope").(string)
+	return strings.Fields(scope)
+}
+
+// HasScopes reports whether granted includes all of required.
+func HasScopes(granted []string, required ...string) bool {
+	set := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		set[s] = true
+	}
+	for _, s := range required {
+		if !set[s] {
+			return false
+		}
+	}
+	return true
+}
+
 // AccessAPIError wraps AccessError
 type AccessAPIError struct {
 	dropbox.APIError
 	AccessError *AccessError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AccessAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// FatalAuth reports that retrying can't fix the error, as the user or team
+// lacks access; see dropbox.IsFatalAuth.
+func (e AccessAPIError) FatalAuth() bool {
+	return true
+}
+
 // RateLimitAPIError wraps RateLimitError
 type RateLimitAPIError struct {
 	dropbox.APIError
 	RateLimitError *RateLimitError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RateLimitAPIError) Unwrap() error {
+	return e.APIError
+}
+
+// Temporary reports that the request may be retried; see
+// dropbox.IsRetryable.
+func (e RateLimitAPIError) Temporary() bool {
+	return true
+}
+
+// RetryAfter returns the delay to wait before retrying, as requested by
+// Dropbox.
+func (e RateLimitAPIError) RetryAfter() time.Duration {
+	if e.RateLimitError == nil {
+		return 0
+	}
+	return time.Duration(e.RateLimitError.RetryAfter) * time.Second
+}
+
 // Bad input parameter.
 type BadRequest struct {
 	dropbox.APIError
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e BadRequest) Unwrap() error {
+	return e.APIError
+}
+
 // An error occurred on the Dropbox servers. Check status.dropbox.com for announcements about
 // Dropbox service issues.
 type ServerError struct {
@@ -37,7 +130,30 @@ type ServerError struct {
 	StatusCode int
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ServerError) Unwrap() error {
+	return e.APIError
+}
+
+// Temporary reports that the request may be retried; see
+// dropbox.IsRetryable.
+func (e ServerError) Temporary() bool {
+	return true
+}
+
+// ParseError converts err, as returned by dropbox.Context.Execute, to the
+// typed error of its HTTP status: BadRequest, AuthAPIError (or
+// MissingScopeError), AccessAPIError, RateLimitAPIError, ServerError, or
+// appError, the error of the route, for a 409. A failure to refresh the
+// access token, e.g. because the refresh token was revoked, is an
+// AuthAPIError tagged invalid_access_token. Other errors are returned
+// unchanged.
 func ParseError(err error, appError error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil && retrieveErr.Response.StatusCode < 500 {
+		return refreshError(retrieveErr)
+	}
+
 	sdkErr, ok := err.(dropbox.SDKInternalError)
 	if !ok {
 		return err
@@ -45,41 +161,45 @@ func ParseError(err error, appError error) error {
 
 	if sdkErr.StatusCode >= 500 && sdkErr.StatusCode <= 599 {
 		return ServerError{
-			APIError: dropbox.APIError{
-				ErrorSummary: sdkErr.Content,
-			},
+			APIError:   sdkErr.APIError(),
+			StatusCode: sdkErr.StatusCode,
 		}
 	}
 
 	switch sdkErr.StatusCode {
 	case http.StatusBadRequest:
 		return BadRequest{
-			APIError: dropbox.APIError{
-				ErrorSummary: sdkErr.Content,
-			},
+			APIError: sdkErr.APIError(),
 		}
 	case http.StatusUnauthorized:
-		var apiError AuthAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
+		apiError := AuthAPIError{APIError: sdkErr.APIError()}
+		if json.Unmarshal([]byte(sdkErr.Content), &apiError) != nil {
+			return apiError
+		}
+		if apiError.AuthError != nil && apiError.AuthError.MissingScope != nil {
+			return MissingScopeError{
+				AuthAPIError:  apiError,
+				RequiredScope: apiError.AuthError.MissingScope.RequiredScope,
+			}
 		}
 
 		return apiError
 	case http.StatusForbidden:
-		var apiError AccessAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
+		apiError := AccessAPIError{APIError: sdkErr.APIError()}
+		if json.Unmarshal([]byte(sdkErr.Content), &apiError) != nil {
+			return apiError
 		}
 
 		return apiError
 	case http.StatusTooManyRequests:
-		var apiError RateLimitAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
+		apiError := RateLimitAPIError{APIError: sdkErr.APIError()}
+		if json.Unmarshal([]byte(sdkErr.Content), &apiError) != nil {
+			return apiError
 		}
 
 		return apiError
 	case http.StatusConflict:
+		setAPIError(appError, sdkErr.APIError())
 		if pErr := json.Unmarshal([]byte(sdkErr.Content), appError); pErr != nil {
 			return pErr
 		}
@@ -89,3 +209,36 @@ func ParseError(err error, appError error) error {
 
 	return err
 }
+
+// setAPIError sets the dropbox.APIError embedded in appError, a pointer to
+// the error of a route, to base. The fields of base that aren't part of the
+// error body are thus kept when the body is parsed into appError.
+func setAPIError(appError error, base dropbox.APIError) {
+	v := reflect.ValueOf(appError)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	if f := v.Elem().FieldByName("APIError"); f.CanSet() && f.Type() == reflect.TypeOf(base) {
+		f.Set(reflect.ValueOf(base))
+	}
+}
+
+// refreshError converts the error returned by the token endpoint when
+// refreshing the access token to an AuthAPIError.
+func refreshError(err *oauth2.RetrieveError) AuthAPIError {
+	var body struct {
+		Error string `json:"error"`
+	}
+	summary := AuthErrorInvalidAccessToken + "/"
+	if json.Unmarshal(err.Body, &body) == nil && body.Error != "" {
+		summary += body.Error
+	}
+	return AuthAPIError{
+		APIError: dropbox.APIError{
+			ErrorSummary: summary,
+			Body:         string(err.Body),
+			StatusCode:   err.Response.StatusCode,
+		},
+		AuthError: &AuthError{Tagged: dropbox.Tagged{Tag: AuthErrorInvalidAccessToken}},
+	}
+}
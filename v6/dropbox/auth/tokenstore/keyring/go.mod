@@ -0,0 +1,22 @@
+module github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore/keyring
+
+go 1.18
+
+require (
+	github.com/dropbox/dropbox-sdk-go-unofficial/v6 v6.0.0-00010101000000-000000000000
+	github.com/zalando/go-keyring v0.2.3
+	golang.org/x/oauth2 v0.7.0
+)
+
+require (
+	github.com/alessio/shellescape v1.4.1 // indirect
+	github.com/danieljoos/wincred v1.2.0 // indirect
+	github.com/godbus/dbus/v5 v5.1.0 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/sys v0.8.0 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+)
+
+replace github.com/dropbox/dropbox-sdk-go-unofficial/v6 => ../../../..
@@ -0,0 +1,66 @@
+// Package keyring provides a dropbox.TokenStore keeping the token in the OS
+// keyring: the Keychain on macOS, the Credential Manager on Windows and the
+// Secret Service on Linux. It is a module of its own, so that the SDK
+// doesn't depend on the keyring libraries:
+//
+//	go get github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore/keyring
+//
+//	dbx := files.New(dropbox.Config{AppKey: appKey, TokenStore: keyring.New("my-app", accountID)})
+package keyring
+
+import (
+	"encoding/json"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore"
+	gokeyring "github.com/zalando/go-keyring"
+	"golang.org/x/oauth2"
+)
+
+var _ dropbox.TokenStore = (*Store)(nil)
+
+// Store stores a token in the OS keyring.
+type Store struct {
+	service string
+	user    string
+}
+
+// New returns a store that keeps the token in the keyring entry identified
+// by service and user, e.g. the app name and the account.
+func New(service string, user string) *Store {
+	return &Store{service: service, user: user}
+}
+
+// Load implements dropbox.TokenStore. It returns nil if there is no entry.
+func (s *Store) Load() (*oauth2.Token, error) {
+	v, err := gokeyring.Get(s.service, s.user)
+	if err == gokeyring.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tok oauth2.Token
+	if err = json.Unmarshal([]byte(v), &tok); err != nil {
+		return nil, tokenstore.ErrCorrupted
+	}
+	return &tok, nil
+}
+
+// Save implements dropbox.TokenStore.
+func (s *Store) Save(tok *oauth2.Token) error {
+	b, err := json.Marshal(tok)
+	if err != nil {
+		return err
+	}
+	return gokeyring.Set(s.service, s.user, string(b))
+}
+
+// Clear implements dropbox.TokenStore.
+func (s *Store) Clear() error {
+	err := gokeyring.Delete(s.service, s.user)
+	if err == gokeyring.ErrNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,49 @@
+package keyring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore/keyring"
+	gokeyring "github.com/zalando/go-keyring"
+	"golang.org/x/oauth2"
+)
+
+func TestStore(t *testing.T) {
+	gokeyring.MockInit()
+	store := keyring.New("my-app", "dbid:1")
+	if tok, err := store.Load(); tok != nil || err != nil {
+		t.Errorf("Unexpected token: %v %v\n", tok, err)
+	}
+
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := store.Save(&oauth2.Token{AccessToken: "at", RefreshToken: "rt", Expiry: expiry}); err != nil {
+		t.Fatal(err)
+	}
+	tok, err := store.Load()
+	if err != nil || tok.AccessToken != "at" || tok.RefreshToken != "rt" || !tok.Expiry.Equal(expiry) {
+		t.Errorf("Unexpected token: %+v %v\n", tok, err)
+	}
+	// Entries are per user.
+	if tok, err = keyring.New("my-app", "dbid:2").Load(); tok != nil || err != nil {
+		t.Errorf("Unexpected token: %v %v\n", tok, err)
+	}
+
+	if err = store.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if tok, err = store.Load(); tok != nil || err != nil {
+		t.Errorf("Unexpected token: %v %v\n", tok, err)
+	}
+	if err = store.Clear(); err != nil {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	if err = gokeyring.Set("my-app", "dbid:1", "not json"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.Load(); err != tokenstore.ErrCorrupted {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
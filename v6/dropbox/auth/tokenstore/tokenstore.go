@@ -0,0 +1,120 @@
+// Package tokenstore provides implementations of dropbox.TokenStore, so that
+// tools run repeatedly keep their credentials between runs.
+//
+//	store, err := tokenstore.NewEncryptedFile(path, key)
+//	...
+//	dbx := files.New(dropbox.Config{AppKey: appKey, TokenStore: store})
+package tokenstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"golang.org/x/oauth2"
+)
+
+// ErrCorrupted is returned when a stored token can't be decrypted or decoded,
+// e.g. because it was encrypted with a different key.
+var ErrCorrupted = errors.New("stored token is corrupted")
+
+var _ dropbox.TokenStore = (*EncryptedFile)(nil)
+
+// EncryptedFile stores a token as JSON in a file, encrypted with AES-GCM.
+type EncryptedFile struct {
+	path string
+	aead cipher.AEAD
+}
+
+// NewEncryptedFile returns a store that keeps the token in the file at path,
+// encrypted with key, which must be 16, 24 or 32 bytes long.
+func NewEncryptedFile(path string, key []byte) (*EncryptedFile, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedFile{path: path, aead: aead}, nil
+}
+
+// Load implements dropbox.TokenStore. It returns nil if the file doesn't
+// exist.
+func (f *EncryptedFile) Load() (*oauth2.Token, error) {
+	b, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	n := f.aead.NonceSize()
+	if len(b) < n {
+		return nil, ErrCorrupted
+	}
+	plain, err := f.aead.Open(nil, b[:n], b[n:], nil)
+	if err != nil {
+		return nil, ErrCorrupted
+	}
+	return decode(plain)
+}
+
+// Save implements dropbox.TokenStore. The file is replaced atomically and is
+// only readable by the current user.
+func (f *EncryptedFile) Save(tok *oauth2.Token) error {
+	plain, err := json.Marshal(tok)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	return writeFile(f.path, f.aead.Seal(nonce, nonce, plain, nil))
+}
+
+// Clear implements dropbox.TokenStore.
+func (f *EncryptedFile) Clear() error {
+	err := os.Remove(f.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func decode(b []byte) (*oauth2.Token, error) {
+	var tok oauth2.Token
+	if err := json.Unmarshal(b, &tok); err != nil {
+		return nil, ErrCorrupted
+	}
+	return &tok, nil
+}
+
+// writeFile writes b to a temporary file that is then renamed to path.
+func writeFile(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err = tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,69 @@
+package tokenstore_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth/tokenstore"
+	"golang.org/x/oauth2"
+)
+
+func TestEncryptedFile(t *testing.T) {
+	if _, err := tokenstore.NewEncryptedFile("token", []byte("short")); err == nil {
+		t.Error("Invalid key accepted")
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	key := bytes.Repeat([]byte{1}, 32)
+	store, err := tokenstore.NewEncryptedFile(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok, err := store.Load(); tok != nil || err != nil {
+		t.Errorf("Unexpected token before Save: %v, %v\n", tok, err)
+	}
+
+	if err = store.Save(&oauth2.Token{AccessToken: "access", RefreshToken: "refresh"}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte("refresh")) {
+		t.Error("Token stored in the clear")
+	}
+	if info, err := os.Stat(path); err != nil || (runtime.GOOS != "windows" && info.Mode().Perm() != 0600) {
+		t.Errorf("Unexpected mode: %v, %v\n", info.Mode(), err)
+	}
+	tok, err := store.Load()
+	if err != nil || tok.AccessToken != "access" || tok.RefreshToken != "refresh" {
+		t.Errorf("Unexpected token: %+v, %v\n", tok, err)
+	}
+
+	other, err := tokenstore.NewEncryptedFile(path, bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = other.Load(); err != tokenstore.ErrCorrupted {
+		t.Errorf("Unexpected error with another key: %v\n", err)
+	}
+	if err = os.WriteFile(path, []byte("short"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.Load(); err != tokenstore.ErrCorrupted {
+		t.Errorf("Unexpected error for a truncated file: %v\n", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err = store.Clear(); err != nil {
+			t.Errorf("Unexpected error clearing: %v\n", err)
+		}
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("File not removed: %v\n", err)
+	}
+}
@@ -92,15 +92,10 @@ const (
 
 // UnmarshalJSON deserializes into a AuthError instance
 func (u *AuthError) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "missing_scope":
 		if err = json.Unmarshal(body, &u.MissingScope); err != nil {
@@ -0,0 +1,224 @@
+// Package chaos injects failures into the requests of a client, so that
+// applications can test how their retry and backoff handling copes with
+// rate limits, server errors, timeouts and truncated responses.
+//
+//	t := &chaos.Transport{Schedule: chaos.Every(3, chaos.RateLimit)}
+//	config.Transport = t
+//	dbx := files.New(config)
+package chaos
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fault is a failure injected into a request.
+type Fault uint
+
+const (
+	// None sends the request unchanged.
+	None Fault = iota
+	// RateLimit answers the request with a 429 and a too_many_requests
+	// error, without sending it.
+	RateLimit
+	// ServerError answers the request with a 500, without sending it.
+	ServerError
+	// Timeout fails the request with a timeout error after
+	// Transport.Delay, without sending it.
+	Timeout
+	// Truncated sends the request but cuts the response body in half,
+	// reading it then fails with io.ErrUnexpectedEOF.
+	Truncated
+)
+
+var faultNames = map[Fault]string{
+	None:        "none",
+	RateLimit:   "rate_limit",
+	ServerError: "server_error",
+	Timeout:     "timeout",
+	Truncated:   "truncated",
+}
+
+func (f Fault) String() string {
+	if name, ok := faultNames[f]; ok {
+		return name
+	}
+	return "fault(" + strconv.Itoa(int(f)) + ")"
+}
+
+// Schedule returns the fault to inject into the n-th request sent through a
+// Transport, starting at 1.
+type Schedule func(n int, req *http.Request) Fault
+
+// Sequence injects faults[n-1] into the n-th request, and no fault once
+// faults are exhausted.
+func Sequence(faults ...Fault) Schedule {
+	return func(n int, req *http.Request) Fault {
+		if n > len(faults) {
+			return None
+		}
+		return faults[n-1]
+	}
+}
+
+// Every injects fault into every k-th request.
+func Every(k int, fault Fault) Schedule {
+	return func(n int, req *http.Request) Fault {
+		if k <= 0 || n%k != 0 {
+			return None
+		}
+		return fault
+	}
+}
+
+// Random injects one of faults, drawn uniformly, into each request with
+// probability p. A non-zero seed makes the schedule reproducible.
+func Random(seed int64, p float64, faults ...Fault) Schedule {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	var mu sync.Mutex
+	return func(n int, req *http.Request) Fault {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(faults) == 0 || rnd.Float64() >= p {
+			return None
+		}
+		return faults[rnd.Intn(len(faults))]
+	}
+}
+
+// Route applies s to the requests to the routes whose URL path ends with
+// route, e.g. "files/upload", and injects no fault into other requests. n
+// counts all requests.
+func Route(route string, s Schedule) Schedule {
+	return func(n int, req *http.Request) Fault {
+		if !strings.HasSuffix(req.URL.Path, "/"+strings.TrimPrefix(route, "/")) {
+			return None
+		}
+		return s(n, req)
+	}
+}
+
+// Transport is an http.RoundTripper injecting the faults of a Schedule, to
+// be set as dropbox.Config.Transport or as the Transport of an http.Client.
+// It is safe for concurrent use.
+type Transport struct {
+	// Base sends the requests. Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+	// Schedule selects the faults. A nil Schedule injects none.
+	Schedule Schedule
+	// RetryAfter is the delay requested by injected rate limits, in
+	// seconds.
+	RetryAfter int
+	// Delay is how long injected timeouts wait before failing.
+	Delay time.Duration
+
+	mu       sync.Mutex
+	n        int
+	injected map[Fault]int
+}
+
+// Injected returns the number of times fault was injected.
+func (t *Transport) Injected(fault Fault) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected[fault]
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault := t.next(req)
+	switch fault {
+	case RateLimit:
+		body := fmt.Sprintf(`{"error_summary": "too_many_requests/", "error": {"reason": {".tag": "too_many_requests"}, "retry_after": %d}}`, t.RetryAfter)
+		resp := response(req, http.StatusTooManyRequests, "application/json", body)
+		resp.Header.Set("Retry-After", strconv.Itoa(t.RetryAfter))
+		return resp, nil
+	case ServerError:
+		return response(req, http.StatusInternalServerError, "text/plain; charset=utf-8", "Internal Server Error"), nil
+	case Timeout:
+		closeBody(req)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(t.Delay):
+		}
+		return nil, timeoutError{}
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil || fault != Truncated {
+		return resp, err
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(data[:len(data)/2]), errReader{io.ErrUnexpectedEOF}))
+	return resp, nil
+}
+
+func (t *Transport) next(req *http.Request) Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.n++
+	fault := None
+	if t.Schedule != nil {
+		fault = t.Schedule(t.n, req)
+	}
+	if t.injected == nil {
+		t.injected = make(map[Fault]int)
+	}
+	t.injected[fault]++
+	return fault
+}
+
+func response(req *http.Request, status int, contentType string, body string) *http.Response {
+	closeBody(req)
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {contentType}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// closeBody closes the body of a request that isn't sent, as RoundTrip
+// has to.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// timeoutError is the error of injected timeouts. Like the errors of
+// net/http timeouts, it implements net.Error.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "chaos: injected timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+type errReader struct{ err error }
+
+func (r errReader) Read(p []byte) (int, error) { return 0, r.err }
@@ -0,0 +1,46 @@
+package chaos_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/chaos"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+)
+
+func TestChaosTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"result": "hello"}`))
+		}))
+	defer srv.Close()
+
+	transport := &chaos.Transport{Base: srv.Client().Transport,
+		Schedule: chaos.Sequence(chaos.ServerError, chaos.RateLimit, chaos.None, chaos.Truncated, chaos.Timeout)}
+	config := dropbox.Config{Client: &http.Client{Transport: transport}, LogLevel: dropbox.LogDebug,
+		RetryPolicy: &dropbox.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := check.New(config)
+	res, err := dbx.User(&check.EchoArg{Query: "hello"})
+	if err != nil || res.Result != "hello" {
+		t.Errorf("Unexpected result: %+v %v\n", res, err)
+	}
+	if transport.Injected(chaos.ServerError) != 1 || transport.Injected(chaos.RateLimit) != 1 {
+		t.Errorf("Unexpected faults: %v %v\n", transport.Injected(chaos.ServerError), transport.Injected(chaos.RateLimit))
+	}
+	if _, err = dbx.User(&check.EchoArg{Query: "hello"}); err == nil {
+		t.Errorf("Unexpected success of truncated response\n")
+	}
+	_, err = dbx.User(&check.EchoArg{Query: "hello"})
+	if ne, ok := errors.Unwrap(err).(net.Error); !ok || !ne.Timeout() {
+		t.Errorf("Unexpected timeout error: %v\n", err)
+	}
+}
@@ -0,0 +1,12 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package check
+
+// WithQuery sets Query and returns u
+func (u *EchoArg) WithQuery(Query string) *EchoArg {
+	u.Query = Query
+	return u
+}
@@ -58,6 +58,11 @@ type AppAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AppAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) AppContext(ctx context.Context, arg *EchoArg) (res *EchoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -100,6 +105,11 @@ type UserAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UserContext(ctx context.Context, arg *EchoArg) (res *EchoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+)
+
+// ErrEchoMismatch is returned by HealthCheck when the query isn't echoed
+// back unchanged.
+var ErrEchoMismatch = errors.New("check: query not echoed back")
+
+// Health describes the credentials and account context of a Config that
+// passed HealthCheck.
+type Health struct {
+	// AuthType is the authentication checked, `check/app` for
+	// dropbox.AuthTypeApp and `check/user` otherwise.
+	AuthType dropbox.AuthType
+	// Scopes are the scopes granted to the access token, if known. They are
+	// only known when the token comes from Config.TokenSource along with
+	// them, e.g. a token just returned by the token endpoint.
+	Scopes []string
+	// AsMemberID, AsAdminID and PathRoot are those of the Config.
+	AsMemberID string
+	AsAdminID  string
+	PathRoot   string
+	// Latency is the duration of the check request.
+	Latency time.Duration
+}
+
+// HealthCheck verifies that requests made with config reach Dropbox and are
+// authenticated, calling `check/app` or `check/user` depending on
+// config.AuthType with a unique query that has to be echoed back. It is
+// meant as a startup or readiness probe for services.
+func HealthCheck(ctx context.Context, config dropbox.Config) (*Health, error) {
+	client := New(config)
+	arg := NewEchoArg()
+	arg.Query = "health-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	h := &Health{
+		AuthType:   config.AuthType,
+		AsMemberID: config.AsMemberID,
+		AsAdminID:  config.AsAdminID,
+		PathRoot:   config.PathRoot,
+	}
+	start := time.Now()
+	var res *EchoResult
+	var err error
+	if config.AuthType == dropbox.AuthTypeApp {
+		res, err = client.AppContext(ctx, arg)
+	} else {
+		res, err = client.UserContext(ctx, arg)
+	}
+	h.Latency = time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result != arg.Query {
+		return nil, ErrEchoMismatch
+	}
+
+	if config.AuthType != dropbox.AuthTypeApp && config.TokenSource != nil {
+		if tok, err := config.TokenSource.Token(); err == nil {
+			h.Scopes = auth.GrantedScopes(tok)
+		}
+	}
+	return h, nil
+}
@@ -0,0 +1,61 @@
+package check_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+	"golang.org/x/oauth2"
+)
+
+func TestHealthCheck(t *testing.T) {
+	var paths []string
+	echo := true
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			var arg check.EchoArg
+			if err := json.NewDecoder(r.Body).Decode(&arg); err != nil {
+				t.Errorf("Unexpected body: %v\n", err)
+			}
+			if !echo {
+				arg.Query = ""
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(check.EchoResult{Result: arg.Query})
+		}))
+	defer srv.Close()
+
+	tok := (&oauth2.Token{AccessToken: "token"}).WithExtra(map[string]interface{}{"scope": "account_info.read files.metadata.read"})
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug, TokenSource: oauth2.StaticTokenSource(tok),
+		AsMemberID: "dbmid:member",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	h, err := check.HealthCheck(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.AsMemberID != "dbmid:member" || !reflect.DeepEqual(h.Scopes, []string{"account_info.read", "files.metadata.read"}) {
+		t.Errorf("Unexpected health: %+v\n", h)
+	}
+
+	config.AuthType = dropbox.AuthTypeApp
+	config.AppKey, config.AppSecret = "key", "secret"
+	if h, err = check.HealthCheck(context.Background(), config); err != nil || h.Scopes != nil {
+		t.Errorf("Unexpected app health: %+v %v\n", h, err)
+	}
+	if !reflect.DeepEqual(paths, []string{"/check/user", "/check/app"}) {
+		t.Errorf("Unexpected paths: %v\n", paths)
+	}
+
+	echo = false
+	if _, err = check.HealthCheck(context.Background(), config); err != check.ErrEchoMismatch {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
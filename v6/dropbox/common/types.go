@@ -22,6 +22,7 @@
 package common
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
@@ -160,15 +161,10 @@ const (
 
 // UnmarshalJSON deserializes into a rootInfoUnion instance
 func (u *rootInfoUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "team":
 		if err = json.Unmarshal(body, &u.Team); err != nil {
@@ -198,7 +194,33 @@ func IsRootInfoFromJSON(data []byte) (IsRootInfo, error) {
 		return t.User, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownRootInfo{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.RootInfo); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownRootInfo is a RootInfo whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsRootInfoFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownRootInfo struct {
+	RootInfo
+	// Tag is the unknown `.tag` of the RootInfo.
+	Tag string
+	// Raw is the JSON the RootInfo was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownRootInfo) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.RootInfo)
 }
 
 // TeamRootInfo : Root info when user is member of a team with a separate root
@@ -50,6 +50,11 @@ type DeleteManualContactsAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteManualContactsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteManualContactsContext(ctx context.Context) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -88,6 +93,11 @@ type DeleteManualContactsBatchAPIError struct {
 	EndpointError *DeleteManualContactsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteManualContactsBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteManualContactsBatchContext(ctx context.Context, arg *DeleteManualContactsArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
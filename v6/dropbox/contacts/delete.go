@@ -0,0 +1,89 @@
+package contacts
+
+import (
+	"context"
+	"strings"
+)
+
+// deleteBatchSize is the number of contacts deleted per
+// `delete_manual_contacts_batch` request.
+const deleteBatchSize = 100
+
+// DeleteOutcome is the result of deleting a single manual contact.
+type DeleteOutcome struct {
+	Email string
+	// NotFound is set if the address isn't a manually added contact.
+	NotFound bool
+	// Err is set if the request deleting the contact failed.
+	Err error
+}
+
+// Deleted reports whether the contact was deleted.
+func (o *DeleteOutcome) Deleted() bool {
+	return !o.NotFound && o.Err == nil
+}
+
+// DeleteContacts deletes the manually added contacts with the addresses
+// emails, in batches of 100. As Dropbox cancels a whole batch if one of its
+// addresses isn't a manual contact, such batches are retried without the
+// addresses it reported. Every address gets an outcome, in the order of
+// emails.
+func DeleteContacts(ctx context.Context, client Client, emails []string) []*DeleteOutcome {
+	outcomes := make([]*DeleteOutcome, len(emails))
+	for i, email := range emails {
+		outcomes[i] = &DeleteOutcome{Email: email}
+	}
+	for start := 0; start < len(outcomes); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(outcomes) {
+			end = len(outcomes)
+		}
+		deleteBatch(ctx, client, outcomes[start:end])
+	}
+	return outcomes
+}
+
+func deleteBatch(ctx context.Context, client Client, batch []*DeleteOutcome) {
+	for len(batch) > 0 {
+		emails := make([]string, len(batch))
+		for i, o := range batch {
+			emails[i] = o.Email
+		}
+		err := client.DeleteManualContactsBatchContext(ctx, NewDeleteManualContactsArg(emails))
+		if err == nil {
+			return
+		}
+		apiErr, ok := err.(DeleteManualContactsBatchAPIError)
+		if !ok || apiErr.EndpointError == nil || len(apiErr.EndpointError.ContactsNotFound) == 0 {
+			for _, o := range batch {
+				o.Err = err
+			}
+			return
+		}
+		notFound := make(map[string]bool)
+		for _, email := range apiErr.EndpointError.ContactsNotFound {
+			notFound[strings.ToLower(email)] = true
+		}
+		var retry []*DeleteOutcome
+		for _, o := range batch {
+			if notFound[strings.ToLower(o.Email)] {
+				o.NotFound = true
+			} else {
+				retry = append(retry, o)
+			}
+		}
+		if len(retry) == len(batch) {
+			for _, o := range batch {
+				o.Err = err
+			}
+			return
+		}
+		batch = retry
+	}
+}
+
+// DeleteAllContacts deletes all the manually added contacts of the account
+// with `delete_manual_contacts`.
+func DeleteAllContacts(ctx context.Context, client Client) error {
+	return client.DeleteManualContactsContext(ctx)
+}
@@ -0,0 +1,49 @@
+package contacts_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contacts"
+)
+
+func TestDeleteContacts(t *testing.T) {
+	var batches [][]string
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg contacts.DeleteManualContactsArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			batches = append(batches, arg.EmailAddresses)
+			for _, email := range arg.EmailAddresses {
+				if email == "auto@example.com" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"error_summary": "contacts_not_found/", "error": {".tag": "contacts_not_found", "contacts_not_found": ["auto@example.com"]}}`))
+					return
+				}
+			}
+			_, _ = w.Write([]byte(`null`))
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	emails := []string{"auto@example.com"}
+	for i := 0; i < 150; i++ {
+		emails = append(emails, fmt.Sprintf("c%d@example.com", i))
+	}
+	res := contacts.DeleteContacts(context.Background(), contacts.New(config), emails)
+	if len(res) != 151 || !res[0].NotFound || res[0].Deleted() || !res[1].Deleted() || !res[150].Deleted() {
+		t.Errorf("Unexpected outcomes: %+v %+v\n", res[0], res[1])
+	}
+	if len(batches) != 3 || len(batches[0]) != 100 || len(batches[1]) != 99 || len(batches[2]) != 51 {
+		t.Errorf("Unexpected batches: %d\n", len(batches))
+	}
+}
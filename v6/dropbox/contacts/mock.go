@@ -0,0 +1,61 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package contacts
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	DeleteManualContactsFunc      func(ctx context.Context) (err error)
+	DeleteManualContactsBatchFunc func(ctx context.Context, arg *DeleteManualContactsArg) (err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) DeleteManualContactsContext(ctx context.Context) (err error) {
+	if m.DeleteManualContactsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteManualContactsFunc(ctx)
+}
+
+func (m *Mock) DeleteManualContacts() (err error) {
+	return m.DeleteManualContactsContext(context.Background())
+}
+
+func (m *Mock) DeleteManualContactsBatchContext(ctx context.Context, arg *DeleteManualContactsArg) (err error) {
+	if m.DeleteManualContactsBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteManualContactsBatchFunc(ctx, arg)
+}
+
+func (m *Mock) DeleteManualContactsBatch(arg *DeleteManualContactsArg) (err error) {
+	return m.DeleteManualContactsBatchContext(context.Background(), arg)
+}
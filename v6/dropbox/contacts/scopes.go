@@ -0,0 +1,12 @@
+package contacts
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"delete_manual_contacts":       "contacts.write",
+	"delete_manual_contacts_batch": "contacts.write",
+}
@@ -0,0 +1,117 @@
+// Package contenthash computes the Dropbox content hash, as found in
+// files.FileMetadata.ContentHash, to check the integrity of uploaded and
+// downloaded content. The content is split into 4 MiB blocks; the content
+// hash is the SHA-256 of the concatenated SHA-256 of every block, encoded as
+// hex. See https://www.dropbox.com/developers/reference/content-hash.
+//
+//	if err := contenthash.VerifyFile("backup.tar", res.ContentHash); err != nil {
+//		...
+//	}
+package contenthash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"os"
+)
+
+const (
+	// BlockSize is the size of the blocks hashed separately.
+	BlockSize = 4 << 20
+	// Size is the size of a content hash in bytes, before hex encoding.
+	Size = sha256.Size
+)
+
+// ErrMismatch is returned when content doesn't match its content hash.
+var ErrMismatch = errors.New("content does not match its content hash")
+
+type digest struct {
+	sums  []byte
+	block hash.Hash
+	n     int
+}
+
+// New returns a hash.Hash computing the content hash. Its Sum method
+// returns the raw hash; use Sum or hex.EncodeToString for the hex form of
+// FileMetadata.ContentHash.
+func New() hash.Hash {
+	return &digest{block: sha256.New()}
+}
+
+func (d *digest) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		n := BlockSize - d.n
+		if n > len(p) {
+			n = len(p)
+		}
+		d.block.Write(p[:n])
+		d.n += n
+		p = p[n:]
+		if d.n == BlockSize {
+			d.sums = d.block.Sum(d.sums)
+			d.block.Reset()
+			d.n = 0
+		}
+	}
+	return written, nil
+}
+
+func (d *digest) Sum(b []byte) []byte {
+	sums := d.sums
+	if d.n > 0 {
+		sums = d.block.Sum(sums[:len(sums):len(sums)])
+	}
+	sum := sha256.Sum256(sums)
+	return append(b, sum[:]...)
+}
+
+func (d *digest) Reset() {
+	d.sums = d.sums[:0]
+	d.block.Reset()
+	d.n = 0
+}
+
+func (d *digest) Size() int {
+	return Size
+}
+
+func (d *digest) BlockSize() int {
+	return BlockSize
+}
+
+// Sum returns the hex-encoded content hash of the content read from r.
+func Sum(r io.Reader) (string, error) {
+	h := New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Verify returns ErrMismatch unless the content read from r matches
+// contentHash.
+func Verify(r io.Reader, contentHash string) error {
+	sum, err := Sum(r)
+	if err != nil {
+		return err
+	}
+	if sum != contentHash {
+		return ErrMismatch
+	}
+	return nil
+}
+
+// VerifyFile returns ErrMismatch unless the content of the local file name
+// matches contentHash.
+func VerifyFile(name string, contentHash string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return Verify(f, contentHash)
+}
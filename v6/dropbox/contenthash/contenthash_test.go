@@ -0,0 +1,42 @@
+package contenthash_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+)
+
+func TestContentHash(t *testing.T) {
+	for _, size := range []int{0, 1, 4 << 20, 4<<20 + 1, 9<<20 + 7} {
+		content := bytes.Repeat([]byte{'a', 'b', 'c'}, size/3+1)[:size]
+		want := contentHash(content)
+		// Write in pieces that straddle block boundaries.
+		h := contenthash.New()
+		for b := content; len(b) > 0; {
+			n := 3<<20 + 5
+			if n > len(b) {
+				n = len(b)
+			}
+			h.Write(b[:n])
+			b = b[n:]
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != want {
+			t.Errorf("Size %d: got %v, want %v\n", size, got, want)
+		}
+
+		name := filepath.Join(t.TempDir(), "a")
+		if err := os.WriteFile(name, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := contenthash.VerifyFile(name, want); err != nil {
+			t.Errorf("Size %d: %v\n", size, err)
+		}
+		if err := contenthash.Verify(bytes.NewReader(append(content, 'x')), want); err != contenthash.ErrMismatch {
+			t.Errorf("Size %d: unexpected error %v\n", size, err)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package contenthash_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// contentHash computes the content hash of b from its definition.
+func contentHash(b []byte) string {
+	var sums []byte
+	for len(b) > 0 {
+		n := 4 << 20
+		if n > len(b) {
+			n = len(b)
+		}
+		sum := sha256.Sum256(b[:n])
+		sums, b = append(sums, sum[:]...), b[n:]
+	}
+	sum := sha256.Sum256(sums)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,315 @@
+// Package dbsync mirrors a local directory and a Dropbox folder one way, as
+// done by backups: files missing from the destination, or differing from
+// the source in size or content hash, are transferred, and files missing
+// from the source can be deleted from the destination. Only files are
+// compared; empty folders are neither created nor deleted.
+//
+//	actions, err := dbsync.Run(ctx, dbx, "/home/me/photos", "/Backup/photos",
+//		&dbsync.Options{Direction: dbsync.Upload, Delete: true})
+//
+// dbsync is experimental and requires experimental.Sync to be enabled.
+package dbsync
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// Direction is the direction in which files are copied.
+type Direction int
+
+// Directions of a sync
+const (
+	// Upload copies the local directory to Dropbox.
+	Upload Direction = iota
+	// Download copies the Dropbox folder to the local directory.
+	Download
+)
+
+// Options controls Run.
+type Options struct {
+	Direction Direction
+	// Delete deletes the files of the destination missing from the source.
+	Delete bool
+	// DryRun only computes the actions, without performing them.
+	DryRun bool
+	// Concurrency is the number of files transferred or deleted in
+	// parallel. Defaults to 4.
+	Concurrency int
+}
+
+// Op is the operation of an Action.
+type Op int
+
+// Operations of an Action
+const (
+	OpUpload Op = iota
+	OpDownload
+	OpDelete
+)
+
+func (o Op) String() string {
+	switch o {
+	case OpUpload:
+		return "upload"
+	case OpDownload:
+		return "download"
+	case OpDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Action is an operation on a single file.
+type Action struct {
+	Op Op
+	// Path is the path of the file relative to both the local directory and
+	// the Dropbox folder, with forward slashes.
+	Path string
+	// Size is the size of the source file, or of the deleted file.
+	Size int64
+	// Err is set if the action failed.
+	Err error
+}
+
+// localFile and remoteFile are the files found on either side. name and
+// path are relative, with forward slashes.
+type localFile struct {
+	name    string
+	file    string
+	size    int64
+	modTime time.Time
+}
+
+type remoteFile struct {
+	path        string
+	size        int64
+	contentHash string
+}
+
+// Run syncs the local directory dir and the Dropbox folder path, "" for the
+// whole account, in the direction of opts, and returns the actions performed,
+// or those that would be with opts.DryRun, ordered by path. Failures of
+// individual actions are reported in the actions, those not started when
+// ctx is cancelled failing with its error. The returned error is only set
+// if either side can't be listed, the source doesn't exist, or
+// experimental.Sync isn't enabled. A missing destination is empty.
+func Run(ctx context.Context, client files.Client, dir string, path string, opts *Options) ([]*Action, error) {
+	if err := files.CheckExperimental(client, experimental.Sync); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+	path = strings.TrimSuffix(path, "/")
+	// The source must exist: taking a mistyped source for an empty one would
+	// delete every file of the destination with opts.Delete.
+	local, err := listLocal(dir, opts.Direction == Download)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := listRemote(ctx, client, path, opts.Direction == Upload)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []*Action
+	if opts.Direction == Upload {
+		for key, l := range local {
+			if r, ok := remote[key]; !ok || !sameContent(l, r) {
+				actions = append(actions, &Action{Op: OpUpload, Path: l.name, Size: l.size})
+			}
+		}
+		for key, r := range remote {
+			if _, ok := local[key]; !ok && opts.Delete {
+				actions = append(actions, &Action{Op: OpDelete, Path: r.path, Size: r.size})
+			}
+		}
+	} else {
+		for key, r := range remote {
+			if l, ok := local[key]; !ok || !sameContent(l, r) {
+				actions = append(actions, &Action{Op: OpDownload, Path: r.path, Size: r.size})
+			}
+		}
+		for key, l := range local {
+			if _, ok := remote[key]; !ok && opts.Delete {
+				actions = append(actions, &Action{Op: OpDelete, Path: l.name, Size: l.size})
+			}
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].Path < actions[j].Path })
+	if opts.DryRun {
+		return actions, nil
+	}
+
+	s := &syncer{client: client, dir: dir, path: path, opts: opts, local: local,
+		uploader: files.NewUploader(client), downloader: files.NewDownloader(client)}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for _, a := range actions {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			// The actions not started fail with the error of ctx.
+			a.Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(a *Action) {
+			defer func() { <-sem; wg.Done() }()
+			a.Err = s.perform(ctx, a)
+		}(a)
+	}
+	wg.Wait()
+	return actions, nil
+}
+
+// listLocal returns the regular files under dir keyed by lowercase relative
+// path, as Dropbox paths are case-insensitive. A missing dir is empty if
+// missingOK, an error otherwise.
+func listLocal(dir string, missingOK bool) (map[string]*localFile, error) {
+	res := make(map[string]*localFile)
+	err := filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if name == dir && missingOK && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		res[strings.ToLower(rel)] = &localFile{name: rel, file: name, size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	return res, err
+}
+
+// listRemote returns the files under path keyed by lowercase relative path.
+// A missing path is empty if missingOK, an error otherwise.
+func listRemote(ctx context.Context, client files.Client, path string, missingOK bool) (map[string]*remoteFile, error) {
+	res := make(map[string]*remoteFile)
+	prefix := strings.ToLower(path) + "/"
+	err := files.Walk(ctx, client, path, func(entry files.IsMetadata) error {
+		f, ok := entry.(*files.FileMetadata)
+		if !ok || !strings.HasPrefix(f.PathLower, prefix) {
+			return nil
+		}
+		rel := f.PathLower[len(prefix):]
+		if len(f.PathDisplay) == len(f.PathLower) {
+			rel = f.PathDisplay[len(prefix):]
+		}
+		res[strings.ToLower(rel)] = &remoteFile{path: rel, size: int64(f.Size), contentHash: f.ContentHash}
+		return nil
+	}, nil)
+	if e, ok := err.(files.ListFolderAPIError); ok && missingOK && e.EndpointError != nil && e.EndpointError.Path != nil &&
+		e.EndpointError.Path.Tag == files.LookupErrorNotFound {
+		return res, nil
+	}
+	return res, err
+}
+
+// sameContent reports whether l and r have the same content, hashing l only
+// if their sizes match.
+func sameContent(l *localFile, r *remoteFile) bool {
+	if l.size != r.size || r.contentHash == "" {
+		return false
+	}
+	return contenthash.VerifyFile(l.file, r.contentHash) == nil
+}
+
+type syncer struct {
+	client     files.Client
+	dir        string
+	path       string
+	opts       *Options
+	local      map[string]*localFile
+	uploader   *files.Uploader
+	downloader *files.Downloader
+}
+
+func (s *syncer) perform(ctx context.Context, a *Action) error {
+	localName := filepath.Join(s.dir, filepath.FromSlash(a.Path))
+	remotePath := s.path + "/" + a.Path
+	switch {
+	case a.Op == OpUpload:
+		return s.upload(ctx, remotePath, s.local[strings.ToLower(a.Path)])
+	case a.Op == OpDownload:
+		return s.download(ctx, remotePath, localName)
+	case s.opts.Direction == Upload:
+		_, err := s.client.DeleteV2Context(ctx, files.NewDeleteArg(remotePath))
+		return err
+	default:
+		return os.Remove(localName)
+	}
+}
+
+// download downloads the file at path to a temporary file next to name,
+// renamed to name once complete, so that a failed download leaves the local
+// file as it was.
+func (s *syncer) download(ctx context.Context, path string, name string) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	mode := fs.FileMode(0644)
+	if info, err := os.Stat(name); err == nil {
+		mode = info.Mode().Perm()
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, _, err = s.downloader.Download(ctx, path, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), mode)
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *syncer) upload(ctx context.Context, path string, l *localFile) error {
+	f, err := os.Open(l.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	commit := files.NewCommitInfo(path)
+	commit.Mode = &files.WriteMode{Tagged: dropbox.Tagged{Tag: files.WriteModeOverwrite}}
+	modified := l.modTime.UTC().Truncate(time.Second)
+	commit.ClientModified = &modified
+	_, _, err = s.uploader.Upload(ctx, f, l.size, commit)
+	return err
+}
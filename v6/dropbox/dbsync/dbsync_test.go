@@ -0,0 +1,257 @@
+package dbsync_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dbsync"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestSync(t *testing.T) {
+	var mu sync.Mutex
+	remote := map[string][]byte{"/backup/stale.txt": []byte("stale"), "/backup/same.txt": []byte("same")}
+	uploads := 0
+	entry := func(path string, b []byte) string {
+		return fmt.Sprintf(`{".tag": "file", "name": %q, "path_lower": %q, "path_display": %q, "rev": "0123456789a", "size": %d, "content_hash": %q}`,
+			filepath.Base(path), strings.ToLower(path), path, len(b), contentHash(b))
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/list_folder":
+				var entries []string
+				for path, b := range remote {
+					entries = append(entries, entry(path, b))
+				}
+				fmt.Fprintf(w, `{"entries": [%s], "cursor": "c", "has_more": false}`, strings.Join(entries, ","))
+			case "/files/upload":
+				var arg files.CommitInfo
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+				remote[arg.Path] = body
+				uploads++
+				_, _ = w.Write([]byte(entry(arg.Path, body)))
+			case "/files/delete_v2":
+				var arg files.DeleteArg
+				_ = json.Unmarshal(body, &arg)
+				b := remote[arg.Path]
+				delete(remote, arg.Path)
+				fmt.Fprintf(w, `{"metadata": %s}`, entry(arg.Path, b))
+			case "/files/download":
+				var arg files.DownloadArg
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+				b := remote[arg.Path]
+				w.Header().Set("Dropbox-API-Result", entry(arg.Path, b))
+				_, _ = w.Write(b)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	_ = os.WriteFile(filepath.Join(dir, "same.txt"), []byte("same"), 0644)
+	_ = os.WriteFile(filepath.Join(dir, "sub", "new.txt"), []byte("new"), 0644)
+
+	opts := &dbsync.Options{Direction: dbsync.Upload, Delete: true, DryRun: true}
+	_, err := dbsync.Run(context.Background(), files.New(config), dir, "/backup", opts)
+	if err != (experimental.DisabledError{Feature: experimental.Sync}) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	config.Experimental = []experimental.Feature{experimental.Sync}
+	dbx := files.New(config)
+	actions, err := dbsync.Run(context.Background(), dbx, dir, "/backup", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plan []string
+	for _, a := range actions {
+		plan = append(plan, a.Op.String()+" "+a.Path)
+	}
+	if strings.Join(plan, ",") != "delete stale.txt,upload sub/new.txt" || uploads != 0 {
+		t.Errorf("Unexpected plan: %v\n", plan)
+	}
+
+	opts.DryRun = false
+	if actions, err = dbsync.Run(context.Background(), dbx, dir, "/backup", opts); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range actions {
+		if a.Err != nil {
+			t.Errorf("%s %s: %v\n", a.Op, a.Path, a.Err)
+		}
+	}
+	if len(remote) != 2 || string(remote["/backup/sub/new.txt"]) != "new" {
+		t.Errorf("Unexpected remote files: %v\n", remote)
+	}
+
+	restored := t.TempDir()
+	opts.Direction = dbsync.Download
+	if actions, err = dbsync.Run(context.Background(), dbx, restored, "/backup", opts); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := os.ReadFile(filepath.Join(restored, "sub", "new.txt")); err != nil || string(b) != "new" || len(actions) != 2 {
+		t.Errorf("Unexpected download: %q %v %d\n", b, err, len(actions))
+	}
+}
+
+func TestSyncMissingSource(t *testing.T) {
+	notFound := files.ListFolderAPIError{EndpointError: &files.ListFolderError{
+		Tagged: dropbox.Tagged{Tag: files.ListFolderErrorPath},
+		Path:   &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFound}},
+	}}
+	var deleted []string
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if arg.Path != "/backup" {
+				return nil, notFound
+			}
+			f := &files.FileMetadata{Size: 4}
+			f.PathLower, f.PathDisplay = "/backup/kept.txt", "/backup/kept.txt"
+			return &files.ListFolderResult{Entries: []files.IsMetadata{f}}, nil
+		},
+		DeleteV2Func: func(ctx context.Context, arg *files.DeleteArg) (*files.DeleteResult, error) {
+			deleted = append(deleted, arg.Path)
+			return &files.DeleteResult{}, nil
+		},
+	}
+	dir := t.TempDir()
+	kept := filepath.Join(dir, "kept.txt")
+	_ = os.WriteFile(kept, []byte("kept"), 0644)
+	missing := filepath.Join(dir, "missing")
+
+	// A missing source fails instead of deleting the destination.
+	opts := &dbsync.Options{Direction: dbsync.Upload, Delete: true}
+	if _, err := dbsync.Run(context.Background(), dbx, missing, "/backup", opts); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Unexpected error for a missing local source: %v\n", err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("Unexpected deletions: %v\n", deleted)
+	}
+	opts.Direction = dbsync.Download
+	_, err := dbsync.Run(context.Background(), dbx, dir, "/missing", opts)
+	if _, ok := err.(files.ListFolderAPIError); !ok {
+		t.Errorf("Unexpected error for a missing remote source: %v\n", err)
+	}
+	if _, err = os.Stat(kept); err != nil {
+		t.Errorf("Local file deleted: %v\n", err)
+	}
+
+	// A missing destination is empty.
+	opts.DryRun = true
+	actions, err := dbsync.Run(context.Background(), dbx, missing, "/backup", opts)
+	if err != nil || len(actions) != 1 || actions[0].Op != dbsync.OpDownload {
+		t.Errorf("Unexpected download to a missing directory: %v, %v\n", actions, err)
+	}
+	opts.Direction = dbsync.Upload
+	actions, err = dbsync.Run(context.Background(), dbx, dir, "/missing", opts)
+	if err != nil || len(actions) != 1 || actions[0].Op != dbsync.OpUpload {
+		t.Errorf("Unexpected upload to a missing folder: %v, %v\n", actions, err)
+	}
+}
+
+func TestSyncFailedDownload(t *testing.T) {
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			f := &files.FileMetadata{Size: 7, ContentHash: contentHash([]byte("changed"))}
+			f.PathLower, f.PathDisplay = "/backup/notes.txt", "/backup/notes.txt"
+			return &files.ListFolderResult{Entries: []files.IsMetadata{f}}, nil
+		},
+		DownloadFunc: func(ctx context.Context, arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			// The content doesn't match the hash, failing the download once
+			// written.
+			res := &files.FileMetadata{Size: 7, Rev: "0123456789a", ContentHash: contentHash([]byte("changed"))}
+			return res, io.NopCloser(strings.NewReader("corrupt")), nil
+		},
+	}
+	dir := t.TempDir()
+	notes := filepath.Join(dir, "notes.txt")
+	_ = os.WriteFile(notes, []byte("original"), 0600)
+
+	actions, err := dbsync.Run(context.Background(), dbx, dir, "/backup", &dbsync.Options{Direction: dbsync.Download})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].Err != files.ErrContentHashMismatch {
+		t.Errorf("Unexpected actions: %v\n", actions)
+	}
+	if b, err := os.ReadFile(notes); err != nil || string(b) != "original" {
+		t.Errorf("Local file changed by a failed download: %q, %v\n", b, err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Temporary file left: %v\n", entries)
+	}
+
+	dbx.DownloadFunc = func(ctx context.Context, arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+		res := &files.FileMetadata{Size: 7, Rev: "0123456789a", ContentHash: contentHash([]byte("changed"))}
+		return res, io.NopCloser(strings.NewReader("changed")), nil
+	}
+	if actions, err = dbsync.Run(context.Background(), dbx, dir, "/backup", &dbsync.Options{Direction: dbsync.Download}); err != nil || actions[0].Err != nil {
+		t.Fatalf("Unexpected download: %v, %v\n", actions, err)
+	}
+	if b, err := os.ReadFile(notes); err != nil || string(b) != "changed" {
+		t.Errorf("Local file not replaced: %q, %v\n", b, err)
+	}
+	if info, err := os.Stat(notes); err != nil || (runtime.GOOS != "windows" && info.Mode().Perm() != 0600) {
+		t.Errorf("Unexpected mode: %v, %v\n", info.Mode(), err)
+	}
+}
+
+func TestSyncCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var deletes int32
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			var entries []files.IsMetadata
+			for i := 0; i < 5; i++ {
+				f := &files.FileMetadata{Size: 1}
+				f.PathLower = fmt.Sprintf("/backup/%d.txt", i)
+				f.PathDisplay = f.PathLower
+				entries = append(entries, f)
+			}
+			return &files.ListFolderResult{Entries: entries}, nil
+		},
+		DeleteV2Func: func(ctx context.Context, arg *files.DeleteArg) (*files.DeleteResult, error) {
+			atomic.AddInt32(&deletes, 1)
+			cancel()
+			return nil, ctx.Err()
+		},
+	}
+	opts := &dbsync.Options{Direction: dbsync.Upload, Delete: true, Concurrency: 1}
+	actions, err := dbsync.Run(ctx, dbx, t.TempDir(), "/backup", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deletes != 1 {
+		t.Errorf("Unexpected deletions after cancellation: %d\n", deletes)
+	}
+	for _, a := range actions {
+		if a.Err != context.Canceled {
+			t.Errorf("Unexpected error of %s %s: %v\n", a.Op, a.Path, a.Err)
+		}
+	}
+}
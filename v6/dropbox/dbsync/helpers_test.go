@@ -0,0 +1,26 @@
+package dbsync_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+func generateURL(base string, namespace string, route string) string {
+	return fmt.Sprintf("%s/%s/%s", base, namespace, route)
+}
+
+// contentHash computes the content hash of b from its definition.
+func contentHash(b []byte) string {
+	var sums []byte
+	for len(b) > 0 {
+		n := 4 << 20
+		if n > len(b) {
+			n = len(b)
+		}
+		sum := sha256.Sum256(b[:n])
+		sums, b = append(sums, sum[:]...), b[n:]
+	}
+	sum := sha256.Sum256(sums)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,78 @@
+package dropbox
+
+import (
+	"errors"
+	"strings"
+)
+
+// Categories of API errors, matched with errors.Is by the errors of all
+// routes according to the union tags of their error summary, e.g.
+// `path/not_found/..` is ErrNotFound:
+//
+//	_, err := dbx.GetMetadata(files.NewGetMetadataArg("/missing"))
+//	if errors.Is(err, dropbox.ErrNotFound) {
+//		...
+//	}
+var (
+	// ErrNotFound matches `not_found` and the tags ending in `_not_found`,
+	// such as `shared_link_not_found` or `id_not_found`.
+	ErrNotFound = errors.New("not found")
+	// ErrNoPermission matches `no_permission`, `insufficient_permissions`
+	// and `access_denied`.
+	ErrNoPermission = errors.New("no permission")
+	// ErrRateLimited matches `too_many_requests`,
+	// `too_many_write_operations` and `rate_limit`, including the rate limit
+	// errors of all routes, auth.RateLimitAPIError.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrConflict matches `conflict`, e.g. writing to a path where a file
+	// or folder already exists.
+	ErrConflict = errors.New("conflict")
+)
+
+var errorCategories = map[string]error{
+	"not_found":                 ErrNotFound,
+	"no_permission":             ErrNoPermission,
+	"insufficient_permissions":  ErrNoPermission,
+	"access_denied":             ErrNoPermission,
+	"too_many_requests":         ErrRateLimited,
+	"too_many_write_operations": ErrRateLimited,
+	"rate_limit":                ErrRateLimited,
+	"conflict":                  ErrConflict,
+}
+
+// Is reports whether target is the category of e, one of ErrNotFound,
+// ErrNoPermission, ErrRateLimited and ErrConflict. It is promoted to the
+// errors of all routes, which embed APIError.
+func (e APIError) Is(target error) bool {
+	for _, tag := range e.tags() {
+		category, ok := errorCategories[tag]
+		if !ok && strings.HasSuffix(tag, "_not_found") {
+			category, ok = ErrNotFound, true
+		}
+		if ok && category == target {
+			return true
+		}
+	}
+	return false
+}
+
+// tags returns the union tags of the error summary of e.
+func (e APIError) tags() []string {
+	tags := strings.Split(e.ErrorSummary, "/")
+	for i, tag := range tags {
+		tags[i] = strings.TrimSpace(tag)
+	}
+	return tags
+}
+
+// hasTag reports whether the error summary of e includes one of tags.
+func (e APIError) hasTag(tags ...string) bool {
+	for _, t := range e.tags() {
+		for _, tag := range tags {
+			if t == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+// Package experimental gates the high-level subsystems of the SDK that are
+// built on top of the generated routes. Unlike the routes, whose API follows
+// the API spec, their API may still change between minor releases, so they
+// must be enabled explicitly, either in dropbox.Config.Experimental or with
+// the DROPBOX_SDK_EXPERIMENTAL environment variable:
+//
+//	config.Experimental = []experimental.Feature{experimental.Watcher}
+//
+// Features graduate out of this package once their API is stable, after
+// which enabling them is no longer needed.
+package experimental
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvVar is the environment variable listing features to enable, separated
+// by commas, in addition to those of the Config.
+const EnvVar = "DROPBOX_SDK_EXPERIMENTAL"
+
+// Feature names an experimental subsystem.
+type Feature string
+
+// Experimental features
+const (
+	// Watcher covers the subsystems following changes with
+	// `list_folder/longpoll`: files.Watcher and files.MetadataCache.Watch.
+	Watcher Feature = "watcher"
+	// Sync covers the one-way sync of package dbsync.
+	Sync Feature = "sync"
+)
+
+// DisabledError is returned when a subsystem is used without its feature
+// being enabled.
+type DisabledError struct {
+	Feature Feature
+}
+
+func (e DisabledError) Error() string {
+	return fmt.Sprintf("experimental feature %q is not enabled, see package experimental", e.Feature)
+}
+
+// Check returns a DisabledError unless f is in enabled or listed in EnvVar.
+func Check(enabled []Feature, f Feature) error {
+	for _, e := range enabled {
+		if e == f {
+			return nil
+		}
+	}
+	for _, e := range strings.Split(os.Getenv(EnvVar), ",") {
+		if Feature(strings.TrimSpace(e)) == f {
+			return nil
+		}
+	}
+	return DisabledError{Feature: f}
+}
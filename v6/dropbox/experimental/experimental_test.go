@@ -0,0 +1,29 @@
+package experimental_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+)
+
+func TestCheck(t *testing.T) {
+	t.Setenv(experimental.EnvVar, "")
+	if err := experimental.Check([]experimental.Feature{experimental.Watcher}, experimental.Watcher); err != nil {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	err := experimental.Check([]experimental.Feature{experimental.Watcher}, experimental.Sync)
+	if err != (experimental.DisabledError{Feature: experimental.Sync}) || !strings.Contains(err.Error(), `"sync"`) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	t.Setenv(experimental.EnvVar, "watcher, sync")
+	for _, f := range []experimental.Feature{experimental.Watcher, experimental.Sync} {
+		if err = experimental.Check(nil, f); err != nil {
+			t.Errorf("Unexpected error with %s: %v\n", experimental.EnvVar, err)
+		}
+	}
+	if err = experimental.Check(nil, "other"); err == nil {
+		t.Error("Feature not listed enabled")
+	}
+}
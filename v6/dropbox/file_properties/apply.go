@@ -0,0 +1,74 @@
+package file_properties
+
+import (
+	"context"
+	"sync"
+)
+
+// ApplyOptions controls how ApplyProperties applies property groups.
+type ApplyOptions struct {
+	// Overwrite replaces existing property groups of the same templates via
+	// `properties/overwrite` instead of adding them via `properties/add`,
+	// which fails if the file already has them.
+	Overwrite bool
+	// Concurrency is the number of paths handled in parallel. Defaults to 4.
+	Concurrency int
+}
+
+// ApplyOutcome is the result of applying property groups to a single path.
+type ApplyOutcome struct {
+	Path string
+	// Err is the error returned by `properties/add` or
+	// `properties/overwrite`, if any.
+	Err error
+}
+
+// ApplyReport is the result of ApplyProperties.
+type ApplyReport struct {
+	// Outcomes holds an outcome per path, in the order of the paths.
+	Outcomes []*ApplyOutcome
+}
+
+// Failed returns the outcomes of the paths that couldn't be updated.
+func (r *ApplyReport) Failed() []*ApplyOutcome {
+	var failed []*ApplyOutcome
+	for _, o := range r.Outcomes {
+		if o.Err != nil {
+			failed = append(failed, o)
+		}
+	}
+	return failed
+}
+
+// ApplyProperties applies groups to every file or folder of paths, with up to
+// opts.Concurrency requests in flight. A failure doesn't stop the other paths
+// from being updated; every path gets an outcome in the report.
+func ApplyProperties(ctx context.Context, client Client, paths []string, groups []*PropertyGroup, opts *ApplyOptions) *ApplyReport {
+	if opts == nil {
+		opts = &ApplyOptions{}
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+
+	report := &ApplyReport{Outcomes: make([]*ApplyOutcome, len(paths))}
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		o := &ApplyOutcome{Path: path}
+		report.Outcomes[i] = o
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			if opts.Overwrite {
+				o.Err = client.PropertiesOverwriteContext(ctx, NewOverwritePropertyGroupArg(o.Path, groups))
+			} else {
+				o.Err = client.PropertiesAddContext(ctx, NewAddPropertiesArg(o.Path, groups))
+			}
+		}()
+	}
+	wg.Wait()
+	return report
+}
@@ -0,0 +1,60 @@
+package file_properties_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+func TestApplyProperties(t *testing.T) {
+	var mu sync.Mutex
+	var overwritten []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg file_properties.OverwritePropertyGroupArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path != "/file_properties/properties/overwrite" || len(arg.PropertyGroups) != 1 {
+				t.Errorf("Unexpected request: %v %+v\n", r.URL.Path, arg)
+			}
+			if strings.HasPrefix(arg.Path, "/bad") {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "path/not_found/", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+				return
+			}
+			mu.Lock()
+			overwritten = append(overwritten, arg.Path)
+			mu.Unlock()
+			_, _ = w.Write([]byte("null"))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	var paths []string
+	for i := 0; i < 20; i++ {
+		paths = append(paths, fmt.Sprintf("/f%d", i))
+	}
+	paths = append(paths, "/bad1", "/bad2")
+	groups := []*file_properties.PropertyGroup{file_properties.NewPropertyGroup("ptid:1",
+		[]*file_properties.PropertyField{file_properties.NewPropertyField("status", "open")})}
+	report := file_properties.ApplyProperties(context.Background(), file_properties.New(config), paths, groups,
+		&file_properties.ApplyOptions{Overwrite: true, Concurrency: 3})
+	if len(report.Outcomes) != len(paths) || report.Outcomes[5].Path != "/f5" || len(overwritten) != 20 {
+		t.Errorf("Unexpected report: %+v\n", report.Outcomes)
+	}
+	failed := report.Failed()
+	if len(failed) != 2 || failed[0].Path != "/bad1" || failed[1].Err == nil {
+		t.Errorf("Unexpected failures: %+v\n", failed)
+	}
+}
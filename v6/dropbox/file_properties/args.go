@@ -0,0 +1,30 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package file_properties
+
+// WithTemplateFilter sets TemplateFilter and returns u
+func (u *PropertiesSearchArg) WithTemplateFilter(TemplateFilter *TemplateFilter) *PropertiesSearchArg {
+	u.TemplateFilter = TemplateFilter
+	return u
+}
+
+// WithName sets Name and returns u
+func (u *UpdateTemplateArg) WithName(Name string) *UpdateTemplateArg {
+	u.Name = Name
+	return u
+}
+
+// WithDescription sets Description and returns u
+func (u *UpdateTemplateArg) WithDescription(Description string) *UpdateTemplateArg {
+	u.Description = Description
+	return u
+}
+
+// WithAddFields sets AddFields and returns u
+func (u *UpdateTemplateArg) WithAddFields(AddFields []*PropertyFieldTemplate) *UpdateTemplateArg {
+	u.AddFields = AddFields
+	return u
+}
@@ -0,0 +1,291 @@
+package file_properties
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// FieldError is returned when a property field can't be decoded into the
+// struct field bound to it.
+type FieldError struct {
+	Field string
+	Value string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("property field %s: cannot decode %q: %v", e.Field, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// boundField is a struct field bound to a property field.
+type boundField struct {
+	index       int
+	name        string
+	description string
+	omitEmpty   bool
+}
+
+// boundFields returns the bound fields of the struct type t.
+func boundFields(t reflect.Type) ([]boundField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("file_properties: cannot bind %s, want a struct", t)
+	}
+	var fields []boundField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("dbxprop")
+		if f.PkgPath != "" || tag == "-" {
+			continue
+		}
+		if !bindable(f.Type) {
+			return nil, fmt.Errorf("file_properties: cannot bind field %s of type %s", f.Name, f.Type)
+		}
+		bf := boundField{index: i, name: f.Name, description: f.Tag.Get("dbxdesc")}
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			bf.name = parts[0]
+		}
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				bf.omitEmpty = true
+			}
+		}
+		fields = append(fields, bf)
+	}
+	return fields, nil
+}
+
+// bindable reports whether values of t can be bound to property fields.
+func bindable(t reflect.Type) bool {
+	if t == timeType || (t.Implements(textMarshalerType) && reflect.PtrTo(t).Implements(textUnmarshalerType)) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// structValue returns the struct value v points to, or v itself if it isn't
+// a pointer and settable is false.
+func structValue(v interface{}, settable bool) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return rv.Elem(), nil
+	}
+	if settable || !rv.IsValid() || rv.Kind() == reflect.Ptr {
+		return reflect.Value{}, fmt.Errorf("file_properties: cannot bind %T, want a pointer to a struct", v)
+	}
+	return rv, nil
+}
+
+// TemplateFields returns the field templates of the struct, or pointer to
+// struct, v, bound as described in MarshalGroup. All fields have the
+// PropertyTypeString type, the only type supported by Dropbox.
+func TemplateFields(v interface{}) ([]*PropertyFieldTemplate, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return nil, fmt.Errorf("file_properties: cannot bind %T, want a struct", v)
+	}
+	fields, err := boundFields(t)
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]*PropertyFieldTemplate, len(fields))
+	for i, f := range fields {
+		templates[i] = NewPropertyFieldTemplate(f.name, f.description,
+			&PropertyType{Tagged: dropbox.Tagged{Tag: PropertyTypeString}})
+	}
+	return templates, nil
+}
+
+// NewTemplateFor returns the argument of `templates/add_for_user` or
+// `templates/add_for_team` creating a template with the fields of the
+// struct v.
+func NewTemplateFor(name string, description string, v interface{}) (*AddTemplateArg, error) {
+	fields, err := TemplateFields(v)
+	if err != nil {
+		return nil, err
+	}
+	return NewAddTemplateArg(name, description, fields), nil
+}
+
+// MarshalGroup returns the property group of the template templateID holding
+// the fields of the struct, or pointer to struct, v. Struct fields are bound
+// to property fields with `dbxprop` tags, and described in templates with
+// `dbxdesc` tags:
+//
+//	type Review struct {
+//		Status   string    `dbxprop:"status" dbxdesc:"Review status"`
+//		Approved bool      `dbxprop:"approved"`
+//		Due      time.Time `dbxprop:"due,omitempty"`
+//		Internal string    `dbxprop:"-"`
+//	}
+//
+// The tag holds the name of the property field, and defaults to the name of
+// the struct field. With the "omitempty" option, zero values aren't set.
+// Fields can be strings, booleans, numbers, time.Time, formatted with
+// RFC 3339, or implement encoding.TextMarshaler and
+// encoding.TextUnmarshaler. Unexported fields are ignored.
+func MarshalGroup(templateID string, v interface{}) (*PropertyGroup, error) {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := boundFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	group := NewPropertyGroup(templateID, []*PropertyField{})
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		value, err := formatValue(fv)
+		if err != nil {
+			return nil, err
+		}
+		group.Fields = append(group.Fields, NewPropertyField(f.name, value))
+	}
+	return group, nil
+}
+
+// UnmarshalGroup sets the fields of the struct v points to from the fields of
+// group, bound as described in MarshalGroup. Struct fields missing from group
+// are left unchanged.
+func UnmarshalGroup(group *PropertyGroup, v interface{}) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+	fields, err := boundFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string, len(group.Fields))
+	for _, f := range group.Fields {
+		values[f.Name] = f.Value
+	}
+	for _, f := range fields {
+		value, ok := values[f.name]
+		if !ok {
+			continue
+		}
+		if err = parseValue(rv.Field(f.index), value); err != nil {
+			return &FieldError{Field: f.name, Value: value, Err: err}
+		}
+	}
+	return nil
+}
+
+// GetProperties sets the fields of the struct v points to from the group of
+// the template templateID in groups, as returned with file metadata, and
+// reports whether the group was found.
+func GetProperties(groups []*PropertyGroup, templateID string, v interface{}) (bool, error) {
+	for _, g := range groups {
+		if g.TemplateId == templateID {
+			return true, UnmarshalGroup(g, v)
+		}
+	}
+	return false, nil
+}
+
+// SetProperties replaces the property group of the template templateID of
+// the file or folder at path with the fields of the struct v.
+func SetProperties(ctx context.Context, client Client, path string, templateID string, v interface{}) error {
+	group, err := MarshalGroup(templateID, v)
+	if err != nil {
+		return err
+	}
+	return client.PropertiesOverwriteContext(ctx, NewOverwritePropertyGroupArg(path, []*PropertyGroup{group}))
+}
+
+func formatValue(v reflect.Value) (string, error) {
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339), nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("file_properties: cannot bind %s", v.Type())
+}
+
+func parseValue(v reflect.Value, s string) error {
+	if v.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, s)
+		if err == nil {
+			v.Set(reflect.ValueOf(t))
+		}
+		return err
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	}
+	return nil
+}
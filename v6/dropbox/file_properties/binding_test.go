@@ -0,0 +1,99 @@
+package file_properties_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+type reviewProps struct {
+	Status   string    `dbxprop:"status" dbxdesc:"Review status"`
+	Approved bool      `dbxprop:"approved"`
+	Score    float64   `dbxprop:"score,omitempty"`
+	Due      time.Time `dbxprop:"due"`
+	Owner    string
+	Internal string `dbxprop:"-"`
+}
+
+func TestPropertyBinding(t *testing.T) {
+	fields, err := file_properties.TemplateFields(reviewProps{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range fields {
+		names = append(names, f.Name)
+	}
+	if strings.Join(names, ",") != "status,approved,score,due,Owner" || fields[0].Description != "Review status" ||
+		fields[0].Type.Tag != file_properties.PropertyTypeString {
+		t.Errorf("Unexpected template fields: %v\n", names)
+	}
+	if _, err = file_properties.TemplateFields(struct{ C chan int }{}); err == nil {
+		t.Errorf("Expected error for unsupported field type\n")
+	}
+
+	due := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	var overwrite file_properties.OverwritePropertyGroupArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/file_properties/properties/overwrite":
+				_ = json.NewDecoder(r.Body).Decode(&overwrite)
+				_, _ = w.Write([]byte("null"))
+			case "/files/get_metadata":
+				var arg files.GetMetadataArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				if arg.IncludePropertyGroups == nil || len(arg.IncludePropertyGroups.FilterSome) != 1 {
+					t.Errorf("Unexpected filter: %+v\n", arg.IncludePropertyGroups)
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					".tag": "file", "name": "a.txt", "id": "id:a", "path_lower": "/a.txt",
+					"property_groups": overwrite.PropertyGroups,
+				})
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	in := reviewProps{Status: "open", Approved: true, Due: due, Owner: "ann", Internal: "x"}
+	if err = file_properties.SetProperties(context.Background(), file_properties.New(config), "/a.txt", "ptid:1", &in); err != nil {
+		t.Fatal(err)
+	}
+	if len(overwrite.PropertyGroups) != 1 || len(overwrite.PropertyGroups[0].Fields) != 4 {
+		t.Fatalf("Unexpected property groups: %+v\n", overwrite.PropertyGroups)
+	}
+	if f := overwrite.PropertyGroups[0].Fields[2]; f.Name != "due" || f.Value != "2024-05-01T12:00:00Z" {
+		t.Errorf("Unexpected field: %+v\n", f)
+	}
+
+	var out reviewProps
+	found, err := files.GetProperties(context.Background(), files.New(config), "/a.txt", "ptid:1", &out)
+	if err != nil || !found {
+		t.Fatalf("Unexpected result: %v %v\n", found, err)
+	}
+	in.Internal = ""
+	if out != in {
+		t.Errorf("Unexpected properties: %+v\n", out)
+	}
+	if found, err = files.GetProperties(context.Background(), files.New(config), "/a.txt", "ptid:2", &out); found || err != nil {
+		t.Errorf("Unexpected result: %v %v\n", found, err)
+	}
+
+	group := file_properties.NewPropertyGroup("ptid:1", []*file_properties.PropertyField{{Name: "approved", Value: "maybe"}})
+	err = file_properties.UnmarshalGroup(group, &out)
+	if fe, ok := err.(*file_properties.FieldError); !ok || fe.Field != "approved" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
@@ -125,6 +125,11 @@ type PropertiesAddAPIError struct {
 	EndpointError *AddPropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesAddContext(ctx context.Context, arg *AddPropertiesArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -163,6 +168,11 @@ type PropertiesOverwriteAPIError struct {
 	EndpointError *InvalidPropertyGroupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesOverwriteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesOverwriteContext(ctx context.Context, arg *OverwritePropertyGroupArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -201,6 +211,11 @@ type PropertiesRemoveAPIError struct {
 	EndpointError *RemovePropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -239,6 +254,11 @@ type PropertiesSearchAPIError struct {
 	EndpointError *PropertiesSearchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesSearchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesSearchContext(ctx context.Context, arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -281,6 +301,11 @@ type PropertiesSearchContinueAPIError struct {
 	EndpointError *PropertiesSearchContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesSearchContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesSearchContinueContext(ctx context.Context, arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -323,6 +348,11 @@ type PropertiesUpdateAPIError struct {
 	EndpointError *UpdatePropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertiesArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -361,6 +391,11 @@ type TemplatesAddForTeamAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesAddForTeamAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesAddForTeamContext(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -403,6 +438,11 @@ type TemplatesAddForUserAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesAddForUserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesAddForUserContext(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -445,6 +485,11 @@ type TemplatesGetForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesGetForTeamAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesGetForTeamContext(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -487,6 +532,11 @@ type TemplatesGetForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesGetForUserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesGetForUserContext(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -529,6 +579,11 @@ type TemplatesListForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesListForTeamAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesListForTeamContext(ctx context.Context) (res *ListTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -571,6 +626,11 @@ type TemplatesListForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesListForUserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesListForUserContext(ctx context.Context) (res *ListTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -613,6 +673,11 @@ type TemplatesRemoveForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesRemoveForTeamAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesRemoveForTeamContext(ctx context.Context, arg *RemoveTemplateArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -651,6 +716,11 @@ type TemplatesRemoveForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesRemoveForUserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesRemoveForUserContext(ctx context.Context, arg *RemoveTemplateArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -689,6 +759,11 @@ type TemplatesUpdateForTeamAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesUpdateForTeamAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesUpdateForTeamContext(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -731,6 +806,11 @@ type TemplatesUpdateForUserAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TemplatesUpdateForUserAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TemplatesUpdateForUserContext(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -0,0 +1,79 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_properties
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// PropertiesSearchIterator iterates over the pages returned by `PropertiesSearch` and
+// `PropertiesSearchContinue`.
+type PropertiesSearchIterator struct {
+	client  Client
+	arg     *PropertiesSearchArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewPropertiesSearchIterator returns a new PropertiesSearchIterator instance.
+// arg is required, as `PropertiesSearch` has required arguments.
+func NewPropertiesSearchIterator(client Client, arg *PropertiesSearchArg) *PropertiesSearchIterator {
+	if arg == nil {
+		panic("file_properties: NewPropertiesSearchIterator called with a nil arg")
+	}
+	return &PropertiesSearchIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *PropertiesSearchIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *PropertiesSearchIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *PropertiesSearchIterator) Next(ctx context.Context) (res *PropertiesSearchResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.PropertiesSearchContext(ctx, it.arg)
+	default:
+		arg := NewPropertiesSearchContinueArg(it.cursor)
+		res, err = it.client.PropertiesSearchContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
@@ -0,0 +1,243 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_properties
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	PropertiesAddFunc            func(ctx context.Context, arg *AddPropertiesArg) (err error)
+	PropertiesOverwriteFunc      func(ctx context.Context, arg *OverwritePropertyGroupArg) (err error)
+	PropertiesRemoveFunc         func(ctx context.Context, arg *RemovePropertiesArg) (err error)
+	PropertiesSearchFunc         func(ctx context.Context, arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error)
+	PropertiesSearchContinueFunc func(ctx context.Context, arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error)
+	PropertiesUpdateFunc         func(ctx context.Context, arg *UpdatePropertiesArg) (err error)
+	TemplatesAddForTeamFunc      func(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error)
+	TemplatesAddForUserFunc      func(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error)
+	TemplatesGetForTeamFunc      func(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error)
+	TemplatesGetForUserFunc      func(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error)
+	TemplatesListForTeamFunc     func(ctx context.Context) (res *ListTemplateResult, err error)
+	TemplatesListForUserFunc     func(ctx context.Context) (res *ListTemplateResult, err error)
+	TemplatesRemoveForTeamFunc   func(ctx context.Context, arg *RemoveTemplateArg) (err error)
+	TemplatesRemoveForUserFunc   func(ctx context.Context, arg *RemoveTemplateArg) (err error)
+	TemplatesUpdateForTeamFunc   func(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error)
+	TemplatesUpdateForUserFunc   func(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) PropertiesAddContext(ctx context.Context, arg *AddPropertiesArg) (err error) {
+	if m.PropertiesAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesAddFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesAdd(arg *AddPropertiesArg) (err error) {
+	return m.PropertiesAddContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesOverwriteContext(ctx context.Context, arg *OverwritePropertyGroupArg) (err error) {
+	if m.PropertiesOverwriteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesOverwriteFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesOverwrite(arg *OverwritePropertyGroupArg) (err error) {
+	return m.PropertiesOverwriteContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg) (err error) {
+	if m.PropertiesRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
+	return m.PropertiesRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesSearchContext(ctx context.Context, arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error) {
+	if m.PropertiesSearchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesSearchFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesSearch(arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error) {
+	return m.PropertiesSearchContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesSearchContinueContext(ctx context.Context, arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error) {
+	if m.PropertiesSearchContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesSearchContinueFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesSearchContinue(arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error) {
+	return m.PropertiesSearchContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertiesArg) (err error) {
+	if m.PropertiesUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesUpdateFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesUpdate(arg *UpdatePropertiesArg) (err error) {
+	return m.PropertiesUpdateContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesAddForTeamContext(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+	if m.TemplatesAddForTeamFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesAddForTeamFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesAddForTeam(arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+	return m.TemplatesAddForTeamContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesAddForUserContext(ctx context.Context, arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+	if m.TemplatesAddForUserFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesAddForUserFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesAddForUser(arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+	return m.TemplatesAddForUserContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesGetForTeamContext(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+	if m.TemplatesGetForTeamFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesGetForTeamFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesGetForTeam(arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+	return m.TemplatesGetForTeamContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesGetForUserContext(ctx context.Context, arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+	if m.TemplatesGetForUserFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesGetForUserFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesGetForUser(arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+	return m.TemplatesGetForUserContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesListForTeamContext(ctx context.Context) (res *ListTemplateResult, err error) {
+	if m.TemplatesListForTeamFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesListForTeamFunc(ctx)
+}
+
+func (m *Mock) TemplatesListForTeam() (res *ListTemplateResult, err error) {
+	return m.TemplatesListForTeamContext(context.Background())
+}
+
+func (m *Mock) TemplatesListForUserContext(ctx context.Context) (res *ListTemplateResult, err error) {
+	if m.TemplatesListForUserFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesListForUserFunc(ctx)
+}
+
+func (m *Mock) TemplatesListForUser() (res *ListTemplateResult, err error) {
+	return m.TemplatesListForUserContext(context.Background())
+}
+
+func (m *Mock) TemplatesRemoveForTeamContext(ctx context.Context, arg *RemoveTemplateArg) (err error) {
+	if m.TemplatesRemoveForTeamFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesRemoveForTeamFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesRemoveForTeam(arg *RemoveTemplateArg) (err error) {
+	return m.TemplatesRemoveForTeamContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesRemoveForUserContext(ctx context.Context, arg *RemoveTemplateArg) (err error) {
+	if m.TemplatesRemoveForUserFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesRemoveForUserFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesRemoveForUser(arg *RemoveTemplateArg) (err error) {
+	return m.TemplatesRemoveForUserContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesUpdateForTeamContext(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+	if m.TemplatesUpdateForTeamFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesUpdateForTeamFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesUpdateForTeam(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+	return m.TemplatesUpdateForTeamContext(context.Background(), arg)
+}
+
+func (m *Mock) TemplatesUpdateForUserContext(ctx context.Context, arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+	if m.TemplatesUpdateForUserFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TemplatesUpdateForUserFunc(ctx, arg)
+}
+
+func (m *Mock) TemplatesUpdateForUser(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+	return m.TemplatesUpdateForUserContext(context.Background(), arg)
+}
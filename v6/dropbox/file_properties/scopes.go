@@ -0,0 +1,26 @@
+package file_properties
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"properties/add":             "files.metadata.write",
+	"properties/overwrite":       "files.metadata.write",
+	"properties/remove":          "files.metadata.write",
+	"properties/search":          "files.metadata.read",
+	"properties/search/continue": "files.metadata.read",
+	"properties/update":          "files.metadata.write",
+	"templates/add_for_team":     "files.team_metadata.write",
+	"templates/add_for_user":     "files.metadata.write",
+	"templates/get_for_team":     "files.team_metadata.write",
+	"templates/get_for_user":     "files.metadata.read",
+	"templates/list_for_team":    "files.team_metadata.write",
+	"templates/list_for_user":    "files.metadata.read",
+	"templates/remove_for_team":  "files.team_metadata.write",
+	"templates/remove_for_user":  "files.metadata.write",
+	"templates/update_for_team":  "files.team_metadata.write",
+	"templates/update_for_user":  "files.metadata.write",
+}
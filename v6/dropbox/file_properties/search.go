@@ -0,0 +1,103 @@
+package file_properties
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// SearchQuery builds the argument of `properties/search`. Queries are
+// combined with a logical or, the only operator supported by Dropbox:
+//
+//	q := file_properties.NewSearchQuery().Field("status", "open").Field("status", "review").Templates(id)
+//	it := file_properties.NewPropertyMatchIterator(dbx, q)
+type SearchQuery struct {
+	arg *PropertiesSearchArg
+}
+
+// NewSearchQuery returns an empty SearchQuery, matching the properties of
+// all templates.
+func NewSearchQuery() *SearchQuery {
+	return &SearchQuery{arg: NewPropertiesSearchArg([]*PropertiesSearchQuery{})}
+}
+
+// Field matches the files with value in the property field name.
+func (q *SearchQuery) Field(name string, value string) *SearchQuery {
+	mode := &PropertiesSearchMode{Tagged: dropbox.Tagged{Tag: PropertiesSearchModeFieldName}, FieldName: name}
+	q.arg.Queries = append(q.arg.Queries, NewPropertiesSearchQuery(value, mode))
+	return q
+}
+
+// Templates restricts the property groups returned with the matches to the
+// templates with the given IDs.
+func (q *SearchQuery) Templates(ids ...string) *SearchQuery {
+	if q.arg.TemplateFilter == nil || q.arg.TemplateFilter.Tag != TemplateFilterFilterSome {
+		q.arg.TemplateFilter = &TemplateFilter{Tagged: dropbox.Tagged{Tag: TemplateFilterFilterSome}}
+	}
+	q.arg.TemplateFilter.FilterSome = append(q.arg.TemplateFilter.FilterSome, ids...)
+	return q
+}
+
+// Arg returns the built argument.
+func (q *SearchQuery) Arg() *PropertiesSearchArg {
+	return q.arg
+}
+
+// PropertyMatch is a file or folder matching a search.
+type PropertyMatch struct {
+	Id        string
+	Path      string
+	IsDeleted bool
+	// Groups are the property groups of the match, keyed by template ID.
+	Groups map[string]*PropertyGroup
+}
+
+// Decode sets the fields of the struct v points to from the property group
+// of the template templateID, bound as described in MarshalGroup, and
+// reports whether the match has such a group.
+func (m *PropertyMatch) Decode(templateID string, v interface{}) (bool, error) {
+	g, ok := m.Groups[templateID]
+	if !ok {
+		return false, nil
+	}
+	return true, UnmarshalGroup(g, v)
+}
+
+// PropertyMatchIterator iterates over the matches of a search, fetching the
+// pages of `properties/search` and `properties/search/continue` as needed.
+type PropertyMatchIterator struct {
+	pages   *PropertiesSearchIterator
+	matches []*PropertiesSearchMatch
+}
+
+// NewPropertyMatchIterator returns a new PropertyMatchIterator instance
+func NewPropertyMatchIterator(client Client, q *SearchQuery) *PropertyMatchIterator {
+	return &PropertyMatchIterator{pages: NewPropertiesSearchIterator(client, q.Arg())}
+}
+
+// HasMore returns false once all matches have been returned. As a page may
+// be empty, Next can still return `dropbox.ErrNoMorePages` after HasMore
+// returned true.
+func (it *PropertyMatchIterator) HasMore() bool {
+	return len(it.matches) > 0 || it.pages.HasMore()
+}
+
+// Next returns the next match, fetching the next page if needed. It returns
+// `dropbox.ErrNoMorePages` once all matches have been returned.
+func (it *PropertyMatchIterator) Next(ctx context.Context) (*PropertyMatch, error) {
+	for len(it.matches) == 0 {
+		res, err := it.pages.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		it.matches = res.Matches
+	}
+	m := it.matches[0]
+	it.matches = it.matches[1:]
+
+	match := &PropertyMatch{Id: m.Id, Path: m.Path, IsDeleted: m.IsDeleted, Groups: make(map[string]*PropertyGroup, len(m.PropertyGroups))}
+	for _, g := range m.PropertyGroups {
+		match.Groups[g.TemplateId] = g
+	}
+	return match, nil
+}
@@ -0,0 +1,59 @@
+package file_properties_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+func TestPropertyMatchIterator(t *testing.T) {
+	var searchArg file_properties.PropertiesSearchArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/file_properties/properties/search":
+				_ = json.NewDecoder(r.Body).Decode(&searchArg)
+				_, _ = w.Write([]byte(`{"matches": [{"id": "id:a", "path": "/a.txt", "is_deleted": false,
+					"property_groups": [{"template_id": "ptid:1", "fields": [{"name": "status", "value": "open"}]}]}],
+					"cursor": "c1"}`))
+			case "/file_properties/properties/search/continue":
+				_, _ = w.Write([]byte(`{"matches": [{"id": "id:b", "path": "/b.txt", "is_deleted": true, "property_groups": []}]}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	q := file_properties.NewSearchQuery().Field("status", "open").Field("status", "review").Templates("ptid:1")
+	it := file_properties.NewPropertyMatchIterator(file_properties.New(config), q)
+	var matches []*file_properties.PropertyMatch
+	for it.HasMore() {
+		m, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches = append(matches, m)
+	}
+	if len(searchArg.Queries) != 2 || searchArg.Queries[1].Mode.FieldName != "status" ||
+		searchArg.TemplateFilter.Tag != file_properties.TemplateFilterFilterSome {
+		t.Errorf("Unexpected search: %+v\n", searchArg)
+	}
+	if len(matches) != 2 || matches[0].Path != "/a.txt" || !matches[1].IsDeleted {
+		t.Fatalf("Unexpected matches: %+v\n", matches)
+	}
+	var props reviewProps
+	if found, err := matches[0].Decode("ptid:1", &props); !found || err != nil || props.Status != "open" {
+		t.Errorf("Unexpected properties: %+v %v %v\n", props, found, err)
+	}
+	if found, _ := matches[1].Decode("ptid:1", &props); found {
+		t.Errorf("Unexpected group\n")
+	}
+}
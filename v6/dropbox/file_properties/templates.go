@@ -0,0 +1,200 @@
+package file_properties
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// TemplateConflictError is returned by EnsureTemplate when an existing
+// template can't be updated to the wanted schema, as fields can only be
+// added to templates.
+type TemplateConflictError struct {
+	TemplateID string
+	// Field is the name of the field whose type differs.
+	Field string
+}
+
+func (e *TemplateConflictError) Error() string {
+	return fmt.Sprintf("template %s: field %s has a different type", e.TemplateID, e.Field)
+}
+
+// TemplateManager reads and sets up the property templates of a user or a
+// team, caching their schemas. A TemplateManager is safe for concurrent use;
+// its operations are serialized.
+//
+//	m := file_properties.NewTemplateManager(dbx)
+//	fields, _ := file_properties.TemplateFields(Review{})
+//	id, err := m.EnsureTemplate(ctx, "Review", "Review status", fields)
+type TemplateManager struct {
+	client Client
+	team   bool
+
+	mu        sync.Mutex
+	loaded    bool
+	templates map[string]*PropertyGroupTemplate
+}
+
+// NewTemplateManager returns a TemplateManager for the templates of the
+// user of client.
+func NewTemplateManager(client Client) *TemplateManager {
+	return &TemplateManager{client: client, templates: make(map[string]*PropertyGroupTemplate)}
+}
+
+// NewTeamTemplateManager returns a TemplateManager for the templates of the
+// team of client, which must be a team client.
+func NewTeamTemplateManager(client Client) *TemplateManager {
+	m := NewTemplateManager(client)
+	m.team = true
+	return m
+}
+
+// Templates returns the templates, keyed by ID, listing them and fetching
+// their schemas on first use.
+func (m *TemplateManager) Templates(ctx context.Context) (map[string]*PropertyGroupTemplate, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.load(ctx); err != nil {
+		return nil, err
+	}
+	templates := make(map[string]*PropertyGroupTemplate, len(m.templates))
+	for id, t := range m.templates {
+		templates[id] = t
+	}
+	return templates, nil
+}
+
+// Template returns the schema of the template id, fetching it if it isn't
+// cached.
+func (m *TemplateManager) Template(ctx context.Context, id string) (*PropertyGroupTemplate, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.get(ctx, id)
+}
+
+// Invalidate drops the cached templates, so that they are fetched again on
+// next use.
+func (m *TemplateManager) Invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loaded = false
+	m.templates = make(map[string]*PropertyGroupTemplate)
+}
+
+// EnsureTemplate returns the ID of the template named name, creating it with
+// description and fields if there is none, or adding the missing fields and
+// setting description if they differ. Existing fields are matched by name;
+// their descriptions are left unchanged, and a TemplateConflictError is
+// returned if their type differs. Fields of the template missing from fields
+// are kept, as they can't be removed.
+func (m *TemplateManager) EnsureTemplate(ctx context.Context, name string, description string, fields []*PropertyFieldTemplate) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.load(ctx); err != nil {
+		return "", err
+	}
+	var id string
+	var current *PropertyGroupTemplate
+	for tid, t := range m.templates {
+		if t.Name == name {
+			id, current = tid, t
+			break
+		}
+	}
+	if current == nil {
+		arg := NewAddTemplateArg(name, description, fields)
+		var res *AddTemplateResult
+		var err error
+		if m.team {
+			res, err = m.client.TemplatesAddForTeamContext(ctx, arg)
+		} else {
+			res, err = m.client.TemplatesAddForUserContext(ctx, arg)
+		}
+		if err != nil {
+			return "", err
+		}
+		m.templates[res.TemplateId] = &arg.PropertyGroupTemplate
+		return res.TemplateId, nil
+	}
+
+	existing := make(map[string]*PropertyFieldTemplate, len(current.Fields))
+	for _, f := range current.Fields {
+		existing[f.Name] = f
+	}
+	arg := NewUpdateTemplateArg(id)
+	for _, f := range fields {
+		e, ok := existing[f.Name]
+		if !ok {
+			arg.AddFields = append(arg.AddFields, f)
+			continue
+		}
+		if e.Type != nil && f.Type != nil && e.Type.Tag != f.Type.Tag {
+			return id, &TemplateConflictError{TemplateID: id, Field: f.Name}
+		}
+	}
+	if current.Description != description {
+		arg.Description = description
+	}
+	if len(arg.AddFields) == 0 && arg.Description == "" {
+		return id, nil
+	}
+	var err error
+	if m.team {
+		_, err = m.client.TemplatesUpdateForTeamContext(ctx, arg)
+	} else {
+		_, err = m.client.TemplatesUpdateForUserContext(ctx, arg)
+	}
+	if err != nil {
+		return id, err
+	}
+	updated := *current
+	if arg.Description != "" {
+		updated.Description = arg.Description
+	}
+	updated.Fields = append(append([]*PropertyFieldTemplate(nil), current.Fields...), arg.AddFields...)
+	m.templates[id] = &updated
+	return id, nil
+}
+
+// load lists the templates and fetches the schemas that aren't cached.
+func (m *TemplateManager) load(ctx context.Context) error {
+	if m.loaded {
+		return nil
+	}
+	var res *ListTemplateResult
+	var err error
+	if m.team {
+		res, err = m.client.TemplatesListForTeamContext(ctx)
+	} else {
+		res, err = m.client.TemplatesListForUserContext(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	for _, id := range res.TemplateIds {
+		if _, err = m.get(ctx, id); err != nil {
+			return err
+		}
+	}
+	m.loaded = true
+	return nil
+}
+
+// get returns the schema of the template id, fetching it if it isn't cached.
+func (m *TemplateManager) get(ctx context.Context, id string) (*PropertyGroupTemplate, error) {
+	if t, ok := m.templates[id]; ok {
+		return t, nil
+	}
+	var res *GetTemplateResult
+	var err error
+	if m.team {
+		res, err = m.client.TemplatesGetForTeamContext(ctx, NewGetTemplateArg(id))
+	} else {
+		res, err = m.client.TemplatesGetForUserContext(ctx, NewGetTemplateArg(id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	m.templates[id] = &res.PropertyGroupTemplate
+	return &res.PropertyGroupTemplate, nil
+}
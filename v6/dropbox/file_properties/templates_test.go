@@ -0,0 +1,73 @@
+package file_properties_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+func TestTemplateManager(t *testing.T) {
+	calls := make(map[string]int)
+	var update file_properties.UpdateTemplateArg
+	var add file_properties.AddTemplateArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			calls[r.URL.Path]++
+			switch r.URL.Path {
+			case "/file_properties/templates/list_for_user":
+				_, _ = w.Write([]byte(`{"template_ids": ["ptid:1"]}`))
+			case "/file_properties/templates/get_for_user":
+				_, _ = w.Write([]byte(`{"name": "Review", "description": "old", "fields": [{"name": "status", "description": "", "type": {".tag": "string"}}]}`))
+			case "/file_properties/templates/update_for_user":
+				_ = json.NewDecoder(r.Body).Decode(&update)
+				_, _ = w.Write([]byte(`{"template_id": "ptid:1"}`))
+			case "/file_properties/templates/add_for_user":
+				_ = json.NewDecoder(r.Body).Decode(&add)
+				_, _ = w.Write([]byte(`{"template_id": "ptid:2"}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	m := file_properties.NewTemplateManager(file_properties.New(config))
+	fields, err := file_properties.TemplateFields(reviewProps{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		id, err := m.EnsureTemplate(context.Background(), "Review", "Review status", fields)
+		if err != nil || id != "ptid:1" {
+			t.Fatalf("Unexpected result: %v %v\n", id, err)
+		}
+	}
+	if calls["/file_properties/templates/update_for_user"] != 1 || calls["/file_properties/templates/get_for_user"] != 1 {
+		t.Errorf("Unexpected calls: %v\n", calls)
+	}
+	if update.Description != "Review status" || len(update.AddFields) != len(fields)-1 {
+		t.Errorf("Unexpected update: %+v\n", update)
+	}
+
+	id, err := m.EnsureTemplate(context.Background(), "Other", "", fields[:1])
+	if err != nil || id != "ptid:2" || add.Name != "Other" {
+		t.Errorf("Unexpected result: %v %v %+v\n", id, err, add)
+	}
+	templates, err := m.Templates(context.Background())
+	if err != nil || len(templates) != 2 || len(templates["ptid:1"].Fields) != len(fields) {
+		t.Errorf("Unexpected templates: %v %v\n", templates, err)
+	}
+
+	other := []*file_properties.PropertyFieldTemplate{file_properties.NewPropertyFieldTemplate("status", "",
+		&file_properties.PropertyType{Tagged: dropbox.Tagged{Tag: file_properties.PropertyTypeOther}})}
+	if _, err = m.EnsureTemplate(context.Background(), "Review", "Review status", other); err == nil {
+		t.Errorf("Expected a conflict\n")
+	}
+}
@@ -0,0 +1,60 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package file_requests
+
+// WithDeadline sets Deadline and returns u
+func (u *CreateFileRequestArgs) WithDeadline(Deadline *FileRequestDeadline) *CreateFileRequestArgs {
+	u.Deadline = Deadline
+	return u
+}
+
+// WithOpen sets Open and returns u
+func (u *CreateFileRequestArgs) WithOpen(Open bool) *CreateFileRequestArgs {
+	u.Open = Open
+	return u
+}
+
+// WithDescription sets Description and returns u
+func (u *CreateFileRequestArgs) WithDescription(Description string) *CreateFileRequestArgs {
+	u.Description = Description
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFileRequestsArg) WithLimit(Limit uint64) *ListFileRequestsArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithTitle sets Title and returns u
+func (u *UpdateFileRequestArgs) WithTitle(Title string) *UpdateFileRequestArgs {
+	u.Title = Title
+	return u
+}
+
+// WithDestination sets Destination and returns u
+func (u *UpdateFileRequestArgs) WithDestination(Destination string) *UpdateFileRequestArgs {
+	u.Destination = Destination
+	return u
+}
+
+// WithDeadline sets Deadline and returns u
+func (u *UpdateFileRequestArgs) WithDeadline(Deadline *UpdateFileRequestDeadline) *UpdateFileRequestArgs {
+	u.Deadline = Deadline
+	return u
+}
+
+// WithOpen sets Open and returns u
+func (u *UpdateFileRequestArgs) WithOpen(Open bool) *UpdateFileRequestArgs {
+	u.Open = Open
+	return u
+}
+
+// WithDescription sets Description and returns u
+func (u *UpdateFileRequestArgs) WithDescription(Description string) *UpdateFileRequestArgs {
+	u.Description = Description
+	return u
+}
@@ -76,6 +76,11 @@ type CountAPIError struct {
 	EndpointError *CountFileRequestsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CountAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CountContext(ctx context.Context) (res *CountFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -118,6 +123,11 @@ type CreateAPIError struct {
 	EndpointError *CreateFileRequestError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateContext(ctx context.Context, arg *CreateFileRequestArgs) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -160,6 +170,11 @@ type DeleteAPIError struct {
 	EndpointError *DeleteFileRequestError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteContext(ctx context.Context, arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -202,6 +217,11 @@ type DeleteAllClosedAPIError struct {
 	EndpointError *DeleteAllClosedFileRequestsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteAllClosedAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteAllClosedContext(ctx context.Context) (res *DeleteAllClosedFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -244,6 +264,11 @@ type GetAPIError struct {
 	EndpointError *GetFileRequestError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetContext(ctx context.Context, arg *GetFileRequestArgs) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -286,6 +311,11 @@ type ListAPIError struct {
 	EndpointError *ListFileRequestsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListContext(ctx context.Context) (res *ListFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -328,6 +358,11 @@ type ListV2APIError struct {
 	EndpointError *ListFileRequestsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListV2Context(ctx context.Context, arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -370,6 +405,11 @@ type ListContinueAPIError struct {
 	EndpointError *ListFileRequestsContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListContinueContext(ctx context.Context, arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -412,6 +452,11 @@ type UpdateAPIError struct {
 	EndpointError *UpdateFileRequestError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UpdateContext(ctx context.Context, arg *UpdateFileRequestArgs) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
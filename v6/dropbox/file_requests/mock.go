@@ -0,0 +1,152 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_requests
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	CountFunc           func(ctx context.Context) (res *CountFileRequestsResult, err error)
+	CreateFunc          func(ctx context.Context, arg *CreateFileRequestArgs) (res *FileRequest, err error)
+	DeleteFunc          func(ctx context.Context, arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error)
+	DeleteAllClosedFunc func(ctx context.Context) (res *DeleteAllClosedFileRequestsResult, err error)
+	GetFunc             func(ctx context.Context, arg *GetFileRequestArgs) (res *FileRequest, err error)
+	ListFunc            func(ctx context.Context) (res *ListFileRequestsResult, err error)
+	ListV2Func          func(ctx context.Context, arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error)
+	ListContinueFunc    func(ctx context.Context, arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error)
+	UpdateFunc          func(ctx context.Context, arg *UpdateFileRequestArgs) (res *FileRequest, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) CountContext(ctx context.Context) (res *CountFileRequestsResult, err error) {
+	if m.CountFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CountFunc(ctx)
+}
+
+func (m *Mock) Count() (res *CountFileRequestsResult, err error) {
+	return m.CountContext(context.Background())
+}
+
+func (m *Mock) CreateContext(ctx context.Context, arg *CreateFileRequestArgs) (res *FileRequest, err error) {
+	if m.CreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateFunc(ctx, arg)
+}
+
+func (m *Mock) Create(arg *CreateFileRequestArgs) (res *FileRequest, err error) {
+	return m.CreateContext(context.Background(), arg)
+}
+
+func (m *Mock) DeleteContext(ctx context.Context, arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error) {
+	if m.DeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteFunc(ctx, arg)
+}
+
+func (m *Mock) Delete(arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error) {
+	return m.DeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) DeleteAllClosedContext(ctx context.Context) (res *DeleteAllClosedFileRequestsResult, err error) {
+	if m.DeleteAllClosedFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteAllClosedFunc(ctx)
+}
+
+func (m *Mock) DeleteAllClosed() (res *DeleteAllClosedFileRequestsResult, err error) {
+	return m.DeleteAllClosedContext(context.Background())
+}
+
+func (m *Mock) GetContext(ctx context.Context, arg *GetFileRequestArgs) (res *FileRequest, err error) {
+	if m.GetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetFunc(ctx, arg)
+}
+
+func (m *Mock) Get(arg *GetFileRequestArgs) (res *FileRequest, err error) {
+	return m.GetContext(context.Background(), arg)
+}
+
+func (m *Mock) ListContext(ctx context.Context) (res *ListFileRequestsResult, err error) {
+	if m.ListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFunc(ctx)
+}
+
+func (m *Mock) List() (res *ListFileRequestsResult, err error) {
+	return m.ListContext(context.Background())
+}
+
+func (m *Mock) ListV2Context(ctx context.Context, arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error) {
+	if m.ListV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListV2Func(ctx, arg)
+}
+
+func (m *Mock) ListV2(arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error) {
+	return m.ListV2Context(context.Background(), arg)
+}
+
+func (m *Mock) ListContinueContext(ctx context.Context, arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error) {
+	if m.ListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListContinue(arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error) {
+	return m.ListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) UpdateContext(ctx context.Context, arg *UpdateFileRequestArgs) (res *FileRequest, err error) {
+	if m.UpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UpdateFunc(ctx, arg)
+}
+
+func (m *Mock) Update(arg *UpdateFileRequestArgs) (res *FileRequest, err error) {
+	return m.UpdateContext(context.Background(), arg)
+}
@@ -0,0 +1,127 @@
+package file_requests
+
+import (
+	"context"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// RequestBuilder builds the argument of `create`:
+//
+//	arg := file_requests.NewRequestBuilder("Receipts", "/Receipts").
+//		Deadline(due, file_requests.GracePeriodSevenDays).
+//		Description("Upload your receipts for May").
+//		Arg()
+//	req, err := dbx.Create(arg)
+type RequestBuilder struct {
+	arg *CreateFileRequestArgs
+}
+
+// NewRequestBuilder returns a RequestBuilder of an open file request titled
+// title, uploading to the folder destination.
+func NewRequestBuilder(title string, destination string) *RequestBuilder {
+	return &RequestBuilder{arg: NewCreateFileRequestArgs(title, destination)}
+}
+
+// Deadline sets the deadline of the file request, after which uploads are
+// refused unless allowed by gracePeriod, one of the GracePeriod tags, or ""
+// to refuse late uploads.
+func (b *RequestBuilder) Deadline(deadline time.Time, gracePeriod string) *RequestBuilder {
+	b.arg.Deadline = NewDeadline(deadline, gracePeriod)
+	return b
+}
+
+// Description sets the description shown to the uploaders.
+func (b *RequestBuilder) Description(description string) *RequestBuilder {
+	b.arg.Description = description
+	return b
+}
+
+// Closed creates the file request closed, so that it accepts no uploads
+// until opened.
+func (b *RequestBuilder) Closed() *RequestBuilder {
+	b.arg.Open = false
+	return b
+}
+
+// Arg returns the built argument.
+func (b *RequestBuilder) Arg() *CreateFileRequestArgs {
+	return b.arg
+}
+
+// NewDeadline returns a FileRequestDeadline at deadline, allowing late
+// uploads for gracePeriod, one of the GracePeriod tags, unless it is "".
+func NewDeadline(deadline time.Time, gracePeriod string) *FileRequestDeadline {
+	d := NewFileRequestDeadline(deadline.UTC().Truncate(time.Second))
+	if gracePeriod != "" {
+		d.AllowLateUploads = &GracePeriod{Tagged: dropbox.Tagged{Tag: gracePeriod}}
+	}
+	return d
+}
+
+// SetDeadline sets the deadline of the file request id, or clears it if
+// deadline is nil, leaving the other settings unchanged.
+func SetDeadline(ctx context.Context, client Client, id string, deadline *FileRequestDeadline) (*FileRequest, error) {
+	arg := NewUpdateFileRequestArgs(id)
+	arg.Deadline = &UpdateFileRequestDeadline{Tagged: dropbox.Tagged{Tag: UpdateFileRequestDeadlineUpdate}, Update: deadline}
+	return client.UpdateContext(ctx, arg)
+}
+
+// DeleteRequests deletes the file requests ids, with a single `delete`
+// request, and returns the deleted requests along with the ids that
+// Dropbox didn't report as deleted.
+func DeleteRequests(ctx context.Context, client Client, ids []string) (deleted []*FileRequest, missing []string, err error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+	res, err := client.DeleteContext(ctx, NewDeleteFileRequestArgs(ids))
+	if err != nil {
+		return nil, nil, err
+	}
+	found := make(map[string]bool, len(res.FileRequests))
+	for _, r := range res.FileRequests {
+		found[r.Id] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return res.FileRequests, missing, nil
+}
+
+// FileRequestIterator iterates over the file requests of the account,
+// fetching the pages of `list_v2` and `list/continue` as needed.
+type FileRequestIterator struct {
+	pages    *ListV2Iterator
+	requests []*FileRequest
+}
+
+// NewFileRequestIterator returns a new FileRequestIterator instance
+func NewFileRequestIterator(client Client) *FileRequestIterator {
+	return &FileRequestIterator{pages: NewListV2Iterator(client, NewListFileRequestsArg())}
+}
+
+// HasMore returns false once all file requests have been returned. As a
+// page may be empty, Next can still return `dropbox.ErrNoMorePages` after
+// HasMore returned true.
+func (it *FileRequestIterator) HasMore() bool {
+	return len(it.requests) > 0 || it.pages.HasMore()
+}
+
+// Next returns the next file request, fetching the next page if needed. It
+// returns `dropbox.ErrNoMorePages` once all file requests have been
+// returned.
+func (it *FileRequestIterator) Next(ctx context.Context) (*FileRequest, error) {
+	for len(it.requests) == 0 {
+		res, err := it.pages.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		it.requests = res.FileRequests
+	}
+	r := it.requests[0]
+	it.requests = it.requests[1:]
+	return r, nil
+}
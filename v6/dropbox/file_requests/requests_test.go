@@ -0,0 +1,88 @@
+package file_requests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_requests"
+)
+
+func TestFileRequests(t *testing.T) {
+	var created, updated map[string]interface{}
+	request := func(id string) string {
+		return fmt.Sprintf(`{"id": %q, "url": "https://www.dropbox.com/request/%s", "title": "R", "created": "2024-01-01T00:00:00Z",
+			"is_open": true, "file_count": 0}`, id, id)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/file_requests/create":
+				created = arg
+				_, _ = w.Write([]byte(request("r1")))
+			case "/file_requests/update":
+				updated = arg
+				_, _ = w.Write([]byte(request("r1")))
+			case "/file_requests/list_v2":
+				_, _ = w.Write([]byte(`{"file_requests": [` + request("r1") + `,` + request("r2") + `], "cursor": "c1", "has_more": true}`))
+			case "/file_requests/list/continue":
+				_, _ = w.Write([]byte(`{"file_requests": [` + request("r3") + `], "cursor": "c2", "has_more": false}`))
+			case "/file_requests/delete":
+				_, _ = w.Write([]byte(`{"file_requests": [` + request("r1") + `]}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := file_requests.New(config)
+	ctx := context.Background()
+	due := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	arg := file_requests.NewRequestBuilder("Receipts", "/Receipts").Deadline(due, file_requests.GracePeriodSevenDays).Closed().Arg()
+	if _, err := dbx.CreateContext(ctx, arg); err != nil {
+		t.Fatal(err)
+	}
+	deadline, _ := created["deadline"].(map[string]interface{})
+	if created["open"] != false || deadline["deadline"] != "2024-06-01T12:00:00Z" {
+		t.Errorf("Unexpected create: %v\n", created)
+	}
+
+	if _, err := file_requests.SetDeadline(ctx, dbx, "r1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if d, _ := updated["deadline"].(map[string]interface{}); updated["id"] != "r1" || d[".tag"] != "update" {
+		t.Errorf("Unexpected update: %v\n", updated)
+	}
+
+	var ids []string
+	it := file_requests.NewFileRequestIterator(dbx)
+	for it.HasMore() {
+		r, err := it.Next(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, r.Id)
+	}
+	if strings.Join(ids, ",") != "r1,r2,r3" {
+		t.Errorf("Unexpected requests: %v\n", ids)
+	}
+
+	deleted, missing, err := file_requests.DeleteRequests(ctx, dbx, []string{"r1", "r9"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || strings.Join(missing, ",") != "r9" {
+		t.Errorf("Unexpected deletion: %d %v\n", len(deleted), missing)
+	}
+}
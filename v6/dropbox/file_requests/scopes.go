@@ -0,0 +1,19 @@
+package file_requests
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"count":             "file_requests.read",
+	"create":            "file_requests.write",
+	"delete":            "file_requests.write",
+	"delete_all_closed": "file_requests.write",
+	"get":               "file_requests.read",
+	"list":              "file_requests.read",
+	"list_v2":           "file_requests.read",
+	"list/continue":     "file_requests.read",
+	"update":            "file_requests.write",
+}
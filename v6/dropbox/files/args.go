@@ -0,0 +1,534 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package files
+
+import (
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// WithIncludeMediaInfo sets IncludeMediaInfo and returns u
+func (u *GetMetadataArg) WithIncludeMediaInfo(IncludeMediaInfo bool) *GetMetadataArg {
+	u.IncludeMediaInfo = IncludeMediaInfo
+	return u
+}
+
+// WithIncludeDeleted sets IncludeDeleted and returns u
+func (u *GetMetadataArg) WithIncludeDeleted(IncludeDeleted bool) *GetMetadataArg {
+	u.IncludeDeleted = IncludeDeleted
+	return u
+}
+
+// WithIncludeHasExplicitSharedMembers sets IncludeHasExplicitSharedMembers and returns u
+func (u *GetMetadataArg) WithIncludeHasExplicitSharedMembers(IncludeHasExplicitSharedMembers bool) *GetMetadataArg {
+	u.IncludeHasExplicitSharedMembers = IncludeHasExplicitSharedMembers
+	return u
+}
+
+// WithIncludePropertyGroups sets IncludePropertyGroups and returns u
+func (u *GetMetadataArg) WithIncludePropertyGroups(IncludePropertyGroups *file_properties.TemplateFilterBase) *GetMetadataArg {
+	u.IncludePropertyGroups = IncludePropertyGroups
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *GetMetadataArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithIncludeMediaInfo sets IncludeMediaInfo and returns u
+func (u *AlphaGetMetadataArg) WithIncludeMediaInfo(IncludeMediaInfo bool) *AlphaGetMetadataArg {
+	u.IncludeMediaInfo = IncludeMediaInfo
+	return u
+}
+
+// WithIncludeDeleted sets IncludeDeleted and returns u
+func (u *AlphaGetMetadataArg) WithIncludeDeleted(IncludeDeleted bool) *AlphaGetMetadataArg {
+	u.IncludeDeleted = IncludeDeleted
+	return u
+}
+
+// WithIncludeHasExplicitSharedMembers sets IncludeHasExplicitSharedMembers and returns u
+func (u *AlphaGetMetadataArg) WithIncludeHasExplicitSharedMembers(IncludeHasExplicitSharedMembers bool) *AlphaGetMetadataArg {
+	u.IncludeHasExplicitSharedMembers = IncludeHasExplicitSharedMembers
+	return u
+}
+
+// WithIncludePropertyGroups sets IncludePropertyGroups and returns u
+func (u *AlphaGetMetadataArg) WithIncludePropertyGroups(IncludePropertyGroups *file_properties.TemplateFilterBase) *AlphaGetMetadataArg {
+	u.IncludePropertyGroups = IncludePropertyGroups
+	return u
+}
+
+// WithIncludePropertyTemplates sets IncludePropertyTemplates and returns u
+func (u *AlphaGetMetadataArg) WithIncludePropertyTemplates(IncludePropertyTemplates []string) *AlphaGetMetadataArg {
+	u.IncludePropertyTemplates = IncludePropertyTemplates
+	return u
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *CreateFolderArg) WithAutorename(Autorename bool) *CreateFolderArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *CreateFolderArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *CreateFolderBatchArg) WithAutorename(Autorename bool) *CreateFolderBatchArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithForceAsync sets ForceAsync and returns u
+func (u *CreateFolderBatchArg) WithForceAsync(ForceAsync bool) *CreateFolderBatchArg {
+	u.ForceAsync = ForceAsync
+	return u
+}
+
+// WithParentRev sets ParentRev and returns u
+func (u *DeleteArg) WithParentRev(ParentRev string) *DeleteArg {
+	u.ParentRev = ParentRev
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *DeleteArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`); err != nil {
+		return err
+	}
+	if u.ParentRev != "" {
+		if err := dropbox.ValidateString("parent_rev", u.ParentRev, 9, -1, `[0-9a-f]+`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithRev sets Rev and returns u
+func (u *DownloadArg) WithRev(Rev string) *DownloadArg {
+	u.Rev = Rev
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *DownloadArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	if u.Rev != "" {
+		if err := dropbox.ValidateString("rev", u.Rev, 9, -1, `[0-9a-f]+`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithExportFormat sets ExportFormat and returns u
+func (u *ExportArg) WithExportFormat(ExportFormat string) *ExportArg {
+	u.ExportFormat = ExportFormat
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *GetTemporaryLinkArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*|id:.*)|(rev:[0-9a-f]{9,})|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithDuration sets Duration and returns u
+func (u *GetTemporaryUploadLinkArg) WithDuration(Duration float64) *GetTemporaryUploadLinkArg {
+	u.Duration = Duration
+	return u
+}
+
+// WithRecursive sets Recursive and returns u
+func (u *ListFolderArg) WithRecursive(Recursive bool) *ListFolderArg {
+	u.Recursive = Recursive
+	return u
+}
+
+// WithIncludeMediaInfo sets IncludeMediaInfo and returns u
+func (u *ListFolderArg) WithIncludeMediaInfo(IncludeMediaInfo bool) *ListFolderArg {
+	u.IncludeMediaInfo = IncludeMediaInfo
+	return u
+}
+
+// WithIncludeDeleted sets IncludeDeleted and returns u
+func (u *ListFolderArg) WithIncludeDeleted(IncludeDeleted bool) *ListFolderArg {
+	u.IncludeDeleted = IncludeDeleted
+	return u
+}
+
+// WithIncludeHasExplicitSharedMembers sets IncludeHasExplicitSharedMembers and returns u
+func (u *ListFolderArg) WithIncludeHasExplicitSharedMembers(IncludeHasExplicitSharedMembers bool) *ListFolderArg {
+	u.IncludeHasExplicitSharedMembers = IncludeHasExplicitSharedMembers
+	return u
+}
+
+// WithIncludeMountedFolders sets IncludeMountedFolders and returns u
+func (u *ListFolderArg) WithIncludeMountedFolders(IncludeMountedFolders bool) *ListFolderArg {
+	u.IncludeMountedFolders = IncludeMountedFolders
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFolderArg) WithLimit(Limit uint32) *ListFolderArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithSharedLink sets SharedLink and returns u
+func (u *ListFolderArg) WithSharedLink(SharedLink *SharedLink) *ListFolderArg {
+	u.SharedLink = SharedLink
+	return u
+}
+
+// WithIncludePropertyGroups sets IncludePropertyGroups and returns u
+func (u *ListFolderArg) WithIncludePropertyGroups(IncludePropertyGroups *file_properties.TemplateFilterBase) *ListFolderArg {
+	u.IncludePropertyGroups = IncludePropertyGroups
+	return u
+}
+
+// WithIncludeNonDownloadableFiles sets IncludeNonDownloadableFiles and returns u
+func (u *ListFolderArg) WithIncludeNonDownloadableFiles(IncludeNonDownloadableFiles bool) *ListFolderArg {
+	u.IncludeNonDownloadableFiles = IncludeNonDownloadableFiles
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *ListFolderArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*)?|id:.*|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	if u.Limit != 0 {
+		if err := dropbox.ValidateMin("limit", u.Limit, 1); err != nil {
+			return err
+		}
+		if err := dropbox.ValidateMax("limit", u.Limit, 2000); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *ListFolderContinueArg) Validate() error {
+	if err := dropbox.ValidateString("cursor", u.Cursor, 1, -1, ``); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithTimeout sets Timeout and returns u
+func (u *ListFolderLongpollArg) WithTimeout(Timeout uint64) *ListFolderLongpollArg {
+	u.Timeout = Timeout
+	return u
+}
+
+// WithMode sets Mode and returns u
+func (u *ListRevisionsArg) WithMode(Mode *ListRevisionsMode) *ListRevisionsArg {
+	u.Mode = Mode
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListRevisionsArg) WithLimit(Limit uint64) *ListRevisionsArg {
+	u.Limit = Limit
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *ListRevisionsArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `/(.|[\r\n])*|id:.*|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	if err := dropbox.ValidateMin("limit", u.Limit, 1); err != nil {
+		return err
+	}
+	if err := dropbox.ValidateMax("limit", u.Limit, 100); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *RelocationBatchArgBase) WithAutorename(Autorename bool) *RelocationBatchArgBase {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *MoveBatchArg) WithAutorename(Autorename bool) *MoveBatchArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithAllowOwnershipTransfer sets AllowOwnershipTransfer and returns u
+func (u *MoveBatchArg) WithAllowOwnershipTransfer(AllowOwnershipTransfer bool) *MoveBatchArg {
+	u.AllowOwnershipTransfer = AllowOwnershipTransfer
+	return u
+}
+
+// WithPaperRevision sets PaperRevision and returns u
+func (u *PaperUpdateArg) WithPaperRevision(PaperRevision int64) *PaperUpdateArg {
+	u.PaperRevision = PaperRevision
+	return u
+}
+
+// WithRev sets Rev and returns u
+func (u *PreviewArg) WithRev(Rev string) *PreviewArg {
+	u.Rev = Rev
+	return u
+}
+
+// WithAllowSharedFolder sets AllowSharedFolder and returns u
+func (u *RelocationArg) WithAllowSharedFolder(AllowSharedFolder bool) *RelocationArg {
+	u.AllowSharedFolder = AllowSharedFolder
+	return u
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *RelocationArg) WithAutorename(Autorename bool) *RelocationArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithAllowOwnershipTransfer sets AllowOwnershipTransfer and returns u
+func (u *RelocationArg) WithAllowOwnershipTransfer(AllowOwnershipTransfer bool) *RelocationArg {
+	u.AllowOwnershipTransfer = AllowOwnershipTransfer
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *RelocationArg) Validate() error {
+	if err := dropbox.ValidateString("from_path", u.FromPath, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`); err != nil {
+		return err
+	}
+	if err := dropbox.ValidateString("to_path", u.ToPath, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *RelocationBatchArg) WithAutorename(Autorename bool) *RelocationBatchArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithAllowSharedFolder sets AllowSharedFolder and returns u
+func (u *RelocationBatchArg) WithAllowSharedFolder(AllowSharedFolder bool) *RelocationBatchArg {
+	u.AllowSharedFolder = AllowSharedFolder
+	return u
+}
+
+// WithAllowOwnershipTransfer sets AllowOwnershipTransfer and returns u
+func (u *RelocationBatchArg) WithAllowOwnershipTransfer(AllowOwnershipTransfer bool) *RelocationBatchArg {
+	u.AllowOwnershipTransfer = AllowOwnershipTransfer
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *RestoreArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)`); err != nil {
+		return err
+	}
+	if err := dropbox.ValidateString("rev", u.Rev, 9, -1, `[0-9a-f]+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithStart sets Start and returns u
+func (u *SearchArg) WithStart(Start uint64) *SearchArg {
+	u.Start = Start
+	return u
+}
+
+// WithMaxResults sets MaxResults and returns u
+func (u *SearchArg) WithMaxResults(MaxResults uint64) *SearchArg {
+	u.MaxResults = MaxResults
+	return u
+}
+
+// WithMode sets Mode and returns u
+func (u *SearchArg) WithMode(Mode *SearchMode) *SearchArg {
+	u.Mode = Mode
+	return u
+}
+
+// WithOptions sets Options and returns u
+func (u *SearchV2Arg) WithOptions(Options *SearchOptions) *SearchV2Arg {
+	u.Options = Options
+	return u
+}
+
+// WithMatchFieldOptions sets MatchFieldOptions and returns u
+func (u *SearchV2Arg) WithMatchFieldOptions(MatchFieldOptions *SearchMatchFieldOptions) *SearchV2Arg {
+	u.MatchFieldOptions = MatchFieldOptions
+	return u
+}
+
+// WithIncludeHighlights sets IncludeHighlights and returns u
+func (u *SearchV2Arg) WithIncludeHighlights(IncludeHighlights bool) *SearchV2Arg {
+	u.IncludeHighlights = IncludeHighlights
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *SearchV2Arg) Validate() error {
+	if err := dropbox.ValidateString("query", u.Query, -1, 1000, ``); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithFormat sets Format and returns u
+func (u *ThumbnailArg) WithFormat(Format *ThumbnailFormat) *ThumbnailArg {
+	u.Format = Format
+	return u
+}
+
+// WithSize sets Size and returns u
+func (u *ThumbnailArg) WithSize(Size *ThumbnailSize) *ThumbnailArg {
+	u.Size = Size
+	return u
+}
+
+// WithMode sets Mode and returns u
+func (u *ThumbnailArg) WithMode(Mode *ThumbnailMode) *ThumbnailArg {
+	u.Mode = Mode
+	return u
+}
+
+// WithFormat sets Format and returns u
+func (u *ThumbnailV2Arg) WithFormat(Format *ThumbnailFormat) *ThumbnailV2Arg {
+	u.Format = Format
+	return u
+}
+
+// WithSize sets Size and returns u
+func (u *ThumbnailV2Arg) WithSize(Size *ThumbnailSize) *ThumbnailV2Arg {
+	u.Size = Size
+	return u
+}
+
+// WithMode sets Mode and returns u
+func (u *ThumbnailV2Arg) WithMode(Mode *ThumbnailMode) *ThumbnailV2Arg {
+	u.Mode = Mode
+	return u
+}
+
+// WithMode sets Mode and returns u
+func (u *UploadArg) WithMode(Mode *WriteMode) *UploadArg {
+	u.Mode = Mode
+	return u
+}
+
+// WithAutorename sets Autorename and returns u
+func (u *UploadArg) WithAutorename(Autorename bool) *UploadArg {
+	u.Autorename = Autorename
+	return u
+}
+
+// WithClientModified sets ClientModified and returns u
+func (u *UploadArg) WithClientModified(ClientModified *time.Time) *UploadArg {
+	u.ClientModified = ClientModified
+	return u
+}
+
+// WithMute sets Mute and returns u
+func (u *UploadArg) WithMute(Mute bool) *UploadArg {
+	u.Mute = Mute
+	return u
+}
+
+// WithPropertyGroups sets PropertyGroups and returns u
+func (u *UploadArg) WithPropertyGroups(PropertyGroups []*file_properties.PropertyGroup) *UploadArg {
+	u.PropertyGroups = PropertyGroups
+	return u
+}
+
+// WithStrictConflict sets StrictConflict and returns u
+func (u *UploadArg) WithStrictConflict(StrictConflict bool) *UploadArg {
+	u.StrictConflict = StrictConflict
+	return u
+}
+
+// WithContentHash sets ContentHash and returns u
+func (u *UploadArg) WithContentHash(ContentHash string) *UploadArg {
+	u.ContentHash = ContentHash
+	return u
+}
+
+// Validate checks u against the constraints of the API spec
+func (u *UploadArg) Validate() error {
+	if err := dropbox.ValidateString("path", u.Path, -1, -1, `(/(.|[\r\n])*)|(ns:[0-9]+(/.*)?)|(id:.*)`); err != nil {
+		return err
+	}
+	if u.ContentHash != "" {
+		if err := dropbox.ValidateString("content_hash", u.ContentHash, 64, 64, ``); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithClose sets Close and returns u
+func (u *UploadSessionAppendArg) WithClose(Close bool) *UploadSessionAppendArg {
+	u.Close = Close
+	return u
+}
+
+// WithContentHash sets ContentHash and returns u
+func (u *UploadSessionAppendArg) WithContentHash(ContentHash string) *UploadSessionAppendArg {
+	u.ContentHash = ContentHash
+	return u
+}
+
+// WithContentHash sets ContentHash and returns u
+func (u *UploadSessionFinishArg) WithContentHash(ContentHash string) *UploadSessionFinishArg {
+	u.ContentHash = ContentHash
+	return u
+}
+
+// WithClose sets Close and returns u
+func (u *UploadSessionStartArg) WithClose(Close bool) *UploadSessionStartArg {
+	u.Close = Close
+	return u
+}
+
+// WithSessionType sets SessionType and returns u
+func (u *UploadSessionStartArg) WithSessionType(SessionType *UploadSessionType) *UploadSessionStartArg {
+	u.SessionType = SessionType
+	return u
+}
+
+// WithContentHash sets ContentHash and returns u
+func (u *UploadSessionStartArg) WithContentHash(ContentHash string) *UploadSessionStartArg {
+	u.ContentHash = ContentHash
+	return u
+}
+
+// WithSessionType sets SessionType and returns u
+func (u *UploadSessionStartBatchArg) WithSessionType(SessionType *UploadSessionType) *UploadSessionStartBatchArg {
+	u.SessionType = SessionType
+	return u
+}
@@ -0,0 +1,173 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// BatchOptions controls CopyBatch, MoveBatch and DeleteBatch, and the
+// polling of SaveURLAndWait.
+type BatchOptions struct {
+	// Autorename renames entries to avoid conflicts when copying or moving.
+	Autorename bool
+	// AllowOwnershipTransfer allows moves that transfer the ownership of
+	// content.
+	AllowOwnershipTransfer bool
+	// JobOptions controls the polling of the jobs.
+	async.JobOptions
+}
+
+// RelocationOutcome is the result of copying or moving a single entry.
+type RelocationOutcome struct {
+	Entry *RelocationPath
+	// Metadata of the entry at its destination, on success.
+	Metadata IsMetadata
+	// Failure tells why the entry wasn't copied or moved.
+	Failure *RelocationBatchErrorEntry
+}
+
+// DeleteOutcome is the result of deleting a single entry.
+type DeleteOutcome struct {
+	Entry *DeleteArg
+	// Metadata of the deleted entry, on success.
+	Metadata IsMetadata
+	// Failure tells why the entry wasn't deleted.
+	Failure *DeleteError
+}
+
+// DeleteBatchFailedError is returned when a `delete_batch` job failed as a
+// whole.
+type DeleteBatchFailedError struct {
+	Failure *DeleteBatchError
+}
+
+func (e DeleteBatchFailedError) Error() string {
+	return fmt.Sprintf("delete batch failed: %s", e.Failure.Tag)
+}
+
+// errUnexpectedBatchResult is returned for batch responses of an unknown
+// type.
+var errUnexpectedBatchResult = errors.New("unexpected batch result")
+
+// CopyBatch copies entries with `copy_batch_v2`, in batches of
+// dropbox.MaxRelocationBatch entries submitted one after the other, and
+// waits for every job to complete. The outcomes are in the order of
+// entries. If a request fails, the outcomes of the batches completed so far
+// are returned along with the error.
+func CopyBatch(ctx context.Context, client Client, entries []*RelocationPath, opts *BatchOptions) ([]*RelocationOutcome, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	return relocateBatch(ctx, entries, opts, func(chunk []*RelocationPath) (*RelocationBatchV2Launch, error) {
+		arg := NewRelocationBatchArgBase(chunk)
+		arg.Autorename = opts.Autorename
+		return client.CopyBatchV2Context(ctx, arg)
+	}, client.CopyBatchCheckV2Context)
+}
+
+// MoveBatch moves entries with `move_batch_v2`. See CopyBatch for how
+// entries are batched and errors reported.
+func MoveBatch(ctx context.Context, client Client, entries []*RelocationPath, opts *BatchOptions) ([]*RelocationOutcome, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	return relocateBatch(ctx, entries, opts, func(chunk []*RelocationPath) (*RelocationBatchV2Launch, error) {
+		arg := NewMoveBatchArg(chunk)
+		arg.Autorename = opts.Autorename
+		arg.AllowOwnershipTransfer = opts.AllowOwnershipTransfer
+		return client.MoveBatchV2Context(ctx, arg)
+	}, client.MoveBatchCheckV2Context)
+}
+
+func relocateBatch(ctx context.Context, entries []*RelocationPath, opts *BatchOptions,
+	submit func(chunk []*RelocationPath) (*RelocationBatchV2Launch, error),
+	check func(ctx context.Context, arg *async.PollArg) (*RelocationBatchV2JobStatus, error)) ([]*RelocationOutcome, error) {
+	var res []*RelocationOutcome
+	for start := 0; start < len(entries); start += dropbox.MaxRelocationBatch {
+		end := start + dropbox.MaxRelocationBatch
+		if end > len(entries) {
+			end = len(entries)
+		}
+		chunk := entries[start:end]
+		launch, err := submit(chunk)
+		if err != nil {
+			return res, err
+		}
+		result := launch.Complete
+		if launch.Tag == RelocationBatchV2LaunchAsyncJobId {
+			v, err := async.Await(ctx, launch.AsyncJobId, &opts.JobOptions, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+				status, err := check(ctx, arg)
+				if err != nil {
+					return nil, true, err
+				}
+				return status.Complete, status.Tag != RelocationBatchV2JobStatusInProgress, nil
+			})
+			if err != nil {
+				return res, err
+			}
+			result, _ = v.(*RelocationBatchV2Result)
+		}
+		if result == nil || len(result.Entries) != len(chunk) {
+			return res, errUnexpectedBatchResult
+		}
+		for i, e := range chunk {
+			r := result.Entries[i]
+			res = append(res, &RelocationOutcome{Entry: e, Metadata: r.Success, Failure: r.Failure})
+		}
+	}
+	return res, nil
+}
+
+// DeleteBatch deletes entries with `delete_batch`, in batches of
+// dropbox.MaxDeleteBatch entries. See CopyBatch for how entries are batched
+// and errors reported. A job failing as a whole is reported with a
+// DeleteBatchFailedError.
+func DeleteBatch(ctx context.Context, client Client, entries []*DeleteArg, opts *BatchOptions) ([]*DeleteOutcome, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	var res []*DeleteOutcome
+	for start := 0; start < len(entries); start += dropbox.MaxDeleteBatch {
+		end := start + dropbox.MaxDeleteBatch
+		if end > len(entries) {
+			end = len(entries)
+		}
+		chunk := entries[start:end]
+		launch, err := client.DeleteBatchContext(ctx, NewDeleteBatchArg(chunk))
+		if err != nil {
+			return res, err
+		}
+		result := launch.Complete
+		if launch.Tag == DeleteBatchLaunchAsyncJobId {
+			v, err := async.Await(ctx, launch.AsyncJobId, &opts.JobOptions, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+				status, err := client.DeleteBatchCheckContext(ctx, arg)
+				if err != nil {
+					return nil, true, err
+				}
+				if status.Tag == DeleteBatchJobStatusFailed && status.Failed != nil {
+					return nil, true, DeleteBatchFailedError{Failure: status.Failed}
+				}
+				return status.Complete, status.Tag != DeleteBatchJobStatusInProgress, nil
+			})
+			if err != nil {
+				return res, err
+			}
+			result, _ = v.(*DeleteBatchResult)
+		}
+		if result == nil || len(result.Entries) != len(chunk) {
+			return res, errUnexpectedBatchResult
+		}
+		for i, e := range chunk {
+			o := &DeleteOutcome{Entry: e, Failure: result.Entries[i].Failure}
+			if s := result.Entries[i].Success; s != nil {
+				o.Metadata = s.Metadata
+			}
+			res = append(res, o)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestBatch(t *testing.T) {
+	var routes []string
+	checks := 0
+	entries := func(n int) string {
+		var e []string
+		for i := 0; i < n; i++ {
+			e = append(e, `{".tag": "success", "metadata": {".tag": "file", "name": "a"}}`)
+		}
+		e[0] = `{".tag": "failure", "failure": {".tag": "path_lookup", "path_lookup": {".tag": "not_found"}}}`
+		return strings.Join(e, ",")
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg struct {
+				Entries []json.RawMessage `json:"entries"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			routes = append(routes, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/delete_batch":
+				if len(arg.Entries) == 1000 {
+					_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "j1"}`))
+					return
+				}
+				fmt.Fprintf(w, `{".tag": "complete", "entries": [%s]}`, entries(len(arg.Entries)))
+			case "/files/delete_batch/check":
+				if checks++; checks < 3 {
+					_, _ = w.Write([]byte(`{".tag": "in_progress"}`))
+					return
+				}
+				fmt.Fprintf(w, `{".tag": "complete", "entries": [%s]}`, entries(1000))
+			case "/files/copy_batch_v2":
+				_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "j2"}`))
+			case "/files/copy_batch/check_v2":
+				_, _ = w.Write([]byte(`{".tag": "complete", "entries": [{".tag": "success", "success": {".tag": "file", "name": "b"}}]}`))
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	opts := &files.BatchOptions{JobOptions: async.JobOptions{PollInterval: time.Millisecond, MaxPollInterval: 4 * time.Millisecond}}
+	var args []*files.DeleteArg
+	for i := 0; i < 1002; i++ {
+		args = append(args, files.NewDeleteArg(fmt.Sprintf("/%d", i)))
+	}
+	deleted, err := files.DeleteBatch(context.Background(), client, args, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1002 || deleted[0].Failure == nil || deleted[1].Metadata == nil || deleted[1000].Failure == nil || deleted[1000].Entry.Path != "/1000" {
+		t.Errorf("Unexpected outcomes: %d\n", len(deleted))
+	}
+	if strings.Join(routes, ",") != "/files/delete_batch,/files/delete_batch/check,/files/delete_batch/check,/files/delete_batch/check,/files/delete_batch" {
+		t.Errorf("Unexpected routes: %v\n", routes)
+	}
+
+	copied, err := files.CopyBatch(context.Background(), client, []*files.RelocationPath{files.NewRelocationPath("/a", "/b")}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := copied[0].Metadata.(*files.FileMetadata); !ok || f.Name != "b" {
+		t.Errorf("Unexpected outcome: %+v\n", copied[0])
+	}
+}
@@ -0,0 +1,146 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// BatchUploadFile is a file uploaded by a BatchUploader.
+type BatchUploadFile struct {
+	Commit  *CommitInfo
+	Content io.Reader
+	Size    int64
+}
+
+// BatchUploadOutcome is the result of uploading a single file.
+type BatchUploadOutcome struct {
+	File *BatchUploadFile
+	// Metadata of the uploaded file, on success.
+	Metadata *FileMetadata
+	// Failure tells why the file couldn't be committed, e.g. because of a
+	// conflict.
+	Failure *UploadSessionFinishError
+	// Err is set if the content of the file couldn't be uploaded.
+	Err error
+}
+
+// BatchUploader uploads many files at once: it starts an upload session for
+// every file with `upload_session/start_batch`, uploads their content
+// concurrently, then commits them all with a single
+// `upload_session/finish_batch_v2`, which avoids the lock contention of
+// committing files one by one. Files are uploaded in batches of
+// dropbox.MaxUploadSessionBatch.
+type BatchUploader struct {
+	client Client
+
+	// Concurrency is the number of files uploaded in parallel. Defaults to
+	// 4.
+	Concurrency int
+	// MaxRetries is the number of times a failed chunk is retried. Defaults
+	// to 3; a negative value disables retries.
+	MaxRetries int
+}
+
+// NewBatchUploader returns a BatchUploader with default settings.
+func NewBatchUploader(client Client) *BatchUploader {
+	return &BatchUploader{client: client}
+}
+
+// Upload uploads files and returns their outcomes, in the order of files.
+// Failures of single files are reported in the outcomes; the returned error
+// is set if starting or committing a batch fails, in which case the
+// outcomes of the batches committed so far are returned alongside it.
+func (u *BatchUploader) Upload(ctx context.Context, files []*BatchUploadFile) ([]*BatchUploadOutcome, error) {
+	var res []*BatchUploadOutcome
+	for start := 0; start < len(files); start += dropbox.MaxUploadSessionBatch {
+		end := start + dropbox.MaxUploadSessionBatch
+		if end > len(files) {
+			end = len(files)
+		}
+		outcomes, err := u.uploadBatch(ctx, files[start:end])
+		if err != nil {
+			return res, err
+		}
+		res = append(res, outcomes...)
+	}
+	return res, nil
+}
+
+func (u *BatchUploader) uploadBatch(ctx context.Context, files []*BatchUploadFile) ([]*BatchUploadOutcome, error) {
+	arg := NewUploadSessionStartBatchArg(uint64(len(files)))
+	arg.SessionType = &UploadSessionType{Tagged: dropbox.Tagged{Tag: UploadSessionTypeConcurrent}}
+	sessions, err := u.client.UploadSessionStartBatchContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions.SessionIds) != len(files) {
+		return nil, errUnexpectedBatchResult
+	}
+
+	outcomes := make([]*BatchUploadOutcome, len(files))
+	uploader := &Uploader{client: u.client, Concurrency: 1, MaxRetries: u.MaxRetries}
+	workers := u.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, f := range files {
+		outcomes[i] = &BatchUploadOutcome{File: f}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(o *BatchUploadOutcome, sessionID string) {
+			defer func() { <-sem; wg.Done() }()
+			switch {
+			case o.File.Size < 0:
+				o.Err = errNegativeSize
+				return
+			case o.File.Size == 0:
+				// Close the session, which has no chunk to close it.
+				arg := NewUploadSessionAppendArg(NewUploadSessionCursor(sessionID, 0))
+				arg.Close = true
+				o.Err = u.client.UploadSessionAppendV2Context(ctx, arg, bytes.NewReader(nil))
+				return
+			}
+			p := newSessionProgress(UploadState{
+				SessionID: sessionID,
+				Size:      o.File.Size,
+				ChunkSize: uploader.chunkSize(),
+			}, nil)
+			o.Err = uploader.appendChunks(ctx, o.File.Content, p, newTransferRecorder(o.File.Size, nil))
+		}(outcomes[i], sessions.SessionIds[i])
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// Commit the files whose content was uploaded.
+	var entries []*UploadSessionFinishArg
+	var committed []*BatchUploadOutcome
+	for i, o := range outcomes {
+		if o.Err == nil {
+			cursor := NewUploadSessionCursor(sessions.SessionIds[i], uint64(o.File.Size))
+			entries = append(entries, NewUploadSessionFinishArg(cursor, o.File.Commit))
+			committed = append(committed, o)
+		}
+	}
+	if len(entries) == 0 {
+		return outcomes, nil
+	}
+	result, err := u.client.UploadSessionFinishBatchV2Context(ctx, NewUploadSessionFinishBatchArg(entries))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.Entries) != len(committed) {
+		return nil, errUnexpectedBatchResult
+	}
+	for i, o := range committed {
+		o.Metadata, o.Failure = result.Entries[i].Success, result.Entries[i].Failure
+	}
+	return outcomes, nil
+}
@@ -0,0 +1,74 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestBatchUploader(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]string{}
+	var finish files.UploadSessionFinishBatchArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/upload_session/start_batch":
+				_, _ = w.Write([]byte(`{"session_ids": ["s1", "s2", "s3"]}`))
+			case "/files/upload_session/append_v2":
+				var arg files.UploadSessionAppendArg
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+				if arg.Cursor.SessionId == "s2" {
+					http.Error(w, `{"error_summary": "incorrect_offset/..", "error": {".tag": "incorrect_offset", "correct_offset": 0}}`, http.StatusConflict)
+					return
+				}
+				received[arg.Cursor.SessionId] = string(body)
+				_, _ = w.Write([]byte(`null`))
+			case "/files/upload_session/finish_batch_v2":
+				_ = json.Unmarshal(body, &finish)
+				_, _ = w.Write([]byte(`{"entries": [
+					{".tag": "success", "name": "a", "size": 1},
+					{".tag": "failure", "failure": {".tag": "path", "path": {".tag": "conflict", "conflict": {".tag": "file"}}}}
+				]}`))
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	u := files.NewBatchUploader(files.New(config))
+	u.MaxRetries = -1
+	res, err := u.Upload(context.Background(), []*files.BatchUploadFile{
+		{Commit: files.NewCommitInfo("/a"), Content: strings.NewReader("a"), Size: 1},
+		{Commit: files.NewCommitInfo("/b"), Content: strings.NewReader("b"), Size: 1},
+		{Commit: files.NewCommitInfo("/c"), Content: strings.NewReader(""), Size: 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received["s1"] != "a" || received["s3"] != "" || len(finish.Entries) != 2 || finish.Entries[1].Commit.Path != "/c" {
+		t.Errorf("Unexpected requests: %v %+v\n", received, finish.Entries)
+	}
+	if res[0].Metadata == nil || res[0].Metadata.Name != "a" || res[1].Err == nil {
+		t.Errorf("Unexpected outcomes: %+v %+v\n", res[0], res[1])
+	}
+	if f := res[2].Failure; f == nil || f.Tag != files.UploadSessionFinishErrorPath || f.Path.Tag != files.WriteErrorConflict {
+		t.Errorf("Unexpected outcome: %+v\n", res[2])
+	}
+}
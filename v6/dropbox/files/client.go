@@ -25,7 +25,6 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"log"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
@@ -539,9 +538,13 @@ type AlphaGetMetadataAPIError struct {
 	EndpointError *AlphaGetMetadataError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AlphaGetMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
-	log.Printf("WARNING: API `AlphaGetMetadata` is deprecated")
-	log.Printf("Use API `GetMetadata` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("AlphaGetMetadata", "GetMetadata")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -595,9 +598,13 @@ type AlphaUploadAPIError struct {
 	EndpointError *UploadError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AlphaUploadAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) AlphaUploadContext(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
-	log.Printf("WARNING: API `AlphaUpload` is deprecated")
-	log.Printf("Use API `Upload` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("AlphaUpload", "Upload")
 
 	req := dropbox.Request{
 		Host:         "content",
@@ -640,9 +647,13 @@ type CopyAPIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
-	log.Printf("WARNING: API `Copy` is deprecated")
-	log.Printf("Use API `CopyV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("Copy", "CopyV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -696,6 +707,11 @@ type CopyV2APIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyV2Context(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -738,9 +754,13 @@ type CopyBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
-	log.Printf("WARNING: API `CopyBatch` is deprecated")
-	log.Printf("Use API `CopyBatchV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("CopyBatch", "CopyBatchV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -783,6 +803,11 @@ type CopyBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyBatchV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyBatchV2Context(ctx context.Context, arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -825,9 +850,13 @@ type CopyBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
-	log.Printf("WARNING: API `CopyBatchCheck` is deprecated")
-	log.Printf("Use API `CopyBatchCheckV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("CopyBatchCheck", "CopyBatchCheckV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -870,6 +899,11 @@ type CopyBatchCheckV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyBatchCheckV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyBatchCheckV2Context(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -912,6 +946,11 @@ type CopyReferenceGetAPIError struct {
 	EndpointError *GetCopyReferenceError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyReferenceGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -954,6 +993,11 @@ type CopyReferenceSaveAPIError struct {
 	EndpointError *SaveCopyReferenceError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CopyReferenceSaveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -996,9 +1040,13 @@ type CreateFolderAPIError struct {
 	EndpointError *CreateFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateFolderContext(ctx context.Context, arg *CreateFolderArg) (res *FolderMetadata, err error) {
-	log.Printf("WARNING: API `CreateFolder` is deprecated")
-	log.Printf("Use API `CreateFolderV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("CreateFolder", "CreateFolderV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -1041,6 +1089,11 @@ type CreateFolderV2APIError struct {
 	EndpointError *CreateFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateFolderV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateFolderV2Context(ctx context.Context, arg *CreateFolderArg) (res *CreateFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1083,6 +1136,11 @@ type CreateFolderBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateFolderBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateFolderBatchContext(ctx context.Context, arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1125,6 +1183,11 @@ type CreateFolderBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateFolderBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateFolderBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1167,9 +1230,13 @@ type DeleteAPIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteContext(ctx context.Context, arg *DeleteArg) (res IsMetadata, err error) {
-	log.Printf("WARNING: API `Delete` is deprecated")
-	log.Printf("Use API `DeleteV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("Delete", "DeleteV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -1223,6 +1290,11 @@ type DeleteV2APIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteV2Context(ctx context.Context, arg *DeleteArg) (res *DeleteResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1265,6 +1337,11 @@ type DeleteBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1307,6 +1384,11 @@ type DeleteBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DeleteBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1349,6 +1431,11 @@ type DownloadAPIError struct {
 	EndpointError *DownloadError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DownloadAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DownloadContext(ctx context.Context, arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1391,6 +1478,11 @@ type DownloadZipAPIError struct {
 	EndpointError *DownloadZipError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DownloadZipAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DownloadZipContext(ctx context.Context, arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1433,6 +1525,11 @@ type ExportAPIError struct {
 	EndpointError *ExportError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ExportAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ExportContext(ctx context.Context, arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1475,6 +1572,11 @@ type GetFileLockBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetFileLockBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetFileLockBatchContext(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1517,6 +1619,11 @@ type GetMetadataAPIError struct {
 	EndpointError *GetMetadataError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetMetadataContext(ctx context.Context, arg *GetMetadataArg) (res IsMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1570,6 +1677,11 @@ type GetPreviewAPIError struct {
 	EndpointError *PreviewError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetPreviewAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetPreviewContext(ctx context.Context, arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1612,6 +1724,11 @@ type GetTemporaryLinkAPIError struct {
 	EndpointError *GetTemporaryLinkError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetTemporaryLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1654,6 +1771,11 @@ type GetTemporaryUploadLinkAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetTemporaryUploadLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetTemporaryUploadLinkContext(ctx context.Context, arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1696,6 +1818,11 @@ type GetThumbnailAPIError struct {
 	EndpointError *ThumbnailError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetThumbnailAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetThumbnailContext(ctx context.Context, arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1738,6 +1865,11 @@ type GetThumbnailV2APIError struct {
 	EndpointError *ThumbnailV2Error `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetThumbnailV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetThumbnailV2Context(ctx context.Context, arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1780,6 +1912,11 @@ type GetThumbnailBatchAPIError struct {
 	EndpointError *GetThumbnailBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetThumbnailBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetThumbnailBatchContext(ctx context.Context, arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -1822,6 +1959,11 @@ type ListFolderAPIError struct {
 	EndpointError *ListFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1864,6 +2006,11 @@ type ListFolderContinueAPIError struct {
 	EndpointError *ListFolderContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1906,6 +2053,11 @@ type ListFolderGetLatestCursorAPIError struct {
 	EndpointError *ListFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderGetLatestCursorAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1948,6 +2100,11 @@ type ListFolderLongpollAPIError struct {
 	EndpointError *ListFolderLongpollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderLongpollAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
 	req := dropbox.Request{
 		Host:         "notify",
@@ -1990,6 +2147,11 @@ type ListRevisionsAPIError struct {
 	EndpointError *ListRevisionsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListRevisionsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2032,6 +2194,11 @@ type LockFileBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LockFileBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LockFileBatchContext(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2074,9 +2241,13 @@ type MoveAPIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
-	log.Printf("WARNING: API `Move` is deprecated")
-	log.Printf("Use API `MoveV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("Move", "MoveV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2130,6 +2301,11 @@ type MoveV2APIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveV2Context(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2172,9 +2348,13 @@ type MoveBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
-	log.Printf("WARNING: API `MoveBatch` is deprecated")
-	log.Printf("Use API `MoveBatchV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("MoveBatch", "MoveBatchV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2217,6 +2397,11 @@ type MoveBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveBatchV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveBatchV2Context(ctx context.Context, arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2259,9 +2444,13 @@ type MoveBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
-	log.Printf("WARNING: API `MoveBatchCheck` is deprecated")
-	log.Printf("Use API `MoveBatchCheckV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("MoveBatchCheck", "MoveBatchCheckV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2304,6 +2493,11 @@ type MoveBatchCheckV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MoveBatchCheckV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MoveBatchCheckV2Context(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2346,6 +2540,11 @@ type PaperCreateAPIError struct {
 	EndpointError *PaperCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PaperCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PaperCreateContext(ctx context.Context, arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2388,6 +2587,11 @@ type PaperUpdateAPIError struct {
 	EndpointError *PaperUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PaperUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PaperUpdateContext(ctx context.Context, arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2430,6 +2634,11 @@ type PermanentlyDeleteAPIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2468,8 +2677,13 @@ type PropertiesAddAPIError struct {
 	EndpointError *file_properties.AddPropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg) (err error) {
-	log.Printf("WARNING: API `PropertiesAdd` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesAdd", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2508,8 +2722,13 @@ type PropertiesOverwriteAPIError struct {
 	EndpointError *file_properties.InvalidPropertyGroupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesOverwriteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg) (err error) {
-	log.Printf("WARNING: API `PropertiesOverwrite` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesOverwrite", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2548,8 +2767,13 @@ type PropertiesRemoveAPIError struct {
 	EndpointError *file_properties.RemovePropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg) (err error) {
-	log.Printf("WARNING: API `PropertiesRemove` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesRemove", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2588,8 +2812,13 @@ type PropertiesTemplateGetAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateGet` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateGet", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2632,8 +2861,13 @@ type PropertiesTemplateListAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateListContext(ctx context.Context) (res *file_properties.ListTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateList` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateList", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2676,8 +2910,13 @@ type PropertiesUpdateAPIError struct {
 	EndpointError *file_properties.UpdatePropertiesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg) (err error) {
-	log.Printf("WARNING: API `PropertiesUpdate` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesUpdate", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2716,6 +2955,11 @@ type RestoreAPIError struct {
 	EndpointError *RestoreError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RestoreAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RestoreContext(ctx context.Context, arg *RestoreArg) (res *FileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2758,6 +3002,11 @@ type SaveUrlAPIError struct {
 	EndpointError *SaveUrlError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SaveUrlAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SaveUrlContext(ctx context.Context, arg *SaveUrlArg) (res *SaveUrlResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2800,6 +3049,11 @@ type SaveUrlCheckJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SaveUrlCheckJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2842,9 +3096,13 @@ type SearchAPIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SearchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SearchContext(ctx context.Context, arg *SearchArg) (res *SearchResult, err error) {
-	log.Printf("WARNING: API `Search` is deprecated")
-	log.Printf("Use API `SearchV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("Search", "SearchV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -2887,6 +3145,11 @@ type SearchV2APIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SearchV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SearchV2Context(ctx context.Context, arg *SearchV2Arg) (res *SearchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2929,6 +3192,11 @@ type SearchContinueV2APIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SearchContinueV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SearchContinueV2Context(ctx context.Context, arg *SearchV2ContinueArg) (res *SearchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2971,6 +3239,11 @@ type TagsAddAPIError struct {
 	EndpointError *AddTagError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TagsAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TagsAddContext(ctx context.Context, arg *AddTagArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3009,6 +3282,11 @@ type TagsGetAPIError struct {
 	EndpointError *BaseTagError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TagsGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TagsGetContext(ctx context.Context, arg *GetTagsArg) (res *GetTagsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3051,6 +3329,11 @@ type TagsRemoveAPIError struct {
 	EndpointError *RemoveTagError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TagsRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TagsRemoveContext(ctx context.Context, arg *RemoveTagArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3089,6 +3372,11 @@ type UnlockFileBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UnlockFileBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UnlockFileBatchContext(ctx context.Context, arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3131,6 +3419,11 @@ type UploadAPIError struct {
 	EndpointError *UploadError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadContext(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -3173,9 +3466,13 @@ type UploadSessionAppendAPIError struct {
 	EndpointError *UploadSessionAppendError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionAppendAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader) (err error) {
-	log.Printf("WARNING: API `UploadSessionAppend` is deprecated")
-	log.Printf("Use API `UploadSessionAppendV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("UploadSessionAppend", "UploadSessionAppendV2")
 
 	req := dropbox.Request{
 		Host:         "content",
@@ -3214,6 +3511,11 @@ type UploadSessionAppendV2APIError struct {
 	EndpointError *UploadSessionAppendError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionAppendV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader) (err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -3252,6 +3554,11 @@ type UploadSessionFinishAPIError struct {
 	EndpointError *UploadSessionFinishError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionFinishAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -3294,9 +3601,13 @@ type UploadSessionFinishBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionFinishBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
-	log.Printf("WARNING: API `UploadSessionFinishBatch` is deprecated")
-	log.Printf("Use API `UploadSessionFinishBatchV2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("UploadSessionFinishBatch", "UploadSessionFinishBatchV2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3339,6 +3650,11 @@ type UploadSessionFinishBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionFinishBatchV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionFinishBatchV2Context(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3381,6 +3697,11 @@ type UploadSessionFinishBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionFinishBatchCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3423,6 +3744,11 @@ type UploadSessionStartAPIError struct {
 	EndpointError *UploadSessionStartError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionStartAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -3465,6 +3791,11 @@ type UploadSessionStartBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UploadSessionStartBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UploadSessionStartBatchContext(ctx context.Context, arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
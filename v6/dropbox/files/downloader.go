@@ -0,0 +1,233 @@
+package files
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+)
+
+// Errors returned by a Downloader
+var (
+	// ErrContentHashMismatch is returned when the downloaded content doesn't
+	// match the content hash of the file.
+	ErrContentHashMismatch = contenthash.ErrMismatch
+	// ErrContentChanged is returned when the file changed while it was
+	// being downloaded.
+	ErrContentChanged = errors.New("file changed during download")
+)
+
+// DownloadResponse is the response of a DownloadSource.
+type DownloadResponse struct {
+	// Size is the size of the whole file.
+	Size int64
+	// Rev is the revision of the file, if known.
+	Rev string
+	// ContentHash is the content hash of the file, if known.
+	ContentHash string
+	// Content is the requested range of the content.
+	Content io.ReadCloser
+}
+
+// DownloadSource requests the content of a file, passing headers, which
+// hold a Range header when resuming, to the download route.
+type DownloadSource func(ctx context.Context, headers map[string]string) (*DownloadResponse, error)
+
+// PermanentError may be returned by a DownloadSource to report an error
+// that isn't worth retrying, such as an error of the route. The Downloader
+// returns Err.
+type PermanentError struct {
+	Err error
+}
+
+func (e PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns e.Err.
+func (e PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Downloader downloads files, resuming the transfer from the last byte
+// received with a Range request if it fails, and verifies the content hash
+// of the downloaded content. A Downloader may be used for several
+// downloads, concurrently, once configured. A dropbox.ProgressFunc set on the
+// context is called with the progress of the whole download.
+//
+//	d := files.NewDownloader(dbx)
+//	res, report, err := d.DownloadFile(ctx, "/backup.tar", "backup.tar")
+type Downloader struct {
+	client Client
+
+	// MaxRetries is the number of times a download is resumed after
+	// consecutive failures that received no content. Defaults to 3; a
+	// negative value disables retries.
+	MaxRetries int
+	// RetryPolicy spaces the attempts to resume a download, whose number is
+	// set by MaxRetries rather than MaxAttempts. Defaults to exponential
+	// delays from 500ms with full jitter.
+	RetryPolicy *dropbox.RetryPolicy
+	// SkipVerify disables the verification of the content hash.
+	SkipVerify bool
+	// OnStats, if set, is called with live statistics.
+	OnStats TransferStatsFunc
+}
+
+// NewDownloader returns a Downloader with default settings.
+func NewDownloader(client Client) *Downloader {
+	return &Downloader{client: client}
+}
+
+func (d *Downloader) maxRetries() int {
+	switch {
+	case d.MaxRetries < 0:
+		return 0
+	case d.MaxRetries == 0:
+		return 3
+	}
+	return d.MaxRetries
+}
+
+// Download writes the file at path to w. Once the first response is
+// received, the download is pinned to its revision, so that resuming never
+// mixes the content of different revisions.
+func (d *Downloader) Download(ctx context.Context, path string, w io.WriterAt) (*FileMetadata, *TransferReport, error) {
+	var res *FileMetadata
+	report, err := d.DownloadFrom(ctx, w, func(ctx context.Context, headers map[string]string) (*DownloadResponse, error) {
+		arg := NewDownloadArg(path)
+		if res != nil {
+			arg.Path = "rev:" + res.Rev
+		}
+		arg.ExtraHeaders = headers
+		meta, content, err := d.client.DownloadContext(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = meta
+		}
+		return &DownloadResponse{Size: int64(meta.Size), Rev: meta.Rev, ContentHash: meta.ContentHash, Content: content}, nil
+	})
+	if err != nil {
+		return nil, report, err
+	}
+	return res, report, nil
+}
+
+// DownloadFile downloads the file at path to the local file name, which is
+// created or truncated.
+func (d *Downloader) DownloadFile(ctx context.Context, path string, name string) (*FileMetadata, *TransferReport, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, report, err := d.Download(ctx, path, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, report, err
+	}
+	return res, report, nil
+}
+
+// DownloadFrom writes the content returned by src to w, resuming it with a
+// Range request after transient failures. It is the building block of
+// Download, for other download routes.
+func (d *Downloader) DownloadFrom(ctx context.Context, w io.WriterAt, src DownloadSource) (*TransferReport, error) {
+	rec := newTransferRecorder(-1, d.OnStats)
+	ctx = rec.takeProgress(ctx)
+	rec.chunkStarted(0)
+	err := d.download(ctx, w, src, rec)
+	var perm PermanentError
+	if errors.As(err, &perm) {
+		err = perm.Err
+	}
+	return rec.finish(), err
+}
+
+func (d *Downloader) download(ctx context.Context, w io.WriterAt, src DownloadSource, rec *transferRecorder) error {
+	var first *DownloadResponse
+	var hasher hash.Hash
+	var offset int64
+	for failures := 0; ; {
+		var headers map[string]string
+		if offset > 0 {
+			headers = map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}
+		}
+		res, err := src(ctx, headers)
+		// resumable is set if the content was cut short, which a Range
+		// request picks up from offset.
+		resumable := false
+		if err == nil {
+			if first == nil {
+				first = res
+				rec.setTotal(res.Size)
+				if !d.SkipVerify && res.ContentHash != "" {
+					hasher = contenthash.New()
+				}
+			} else if res.Rev != first.Rev || res.Size != first.Size {
+				res.Content.Close()
+				return ErrContentChanged
+			}
+			var n int64
+			n, err = d.copyAt(w, offset, res.Content, hasher, rec)
+			res.Content.Close()
+			offset += n
+			if n > 0 {
+				failures = 0
+			}
+			if err == nil && offset < first.Size {
+				err = io.ErrUnexpectedEOF
+			}
+			_, permanent := err.(PermanentError)
+			resumable = err != nil && !permanent
+		}
+		if err == nil {
+			break
+		}
+		if failures >= d.maxRetries() || !resumable && !retryableChunkError(err) || ctx.Err() != nil ||
+			!waitRetry(ctx, d.RetryPolicy, failures+1, err) {
+			return err
+		}
+		failures++
+		rec.chunkRetried(0)
+	}
+
+	if hasher != nil && hex.EncodeToString(hasher.Sum(nil)) != first.ContentHash {
+		return ErrContentHashMismatch
+	}
+	return nil
+}
+
+// copyAt copies r to w from offset on, hashing the content with h if set.
+func (d *Downloader) copyAt(w io.WriterAt, offset int64, r io.Reader, h hash.Hash, rec *transferRecorder) (int64, error) {
+	buf := make([]byte, 32<<10)
+	var written int64
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, werr := w.WriteAt(buf[:n], offset+written); werr != nil {
+				return written, PermanentError{werr}
+			}
+			if h != nil {
+				h.Write(buf[:n])
+			}
+			written += int64(n)
+			rec.transferred(int64(n))
+		}
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestDownloaderResume(t *testing.T) {
+	const size = 5<<20 + 3
+	content := bytes.Repeat([]byte("0123456789abcdef"), size/16+1)[:size]
+	sum := contentHash(content)
+
+	var paths, ranges []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.DownloadArg
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+			paths = append(paths, arg.Path)
+			ranges = append(ranges, r.Header.Get("Range"))
+			w.Header().Set("Dropbox-API-Result", fmt.Sprintf(`{"name": "a", "rev": "0123456789a", "size": %d, "content_hash": "%s"}`, size, sum))
+			if len(paths) == 1 {
+				// Announce the whole content but send only part of it.
+				w.Header().Set("Content-Length", fmt.Sprint(size))
+				_, _ = w.Write(content[:1<<20])
+				return
+			}
+			var from int
+			_, _ = fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &from)
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(content[from:])
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	name := filepath.Join(t.TempDir(), "a")
+	res, report, err := files.NewDownloader(files.New(config)).DownloadFile(context.Background(), "/a", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Name != "a" || report.Bytes != size || report.Retries() != 1 {
+		t.Errorf("Unexpected result: %v %+v\n", res.Name, report)
+	}
+	if strings.Join(paths, ",") != "/a,rev:0123456789a" || strings.Join(ranges, ",") != fmt.Sprintf(",bytes=%d-", 1<<20) {
+		t.Errorf("Unexpected requests: %v %v\n", paths, ranges)
+	}
+	if got, _ := os.ReadFile(name); !bytes.Equal(got, content) {
+		t.Error("Downloaded content differs")
+	}
+}
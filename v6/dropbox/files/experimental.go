@@ -0,0 +1,16 @@
+package files
+
+import (
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+)
+
+// CheckExperimental returns an experimental.DisabledError unless f is
+// enabled for client, for the experimental subsystems built on files.
+// Clients not created by New, such as mocks, are always allowed.
+func CheckExperimental(client Client, f experimental.Feature) error {
+	if impl, ok := client.(*apiImpl); ok {
+		return (*dropbox.Context)(impl).CheckExperimental(f)
+	}
+	return nil
+}
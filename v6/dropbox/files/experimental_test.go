@@ -0,0 +1,44 @@
+package files_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestExperimental(t *testing.T) {
+	var routes []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			routes = append(routes, r.URL.Path)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	cache := files.NewMetadataCache(files.New(config))
+	err := cache.Watch(context.Background(), "")
+	if err != (experimental.DisabledError{Feature: experimental.Watcher}) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if len(routes) != 0 {
+		t.Errorf("Unexpected routes: %v\n", routes)
+	}
+
+	config.Experimental = []experimental.Feature{experimental.Watcher}
+	cache = files.NewMetadataCache(files.New(config))
+	if _, ok := cache.Watch(context.Background(), "").(experimental.DisabledError); ok {
+		t.Error("Watcher was not enabled")
+	}
+	if len(routes) == 0 {
+		t.Error("Watch made no request")
+	}
+}
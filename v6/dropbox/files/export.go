@@ -0,0 +1,51 @@
+package files
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+)
+
+// ExportFormats returns the formats that the file f can be exported to with
+// Export, its default format first, or nil if it can't be exported.
+func ExportFormats(f *FileMetadata) []string {
+	if f.ExportInfo == nil {
+		return nil
+	}
+	var formats []string
+	if f.ExportInfo.ExportAs != "" {
+		formats = append(formats, f.ExportInfo.ExportAs)
+	}
+	for _, format := range f.ExportInfo.ExportOptions {
+		if format != f.ExportInfo.ExportAs {
+			formats = append(formats, format)
+		}
+	}
+	return formats
+}
+
+// Export writes the export of the file at path, such as a Paper doc or a
+// cloud document, to w in format, one of its ExportFormats, or its default
+// format if format is empty. The exported content is checked against the
+// export hash of the returned metadata, and contenthash.ErrMismatch returned if it
+// doesn't match.
+func Export(ctx context.Context, client Client, path string, format string, w io.Writer) (*ExportResult, error) {
+	arg := NewExportArg(path)
+	arg.ExportFormat = format
+	res, content, err := client.ExportContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	h := contenthash.New()
+	if _, err = io.Copy(io.MultiWriter(w, h), content); err != nil {
+		return nil, err
+	}
+	if m := res.ExportMetadata; m != nil && m.ExportHash != "" && m.ExportHash != hex.EncodeToString(h.Sum(nil)) {
+		return res, contenthash.ErrMismatch
+	}
+	return res, nil
+}
@@ -0,0 +1,55 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestExport(t *testing.T) {
+	content := []byte("# Notes\n")
+	sum := contentHash(content)
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.ExportArg
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+			if arg.ExportFormat == "html" {
+				sum = "bad"
+			}
+			w.Header().Set("Dropbox-API-Result", fmt.Sprintf(`{"export_metadata": {"name": "notes.md", "size": %d, "export_hash": %q},
+				"file_metadata": {"name": "notes.paper", "export_info": {"export_as": "md", "export_options": ["md", "html"]}}}`, len(content), sum))
+			_, _ = w.Write(content)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	var buf bytes.Buffer
+	res, err := files.Export(context.Background(), dbx, "/notes.paper", "", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(content) || res.ExportMetadata.Name != "notes.md" {
+		t.Errorf("Unexpected export: %q %+v\n", buf.String(), res.ExportMetadata)
+	}
+	if formats := files.ExportFormats(res.FileMetadata); strings.Join(formats, ",") != "md,html" {
+		t.Errorf("Unexpected formats: %v\n", formats)
+	}
+	if _, err = files.Export(context.Background(), dbx, "/notes.paper", "html", io.Discard); !errors.Is(err, contenthash.ErrMismatch) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
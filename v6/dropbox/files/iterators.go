@@ -0,0 +1,131 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// ListFolderIterator iterates over the pages returned by `ListFolder` and
+// `ListFolderContinue`.
+type ListFolderIterator struct {
+	client  Client
+	arg     *ListFolderArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListFolderIterator returns a new ListFolderIterator instance.
+// arg is required, as `ListFolder` has required arguments.
+func NewListFolderIterator(client Client, arg *ListFolderArg) *ListFolderIterator {
+	if arg == nil {
+		panic("files: NewListFolderIterator called with a nil arg")
+	}
+	return &ListFolderIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListFolderIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListFolderIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListFolderIterator) Next(ctx context.Context) (res *ListFolderResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListFolderContext(ctx, it.arg)
+	default:
+		arg := NewListFolderContinueArg(it.cursor)
+		res, err = it.client.ListFolderContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// SearchV2Iterator iterates over the pages returned by `SearchV2` and
+// `SearchContinueV2`.
+type SearchV2Iterator struct {
+	client  Client
+	arg     *SearchV2Arg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewSearchV2Iterator returns a new SearchV2Iterator instance.
+// arg is required, as `SearchV2` has required arguments.
+func NewSearchV2Iterator(client Client, arg *SearchV2Arg) *SearchV2Iterator {
+	if arg == nil {
+		panic("files: NewSearchV2Iterator called with a nil arg")
+	}
+	return &SearchV2Iterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *SearchV2Iterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *SearchV2Iterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *SearchV2Iterator) Next(ctx context.Context) (res *SearchV2Result, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.SearchV2Context(ctx, it.arg)
+	default:
+		arg := NewSearchV2ContinueArg(it.cursor)
+		res, err = it.client.SearchContinueV2Context(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
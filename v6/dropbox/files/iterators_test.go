@@ -0,0 +1,77 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestListFolderIterator(t *testing.T) {
+	pages := []string{
+		`{"entries": [{".tag": "file", "name": "a"}], "cursor": "c1", "has_more": true}`,
+		`{"entries": [{".tag": "folder", "name": "b"}], "cursor": "c2", "has_more": false}`,
+	}
+	var routes []string
+	var cursors []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			routes = append(routes, r.URL.Path)
+			var arg struct {
+				Cursor string `json:"cursor"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			cursors = append(cursors, arg.Cursor)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(pages[len(routes)-1]))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	it := files.NewListFolderIterator(client, files.NewListFolderArg(""))
+	var names []string
+	for it.HasMore() {
+		res, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range res.Entries {
+			switch e := e.(type) {
+			case *files.FileMetadata:
+				names = append(names, e.Name)
+			case *files.FolderMetadata:
+				names = append(names, e.Name)
+			}
+		}
+	}
+	if _, err := it.Next(context.Background()); err != dropbox.ErrNoMorePages {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if strings.Join(names, ",") != "a,b" {
+		t.Errorf("Unexpected entries: %v\n", names)
+	}
+	if strings.Join(routes, ",") != "/files/list_folder,/files/list_folder/continue" {
+		t.Errorf("Unexpected routes: %v\n", routes)
+	}
+	if cursors[1] != "c1" || it.Cursor() != "c2" {
+		t.Errorf("Unexpected cursors: %v %s\n", cursors, it.Cursor())
+	}
+}
+
+func TestListFolderIteratorNilArg(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Nil arg accepted")
+		}
+	}()
+	files.NewListFolderIterator(&files.Mock{}, nil)
+}
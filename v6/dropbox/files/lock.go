@@ -0,0 +1,160 @@
+package files
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// lockFileBatch is the number of paths sent with a single `lock_file_batch`
+// or `unlock_file_batch` request.
+const lockFileBatch = 1000
+
+// LockOptions controls LockFiles and UnlockFiles.
+type LockOptions struct {
+	// MaxRetries is the number of times paths failing with a transient
+	// error are retried, as well as, when locking, paths locked by someone
+	// else. Defaults to 3; a negative value disables retries.
+	MaxRetries int
+	// Backoff is the delay before the first retry, doubled before every
+	// other one. Defaults to one second.
+	Backoff time.Duration
+}
+
+func (o *LockOptions) maxRetries() int {
+	switch {
+	case o.MaxRetries < 0:
+		return 0
+	case o.MaxRetries == 0:
+		return 3
+	}
+	return o.MaxRetries
+}
+
+// LockOutcome is the result of locking or unlocking a single file.
+type LockOutcome struct {
+	Path string
+	// Metadata of the file, on success.
+	Metadata IsMetadata
+	// Lock is the state of the lock of the file after the operation, on
+	// success.
+	Lock *FileLock
+	// Failure tells why the file wasn't locked or unlocked.
+	Failure *LockFileError
+	// Holder is the lock that prevented the operation, if Failure is a lock
+	// conflict with a single user lock.
+	Holder *SingleUserLock
+}
+
+// LockFiles locks the files at paths with `lock_file_batch`, in batches
+// submitted one after the other. Paths locked by someone else are retried
+// with backoff, as they may be unlocked in the meantime. The outcomes are in
+// the order of paths; paths differing only in case share their outcome. If a
+// request fails, the outcomes so far are returned along with the error.
+func LockFiles(ctx context.Context, client Client, paths []string, opts *LockOptions) ([]*LockOutcome, error) {
+	return lockBatch(ctx, paths, opts, true, func(chunk []*LockOutcome) (*LockFileBatchResult, error) {
+		entries := make([]*LockFileArg, len(chunk))
+		for i, o := range chunk {
+			entries[i] = NewLockFileArg(o.Path)
+		}
+		return client.LockFileBatchContext(ctx, NewLockFileBatchArg(entries))
+	})
+}
+
+// UnlockFiles unlocks the files at paths with `unlock_file_batch`. See
+// LockFiles for how paths are batched and errors reported; lock conflicts
+// aren't retried.
+func UnlockFiles(ctx context.Context, client Client, paths []string, opts *LockOptions) ([]*LockOutcome, error) {
+	return lockBatch(ctx, paths, opts, false, func(chunk []*LockOutcome) (*LockFileBatchResult, error) {
+		entries := make([]*UnlockFileArg, len(chunk))
+		for i, o := range chunk {
+			entries[i] = NewUnlockFileArg(o.Path)
+		}
+		return client.UnlockFileBatchContext(ctx, NewUnlockFileBatchArg(entries))
+	})
+}
+
+func lockBatch(ctx context.Context, paths []string, opts *LockOptions, retryConflicts bool,
+	call func(chunk []*LockOutcome) (*LockFileBatchResult, error)) ([]*LockOutcome, error) {
+	if opts == nil {
+		opts = &LockOptions{}
+	}
+	backoff := opts.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	outcomes := make([]*LockOutcome, len(paths))
+	seen := make(map[string]*LockOutcome, len(paths))
+	var pending []*LockOutcome
+	for i, p := range paths {
+		key := strings.ToLower(p)
+		if o, ok := seen[key]; ok {
+			outcomes[i] = o
+			continue
+		}
+		o := &LockOutcome{Path: p}
+		seen[key], outcomes[i] = o, o
+		pending = append(pending, o)
+	}
+
+	for attempt := 0; ; attempt++ {
+		var retry []*LockOutcome
+		for start := 0; start < len(pending); start += lockFileBatch {
+			end := start + lockFileBatch
+			if end > len(pending) {
+				end = len(pending)
+			}
+			chunk := pending[start:end]
+			res, err := call(chunk)
+			if err != nil {
+				return outcomes, err
+			}
+			if len(res.Entries) != len(chunk) {
+				return outcomes, errUnexpectedBatchResult
+			}
+			for i, e := range res.Entries {
+				if setLockOutcome(chunk[i], e, retryConflicts) {
+					retry = append(retry, chunk[i])
+				}
+			}
+		}
+		if len(retry) == 0 || attempt >= opts.maxRetries() {
+			return outcomes, nil
+		}
+		select {
+		case <-ctx.Done():
+			return outcomes, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		pending = retry
+	}
+}
+
+// setLockOutcome records e in o and reports whether it is worth retrying.
+func setLockOutcome(o *LockOutcome, e *LockFileResultEntry, retryConflicts bool) bool {
+	o.Metadata, o.Lock, o.Failure, o.Holder = nil, nil, nil, nil
+	switch e.Tag {
+	case LockFileResultEntrySuccess:
+		o.Metadata, o.Lock = e.Success.Metadata, e.Success.Lock
+		return false
+	case LockFileResultEntryFailure:
+		o.Failure = e.Failure
+	default:
+		o.Failure = &LockFileError{}
+		o.Failure.Tag = LockFileErrorOther
+		return false
+	}
+
+	switch o.Failure.Tag {
+	case LockFileErrorLockConflict:
+		if c := o.Failure.LockConflict; c != nil && c.Lock != nil && c.Lock.Content != nil {
+			o.Holder = c.Lock.Content.SingleUser
+		}
+		return retryConflicts
+	case LockFileErrorTooManyWriteOperations, LockFileErrorInternalError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestLockFiles(t *testing.T) {
+	var batches [][]string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.LockFileBatchArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			var paths, entries []string
+			for _, e := range arg.Entries {
+				paths = append(paths, e.Path)
+				switch {
+				case e.Path == "/b" && len(batches) == 0:
+					entries = append(entries, `{".tag": "failure", "failure": {".tag": "lock_conflict", "lock": {"content": {
+						".tag": "single_user", "created": "2020-01-01T00:00:00Z", "lock_holder_account_id": "dbid:other"}}}}`)
+				case e.Path == "/c":
+					entries = append(entries, `{".tag": "failure", "failure": {".tag": "no_write_permission"}}`)
+				default:
+					entries = append(entries, fmt.Sprintf(`{".tag": "success", "metadata": {".tag": "file", "name": "x", "path_lower": %q},
+						"lock": {"content": {".tag": "single_user", "created": "2020-01-01T00:00:00Z", "lock_holder_account_id": "dbid:me"}}}`, e.Path))
+				}
+			}
+			batches = append(batches, paths)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"entries": [%s]}`, strings.Join(entries, ","))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	res, err := files.LockFiles(context.Background(), files.New(config), []string{"/a", "/b", "/c", "/A"},
+		&files.LockOptions{Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 2 || len(batches[0]) != 3 || strings.Join(batches[1], ",") != "/b" {
+		t.Errorf("Unexpected batches: %v\n", batches)
+	}
+	if res[0] != res[3] || res[0].Failure != nil || res[0].Lock.Content.SingleUser.LockHolderAccountId != "dbid:me" {
+		t.Errorf("Unexpected outcome of /a: %+v\n", res[0])
+	}
+	if res[1].Failure != nil || res[1].Holder != nil {
+		t.Errorf("Unexpected outcome of /b: %+v\n", res[1])
+	}
+	if res[2].Failure == nil || res[2].Failure.Tag != files.LockFileErrorNoWritePermission {
+		t.Errorf("Unexpected outcome of /c: %+v\n", res[2])
+	}
+
+	batches = nil
+	res, err = files.LockFiles(context.Background(), files.New(config), []string{"/b"}, &files.LockOptions{MaxRetries: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 || res[0].Holder == nil || res[0].Holder.LockHolderAccountId != "dbid:other" {
+		t.Errorf("Unexpected conflict: %v %+v\n", batches, res[0])
+	}
+}
@@ -0,0 +1,115 @@
+package files
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// MetadataCache caches the metadata returned by `get_metadata`, for
+// read-heavy applications. Entries are kept until they are invalidated,
+// either explicitly or, while Watch runs, as soon as Dropbox reports a change
+// to their path. A MetadataCache is safe for concurrent use.
+type MetadataCache struct {
+	client  Client
+	mu      sync.RWMutex
+	entries map[string]IsMetadata
+	// gen is incremented by every invalidation, so that metadata fetched
+	// before an invalidation isn't stored after it.
+	gen uint64
+}
+
+// NewMetadataCache returns an empty cache using client to fetch metadata.
+func NewMetadataCache(client Client) *MetadataCache {
+	return &MetadataCache{client: client, entries: make(map[string]IsMetadata)}
+}
+
+// GetMetadata returns the metadata of the file or folder at path, from the
+// cache if present. Only lookups by path are cached; IDs and revisions are
+// always looked up.
+func (c *MetadataCache) GetMetadata(ctx context.Context, path string) (IsMetadata, error) {
+	key := strings.ToLower(path)
+	cacheable := strings.HasPrefix(path, "/")
+	var gen uint64
+	if cacheable {
+		c.mu.RLock()
+		m, ok := c.entries[key]
+		gen = c.gen
+		c.mu.RUnlock()
+		if ok {
+			return m, nil
+		}
+	}
+
+	m, err := c.client.GetMetadataContext(ctx, NewGetMetadataArg(path))
+	if err != nil || !cacheable {
+		return m, err
+	}
+	c.mu.Lock()
+	if c.gen == gen {
+		c.entries[key] = m
+	}
+	c.mu.Unlock()
+	return m, nil
+}
+
+// Invalidate removes the entry for path, and those of anything under it,
+// from the cache.
+func (c *MetadataCache) Invalidate(path string) {
+	key := strings.ToLower(path)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	delete(c.entries, key)
+	for k := range c.entries {
+		if strings.HasPrefix(k, key+"/") {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Purge removes all entries from the cache.
+func (c *MetadataCache) Purge() {
+	c.mu.Lock()
+	c.gen++
+	c.entries = make(map[string]IsMetadata)
+	c.mu.Unlock()
+}
+
+// Watch long-polls for changes under root, "" for the whole account, and
+// invalidates the entries of changed paths. It blocks until ctx is done or
+// an error occurs, and purges the cache before returning since changes may
+// then go unnoticed.
+//
+// Watch is experimental and requires experimental.Watcher to be enabled.
+func (c *MetadataCache) Watch(ctx context.Context, root string) error {
+	defer c.Purge()
+	w := NewWatcher(c.client, root)
+	w.listExisting = false
+	w.onReset = c.Purge
+	events, err := w.Start(ctx)
+	if err != nil {
+		return err
+	}
+	for e := range events {
+		if path := metadataPathLower(e.Metadata); path != "" {
+			c.Invalidate(path)
+		}
+	}
+	return w.Err()
+}
+
+// metadataPathLower returns the lower-cased path of m.
+func metadataPathLower(m IsMetadata) string {
+	switch m := m.(type) {
+	case *FileMetadata:
+		return m.PathLower
+	case *FolderMetadata:
+		return m.PathLower
+	case *DeletedMetadata:
+		return m.PathLower
+	case *UnknownMetadata:
+		return m.PathLower
+	}
+	return ""
+}
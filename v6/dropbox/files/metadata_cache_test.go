@@ -0,0 +1,69 @@
+package files_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestMetadataCacheInvalidationRace(t *testing.T) {
+	var mu sync.Mutex
+	lookups := 0
+	rev := "r1"
+	fetching := make(chan struct{})
+	release := make(chan struct{})
+	client := &files.Mock{
+		GetMetadataFunc: func(ctx context.Context, arg *files.GetMetadataArg) (files.IsMetadata, error) {
+			mu.Lock()
+			lookups++
+			m := &files.FileMetadata{Rev: rev}
+			n := lookups
+			mu.Unlock()
+			if n == 1 {
+				// The first lookup reads r1, then the file changes before
+				// the lookup returns.
+				close(fetching)
+				<-release
+			}
+			return m, nil
+		},
+	}
+	for _, invalidate := range []func(c *files.MetadataCache){
+		func(c *files.MetadataCache) { c.Invalidate("/A.txt") },
+		func(c *files.MetadataCache) { c.Invalidate("") },
+		func(c *files.MetadataCache) { c.Purge() },
+	} {
+		mu.Lock()
+		lookups, rev = 0, "r1"
+		mu.Unlock()
+		fetching, release = make(chan struct{}), make(chan struct{})
+		cache := files.NewMetadataCache(client)
+
+		stale := make(chan files.IsMetadata)
+		go func() {
+			m, _ := cache.GetMetadata(context.Background(), "/a.txt")
+			stale <- m
+		}()
+		<-fetching
+		mu.Lock()
+		rev = "r2"
+		mu.Unlock()
+		invalidate(cache)
+		close(release)
+		if m := (<-stale).(*files.FileMetadata); m.Rev != "r1" {
+			t.Errorf("Unexpected metadata: %+v\n", m)
+		}
+
+		// The metadata fetched before the invalidation isn't cached.
+		m, err := cache.GetMetadata(context.Background(), "/a.txt")
+		if err != nil || m.(*files.FileMetadata).Rev != "r2" || lookups != 2 {
+			t.Errorf("Unexpected metadata: %+v %v %d\n", m, err, lookups)
+		}
+		m, err = cache.GetMetadata(context.Background(), "/A.TXT")
+		if err != nil || m.(*files.FileMetadata).Rev != "r2" || lookups != 2 {
+			t.Errorf("Unexpected metadata: %+v %v %d\n", m, err, lookups)
+		}
+	}
+}
@@ -0,0 +1,935 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	AlphaGetMetadataFunc              func(ctx context.Context, arg *AlphaGetMetadataArg) (res IsMetadata, err error)
+	AlphaUploadFunc                   func(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error)
+	CopyFunc                          func(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error)
+	CopyV2Func                        func(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error)
+	CopyBatchFunc                     func(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	CopyBatchV2Func                   func(ctx context.Context, arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error)
+	CopyBatchCheckFunc                func(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	CopyBatchCheckV2Func              func(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error)
+	CopyReferenceGetFunc              func(ctx context.Context, arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error)
+	CopyReferenceSaveFunc             func(ctx context.Context, arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error)
+	CreateFolderFunc                  func(ctx context.Context, arg *CreateFolderArg) (res *FolderMetadata, err error)
+	CreateFolderV2Func                func(ctx context.Context, arg *CreateFolderArg) (res *CreateFolderResult, err error)
+	CreateFolderBatchFunc             func(ctx context.Context, arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error)
+	CreateFolderBatchCheckFunc        func(ctx context.Context, arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error)
+	DeleteFunc                        func(ctx context.Context, arg *DeleteArg) (res IsMetadata, err error)
+	DeleteV2Func                      func(ctx context.Context, arg *DeleteArg) (res *DeleteResult, err error)
+	DeleteBatchFunc                   func(ctx context.Context, arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error)
+	DeleteBatchCheckFunc              func(ctx context.Context, arg *async.PollArg) (res *DeleteBatchJobStatus, err error)
+	DownloadFunc                      func(ctx context.Context, arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error)
+	DownloadZipFunc                   func(ctx context.Context, arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error)
+	ExportFunc                        func(ctx context.Context, arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error)
+	GetFileLockBatchFunc              func(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error)
+	GetMetadataFunc                   func(ctx context.Context, arg *GetMetadataArg) (res IsMetadata, err error)
+	GetPreviewFunc                    func(ctx context.Context, arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error)
+	GetTemporaryLinkFunc              func(ctx context.Context, arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error)
+	GetTemporaryUploadLinkFunc        func(ctx context.Context, arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error)
+	GetThumbnailFunc                  func(ctx context.Context, arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error)
+	GetThumbnailV2Func                func(ctx context.Context, arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error)
+	GetThumbnailBatchFunc             func(ctx context.Context, arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error)
+	ListFolderFunc                    func(ctx context.Context, arg *ListFolderArg) (res *ListFolderResult, err error)
+	ListFolderContinueFunc            func(ctx context.Context, arg *ListFolderContinueArg) (res *ListFolderResult, err error)
+	ListFolderGetLatestCursorFunc     func(ctx context.Context, arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error)
+	ListFolderLongpollFunc            func(ctx context.Context, arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error)
+	ListRevisionsFunc                 func(ctx context.Context, arg *ListRevisionsArg) (res *ListRevisionsResult, err error)
+	LockFileBatchFunc                 func(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error)
+	MoveFunc                          func(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error)
+	MoveV2Func                        func(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error)
+	MoveBatchFunc                     func(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	MoveBatchV2Func                   func(ctx context.Context, arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error)
+	MoveBatchCheckFunc                func(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	MoveBatchCheckV2Func              func(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error)
+	PaperCreateFunc                   func(ctx context.Context, arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error)
+	PaperUpdateFunc                   func(ctx context.Context, arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error)
+	PermanentlyDeleteFunc             func(ctx context.Context, arg *DeleteArg) (err error)
+	PropertiesAddFunc                 func(ctx context.Context, arg *file_properties.AddPropertiesArg) (err error)
+	PropertiesOverwriteFunc           func(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg) (err error)
+	PropertiesRemoveFunc              func(ctx context.Context, arg *file_properties.RemovePropertiesArg) (err error)
+	PropertiesTemplateGetFunc         func(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateListFunc        func(ctx context.Context) (res *file_properties.ListTemplateResult, err error)
+	PropertiesUpdateFunc              func(ctx context.Context, arg *file_properties.UpdatePropertiesArg) (err error)
+	RestoreFunc                       func(ctx context.Context, arg *RestoreArg) (res *FileMetadata, err error)
+	SaveUrlFunc                       func(ctx context.Context, arg *SaveUrlArg) (res *SaveUrlResult, err error)
+	SaveUrlCheckJobStatusFunc         func(ctx context.Context, arg *async.PollArg) (res *SaveUrlJobStatus, err error)
+	SearchFunc                        func(ctx context.Context, arg *SearchArg) (res *SearchResult, err error)
+	SearchV2Func                      func(ctx context.Context, arg *SearchV2Arg) (res *SearchV2Result, err error)
+	SearchContinueV2Func              func(ctx context.Context, arg *SearchV2ContinueArg) (res *SearchV2Result, err error)
+	TagsAddFunc                       func(ctx context.Context, arg *AddTagArg) (err error)
+	TagsGetFunc                       func(ctx context.Context, arg *GetTagsArg) (res *GetTagsResult, err error)
+	TagsRemoveFunc                    func(ctx context.Context, arg *RemoveTagArg) (err error)
+	UnlockFileBatchFunc               func(ctx context.Context, arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error)
+	UploadFunc                        func(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error)
+	UploadSessionAppendFunc           func(ctx context.Context, arg *UploadSessionCursor, content io.Reader) (err error)
+	UploadSessionAppendV2Func         func(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader) (err error)
+	UploadSessionFinishFunc           func(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error)
+	UploadSessionFinishBatchFunc      func(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error)
+	UploadSessionFinishBatchV2Func    func(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error)
+	UploadSessionFinishBatchCheckFunc func(ctx context.Context, arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error)
+	UploadSessionStartFunc            func(ctx context.Context, arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error)
+	UploadSessionStartBatchFunc       func(ctx context.Context, arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
+	if m.AlphaGetMetadataFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.AlphaGetMetadataFunc(ctx, arg)
+}
+
+func (m *Mock) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
+	return m.AlphaGetMetadataContext(context.Background(), arg)
+}
+
+func (m *Mock) AlphaUploadContext(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+	if m.AlphaUploadFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.AlphaUploadFunc(ctx, arg, content)
+}
+
+func (m *Mock) AlphaUpload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+	return m.AlphaUploadContext(context.Background(), arg, content)
+}
+
+func (m *Mock) CopyContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
+	if m.CopyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyFunc(ctx, arg)
+}
+
+func (m *Mock) Copy(arg *RelocationArg) (res IsMetadata, err error) {
+	return m.CopyContext(context.Background(), arg)
+}
+
+func (m *Mock) CopyV2Context(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error) {
+	if m.CopyV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyV2Func(ctx, arg)
+}
+
+func (m *Mock) CopyV2(arg *RelocationArg) (res *RelocationResult, err error) {
+	return m.CopyV2Context(context.Background(), arg)
+}
+
+func (m *Mock) CopyBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	if m.CopyBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyBatchFunc(ctx, arg)
+}
+
+func (m *Mock) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	return m.CopyBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) CopyBatchV2Context(ctx context.Context, arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error) {
+	if m.CopyBatchV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyBatchV2Func(ctx, arg)
+}
+
+func (m *Mock) CopyBatchV2(arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error) {
+	return m.CopyBatchV2Context(context.Background(), arg)
+}
+
+func (m *Mock) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	if m.CopyBatchCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyBatchCheckFunc(ctx, arg)
+}
+
+func (m *Mock) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	return m.CopyBatchCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) CopyBatchCheckV2Context(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+	if m.CopyBatchCheckV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyBatchCheckV2Func(ctx, arg)
+}
+
+func (m *Mock) CopyBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+	return m.CopyBatchCheckV2Context(context.Background(), arg)
+}
+
+func (m *Mock) CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
+	if m.CopyReferenceGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyReferenceGetFunc(ctx, arg)
+}
+
+func (m *Mock) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
+	return m.CopyReferenceGetContext(context.Background(), arg)
+}
+
+func (m *Mock) CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
+	if m.CopyReferenceSaveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CopyReferenceSaveFunc(ctx, arg)
+}
+
+func (m *Mock) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
+	return m.CopyReferenceSaveContext(context.Background(), arg)
+}
+
+func (m *Mock) CreateFolderContext(ctx context.Context, arg *CreateFolderArg) (res *FolderMetadata, err error) {
+	if m.CreateFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateFolderFunc(ctx, arg)
+}
+
+func (m *Mock) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error) {
+	return m.CreateFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) CreateFolderV2Context(ctx context.Context, arg *CreateFolderArg) (res *CreateFolderResult, err error) {
+	if m.CreateFolderV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateFolderV2Func(ctx, arg)
+}
+
+func (m *Mock) CreateFolderV2(arg *CreateFolderArg) (res *CreateFolderResult, err error) {
+	return m.CreateFolderV2Context(context.Background(), arg)
+}
+
+func (m *Mock) CreateFolderBatchContext(ctx context.Context, arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error) {
+	if m.CreateFolderBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateFolderBatchFunc(ctx, arg)
+}
+
+func (m *Mock) CreateFolderBatch(arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error) {
+	return m.CreateFolderBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) CreateFolderBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error) {
+	if m.CreateFolderBatchCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateFolderBatchCheckFunc(ctx, arg)
+}
+
+func (m *Mock) CreateFolderBatchCheck(arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error) {
+	return m.CreateFolderBatchCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) DeleteContext(ctx context.Context, arg *DeleteArg) (res IsMetadata, err error) {
+	if m.DeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteFunc(ctx, arg)
+}
+
+func (m *Mock) Delete(arg *DeleteArg) (res IsMetadata, err error) {
+	return m.DeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) DeleteV2Context(ctx context.Context, arg *DeleteArg) (res *DeleteResult, err error) {
+	if m.DeleteV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteV2Func(ctx, arg)
+}
+
+func (m *Mock) DeleteV2(arg *DeleteArg) (res *DeleteResult, err error) {
+	return m.DeleteV2Context(context.Background(), arg)
+}
+
+func (m *Mock) DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
+	if m.DeleteBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteBatchFunc(ctx, arg)
+}
+
+func (m *Mock) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
+	return m.DeleteBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
+	if m.DeleteBatchCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DeleteBatchCheckFunc(ctx, arg)
+}
+
+func (m *Mock) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
+	return m.DeleteBatchCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) DownloadContext(ctx context.Context, arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if m.DownloadFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DownloadFunc(ctx, arg)
+}
+
+func (m *Mock) Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return m.DownloadContext(context.Background(), arg)
+}
+
+func (m *Mock) DownloadZipContext(ctx context.Context, arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error) {
+	if m.DownloadZipFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DownloadZipFunc(ctx, arg)
+}
+
+func (m *Mock) DownloadZip(arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error) {
+	return m.DownloadZipContext(context.Background(), arg)
+}
+
+func (m *Mock) ExportContext(ctx context.Context, arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error) {
+	if m.ExportFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ExportFunc(ctx, arg)
+}
+
+func (m *Mock) Export(arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error) {
+	return m.ExportContext(context.Background(), arg)
+}
+
+func (m *Mock) GetFileLockBatchContext(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+	if m.GetFileLockBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetFileLockBatchFunc(ctx, arg)
+}
+
+func (m *Mock) GetFileLockBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+	return m.GetFileLockBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) GetMetadataContext(ctx context.Context, arg *GetMetadataArg) (res IsMetadata, err error) {
+	if m.GetMetadataFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetMetadataFunc(ctx, arg)
+}
+
+func (m *Mock) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error) {
+	return m.GetMetadataContext(context.Background(), arg)
+}
+
+func (m *Mock) GetPreviewContext(ctx context.Context, arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if m.GetPreviewFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetPreviewFunc(ctx, arg)
+}
+
+func (m *Mock) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return m.GetPreviewContext(context.Background(), arg)
+}
+
+func (m *Mock) GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
+	if m.GetTemporaryLinkFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetTemporaryLinkFunc(ctx, arg)
+}
+
+func (m *Mock) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
+	return m.GetTemporaryLinkContext(context.Background(), arg)
+}
+
+func (m *Mock) GetTemporaryUploadLinkContext(ctx context.Context, arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error) {
+	if m.GetTemporaryUploadLinkFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetTemporaryUploadLinkFunc(ctx, arg)
+}
+
+func (m *Mock) GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error) {
+	return m.GetTemporaryUploadLinkContext(context.Background(), arg)
+}
+
+func (m *Mock) GetThumbnailContext(ctx context.Context, arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	if m.GetThumbnailFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetThumbnailFunc(ctx, arg)
+}
+
+func (m *Mock) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
+	return m.GetThumbnailContext(context.Background(), arg)
+}
+
+func (m *Mock) GetThumbnailV2Context(ctx context.Context, arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error) {
+	if m.GetThumbnailV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetThumbnailV2Func(ctx, arg)
+}
+
+func (m *Mock) GetThumbnailV2(arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error) {
+	return m.GetThumbnailV2Context(context.Background(), arg)
+}
+
+func (m *Mock) GetThumbnailBatchContext(ctx context.Context, arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error) {
+	if m.GetThumbnailBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetThumbnailBatchFunc(ctx, arg)
+}
+
+func (m *Mock) GetThumbnailBatch(arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error) {
+	return m.GetThumbnailBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderResult, err error) {
+	if m.ListFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error) {
+	return m.ListFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
+	if m.ListFolderContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
+	return m.ListFolderContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
+	if m.ListFolderGetLatestCursorFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderGetLatestCursorFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
+	return m.ListFolderGetLatestCursorContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
+	if m.ListFolderLongpollFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderLongpollFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
+	return m.ListFolderLongpollContext(context.Background(), arg)
+}
+
+func (m *Mock) ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
+	if m.ListRevisionsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListRevisionsFunc(ctx, arg)
+}
+
+func (m *Mock) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
+	return m.ListRevisionsContext(context.Background(), arg)
+}
+
+func (m *Mock) LockFileBatchContext(ctx context.Context, arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+	if m.LockFileBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LockFileBatchFunc(ctx, arg)
+}
+
+func (m *Mock) LockFileBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+	return m.LockFileBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) MoveContext(ctx context.Context, arg *RelocationArg) (res IsMetadata, err error) {
+	if m.MoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveFunc(ctx, arg)
+}
+
+func (m *Mock) Move(arg *RelocationArg) (res IsMetadata, err error) {
+	return m.MoveContext(context.Background(), arg)
+}
+
+func (m *Mock) MoveV2Context(ctx context.Context, arg *RelocationArg) (res *RelocationResult, err error) {
+	if m.MoveV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveV2Func(ctx, arg)
+}
+
+func (m *Mock) MoveV2(arg *RelocationArg) (res *RelocationResult, err error) {
+	return m.MoveV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MoveBatchContext(ctx context.Context, arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	if m.MoveBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveBatchFunc(ctx, arg)
+}
+
+func (m *Mock) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+	return m.MoveBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) MoveBatchV2Context(ctx context.Context, arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error) {
+	if m.MoveBatchV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveBatchV2Func(ctx, arg)
+}
+
+func (m *Mock) MoveBatchV2(arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error) {
+	return m.MoveBatchV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	if m.MoveBatchCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveBatchCheckFunc(ctx, arg)
+}
+
+func (m *Mock) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+	return m.MoveBatchCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) MoveBatchCheckV2Context(ctx context.Context, arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+	if m.MoveBatchCheckV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MoveBatchCheckV2Func(ctx, arg)
+}
+
+func (m *Mock) MoveBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+	return m.MoveBatchCheckV2Context(context.Background(), arg)
+}
+
+func (m *Mock) PaperCreateContext(ctx context.Context, arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error) {
+	if m.PaperCreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PaperCreateFunc(ctx, arg, content)
+}
+
+func (m *Mock) PaperCreate(arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error) {
+	return m.PaperCreateContext(context.Background(), arg, content)
+}
+
+func (m *Mock) PaperUpdateContext(ctx context.Context, arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error) {
+	if m.PaperUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PaperUpdateFunc(ctx, arg, content)
+}
+
+func (m *Mock) PaperUpdate(arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error) {
+	return m.PaperUpdateContext(context.Background(), arg, content)
+}
+
+func (m *Mock) PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg) (err error) {
+	if m.PermanentlyDeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PermanentlyDeleteFunc(ctx, arg)
+}
+
+func (m *Mock) PermanentlyDelete(arg *DeleteArg) (err error) {
+	return m.PermanentlyDeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg) (err error) {
+	if m.PropertiesAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesAddFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesAdd(arg *file_properties.AddPropertiesArg) (err error) {
+	return m.PropertiesAddContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg) (err error) {
+	if m.PropertiesOverwriteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesOverwriteFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg) (err error) {
+	return m.PropertiesOverwriteContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg) (err error) {
+	if m.PropertiesRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesRemove(arg *file_properties.RemovePropertiesArg) (err error) {
+	return m.PropertiesRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+	if m.PropertiesTemplateGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateGetFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+	return m.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesTemplateListContext(ctx context.Context) (res *file_properties.ListTemplateResult, err error) {
+	if m.PropertiesTemplateListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateListFunc(ctx)
+}
+
+func (m *Mock) PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error) {
+	return m.PropertiesTemplateListContext(context.Background())
+}
+
+func (m *Mock) PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg) (err error) {
+	if m.PropertiesUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesUpdateFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg) (err error) {
+	return m.PropertiesUpdateContext(context.Background(), arg)
+}
+
+func (m *Mock) RestoreContext(ctx context.Context, arg *RestoreArg) (res *FileMetadata, err error) {
+	if m.RestoreFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RestoreFunc(ctx, arg)
+}
+
+func (m *Mock) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
+	return m.RestoreContext(context.Background(), arg)
+}
+
+func (m *Mock) SaveUrlContext(ctx context.Context, arg *SaveUrlArg) (res *SaveUrlResult, err error) {
+	if m.SaveUrlFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SaveUrlFunc(ctx, arg)
+}
+
+func (m *Mock) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
+	return m.SaveUrlContext(context.Background(), arg)
+}
+
+func (m *Mock) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
+	if m.SaveUrlCheckJobStatusFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SaveUrlCheckJobStatusFunc(ctx, arg)
+}
+
+func (m *Mock) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
+	return m.SaveUrlCheckJobStatusContext(context.Background(), arg)
+}
+
+func (m *Mock) SearchContext(ctx context.Context, arg *SearchArg) (res *SearchResult, err error) {
+	if m.SearchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SearchFunc(ctx, arg)
+}
+
+func (m *Mock) Search(arg *SearchArg) (res *SearchResult, err error) {
+	return m.SearchContext(context.Background(), arg)
+}
+
+func (m *Mock) SearchV2Context(ctx context.Context, arg *SearchV2Arg) (res *SearchV2Result, err error) {
+	if m.SearchV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SearchV2Func(ctx, arg)
+}
+
+func (m *Mock) SearchV2(arg *SearchV2Arg) (res *SearchV2Result, err error) {
+	return m.SearchV2Context(context.Background(), arg)
+}
+
+func (m *Mock) SearchContinueV2Context(ctx context.Context, arg *SearchV2ContinueArg) (res *SearchV2Result, err error) {
+	if m.SearchContinueV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SearchContinueV2Func(ctx, arg)
+}
+
+func (m *Mock) SearchContinueV2(arg *SearchV2ContinueArg) (res *SearchV2Result, err error) {
+	return m.SearchContinueV2Context(context.Background(), arg)
+}
+
+func (m *Mock) TagsAddContext(ctx context.Context, arg *AddTagArg) (err error) {
+	if m.TagsAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TagsAddFunc(ctx, arg)
+}
+
+func (m *Mock) TagsAdd(arg *AddTagArg) (err error) {
+	return m.TagsAddContext(context.Background(), arg)
+}
+
+func (m *Mock) TagsGetContext(ctx context.Context, arg *GetTagsArg) (res *GetTagsResult, err error) {
+	if m.TagsGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TagsGetFunc(ctx, arg)
+}
+
+func (m *Mock) TagsGet(arg *GetTagsArg) (res *GetTagsResult, err error) {
+	return m.TagsGetContext(context.Background(), arg)
+}
+
+func (m *Mock) TagsRemoveContext(ctx context.Context, arg *RemoveTagArg) (err error) {
+	if m.TagsRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TagsRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) TagsRemove(arg *RemoveTagArg) (err error) {
+	return m.TagsRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) UnlockFileBatchContext(ctx context.Context, arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error) {
+	if m.UnlockFileBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UnlockFileBatchFunc(ctx, arg)
+}
+
+func (m *Mock) UnlockFileBatch(arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error) {
+	return m.UnlockFileBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) UploadContext(ctx context.Context, arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+	if m.UploadFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadFunc(ctx, arg, content)
+}
+
+func (m *Mock) Upload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+	return m.UploadContext(context.Background(), arg, content)
+}
+
+func (m *Mock) UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader) (err error) {
+	if m.UploadSessionAppendFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionAppendFunc(ctx, arg, content)
+}
+
+func (m *Mock) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error) {
+	return m.UploadSessionAppendContext(context.Background(), arg, content)
+}
+
+func (m *Mock) UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader) (err error) {
+	if m.UploadSessionAppendV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionAppendV2Func(ctx, arg, content)
+}
+
+func (m *Mock) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error) {
+	return m.UploadSessionAppendV2Context(context.Background(), arg, content)
+}
+
+func (m *Mock) UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
+	if m.UploadSessionFinishFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionFinishFunc(ctx, arg, content)
+}
+
+func (m *Mock) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
+	return m.UploadSessionFinishContext(context.Background(), arg, content)
+}
+
+func (m *Mock) UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
+	if m.UploadSessionFinishBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionFinishBatchFunc(ctx, arg)
+}
+
+func (m *Mock) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
+	return m.UploadSessionFinishBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) UploadSessionFinishBatchV2Context(ctx context.Context, arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error) {
+	if m.UploadSessionFinishBatchV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionFinishBatchV2Func(ctx, arg)
+}
+
+func (m *Mock) UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error) {
+	return m.UploadSessionFinishBatchV2Context(context.Background(), arg)
+}
+
+func (m *Mock) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
+	if m.UploadSessionFinishBatchCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionFinishBatchCheckFunc(ctx, arg)
+}
+
+func (m *Mock) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
+	return m.UploadSessionFinishBatchCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
+	if m.UploadSessionStartFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionStartFunc(ctx, arg, content)
+}
+
+func (m *Mock) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
+	return m.UploadSessionStartContext(context.Background(), arg, content)
+}
+
+func (m *Mock) UploadSessionStartBatchContext(ctx context.Context, arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error) {
+	if m.UploadSessionStartBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UploadSessionStartBatchFunc(ctx, arg)
+}
+
+func (m *Mock) UploadSessionStartBatch(arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error) {
+	return m.UploadSessionStartBatchContext(context.Background(), arg)
+}
@@ -0,0 +1,31 @@
+package files
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// CreatePaperDoc creates a Paper doc at path, which must end with ".paper",
+// from content in format, one of the ImportFormat tags, e.g.
+// ImportFormatMarkdown. It replaces the deprecated `paper/docs/create` for
+// accounts storing Paper docs as files.
+func CreatePaperDoc(ctx context.Context, client Client, path string, format string, content io.Reader) (*PaperCreateResult, error) {
+	arg := NewPaperCreateArg(path, &ImportFormat{Tagged: dropbox.Tagged{Tag: format}})
+	return client.PaperCreateContext(ctx, arg, content)
+}
+
+// UpdatePaperDoc applies content in format to the Paper doc at path with
+// policy, one of the PaperDocUpdatePolicy tags. revision is the current
+// revision of the doc, as returned by CreatePaperDoc or a previous update,
+// and is only checked with PaperDocUpdatePolicyUpdate; a stale revision
+// fails with PaperUpdateErrorRevisionMismatch.
+func UpdatePaperDoc(ctx context.Context, client Client, path string, format string, policy string, revision int64, content io.Reader) (*PaperUpdateResult, error) {
+	arg := NewPaperUpdateArg(path, &ImportFormat{Tagged: dropbox.Tagged{Tag: format}},
+		&PaperDocUpdatePolicy{Tagged: dropbox.Tagged{Tag: policy}})
+	if policy == PaperDocUpdatePolicyUpdate {
+		arg.PaperRevision = revision
+	}
+	return client.PaperUpdateContext(ctx, arg, content)
+}
@@ -0,0 +1,56 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestPaperDocs(t *testing.T) {
+	var args []string
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			args = append(args, r.Header.Get("Dropbox-API-Arg"))
+			bodies = append(bodies, string(b))
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/paper/create":
+				_, _ = w.Write([]byte(`{"url": "https://paper.dropbox.com/doc/x", "result_path": "/Notes.paper", "file_id": "id:x", "paper_revision": 1}`))
+			case "/files/paper/update":
+				_, _ = w.Write([]byte(`{"paper_revision": 2}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	created, err := files.CreatePaperDoc(context.Background(), dbx, "/Notes.paper", files.ImportFormatMarkdown, strings.NewReader("# Notes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := files.UpdatePaperDoc(context.Background(), dbx, created.ResultPath, files.ImportFormatPlainText,
+		files.PaperDocUpdatePolicyUpdate, created.PaperRevision, strings.NewReader("more"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.PaperRevision != 2 || len(args) != 2 || bodies[0] != "# Notes" || bodies[1] != "more" {
+		t.Errorf("Unexpected requests: %v %v\n", args, bodies)
+	}
+	var arg files.PaperUpdateArg
+	_ = json.Unmarshal([]byte(args[1]), &arg)
+	if arg.DocUpdatePolicy.Tag != files.PaperDocUpdatePolicyUpdate || arg.PaperRevision != 1 || arg.ImportFormat.Tag != files.ImportFormatPlainText {
+		t.Errorf("Unexpected update: %s\n", args[1])
+	}
+}
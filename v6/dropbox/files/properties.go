@@ -0,0 +1,32 @@
+package files
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// GetProperties sets the fields of the struct v points to from the property
+// group of the template templateID of the file or folder at path, bound as
+// described in file_properties.MarshalGroup, and reports whether the file or
+// folder has such a group. Use file_properties.SetProperties to set them.
+func GetProperties(ctx context.Context, client Client, path string, templateID string, v interface{}) (bool, error) {
+	arg := NewGetMetadataArg(path)
+	arg.IncludePropertyGroups = &file_properties.TemplateFilterBase{
+		Tagged:     dropbox.Tagged{Tag: file_properties.TemplateFilterBaseFilterSome},
+		FilterSome: []string{templateID},
+	}
+	res, err := client.GetMetadataContext(ctx, arg)
+	if err != nil {
+		return false, err
+	}
+	var groups []*file_properties.PropertyGroup
+	switch m := res.(type) {
+	case *FileMetadata:
+		groups = m.PropertyGroups
+	case *FolderMetadata:
+		groups = m.PropertyGroups
+	}
+	return file_properties.GetProperties(groups, templateID, v)
+}
@@ -0,0 +1,97 @@
+package files
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+)
+
+// RevisionIterator iterates over the revisions of a file, newest first. As
+// `list_revisions` isn't paginated, only the latest 100 revisions are
+// returned.
+type RevisionIterator struct {
+	client    Client
+	path      string
+	started   bool
+	isDeleted bool
+	entries   []*FileMetadata
+}
+
+// Revisions returns a RevisionIterator over the revisions of the file at
+// path. path may also be an ID, in which case the revisions of the file
+// across moves and renames are returned.
+//
+//	it := files.Revisions(dbx, "/notes.txt")
+//	for it.HasMore() {
+//		rev, err := it.Next(ctx)
+//		...
+//	}
+func Revisions(client Client, path string) *RevisionIterator {
+	return &RevisionIterator{client: client, path: path}
+}
+
+// HasMore returns false once all revisions have been returned.
+func (it *RevisionIterator) HasMore() bool {
+	return !it.started || len(it.entries) > 0
+}
+
+// IsDeleted reports whether the file is currently deleted. It is only known
+// once Next has been called.
+func (it *RevisionIterator) IsDeleted() bool {
+	return it.isDeleted
+}
+
+// Next returns the next revision, calling `list_revisions` the first time.
+// It returns `dropbox.ErrNoMorePages` once all revisions have been returned.
+func (it *RevisionIterator) Next(ctx context.Context) (*FileMetadata, error) {
+	if !it.started {
+		arg := NewListRevisionsArg(it.path)
+		if strings.HasPrefix(it.path, "id:") {
+			arg.Mode = &ListRevisionsMode{Tagged: dropbox.Tagged{Tag: ListRevisionsModeId}}
+		}
+		arg.Limit = maxRevisions
+		res, err := it.client.ListRevisionsContext(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		it.started = true
+		it.isDeleted = res.IsDeleted
+		it.entries = res.Entries
+	}
+	if len(it.entries) == 0 {
+		return nil, dropbox.ErrNoMorePages
+	}
+	rev := it.entries[0]
+	it.entries = it.entries[1:]
+	return rev, nil
+}
+
+// RestoreToRev restores the file at path to its revision rev, which may
+// also undelete it, and returns the metadata of the restored file.
+func RestoreToRev(ctx context.Context, client Client, path string, rev string) (*FileMetadata, error) {
+	return client.RestoreContext(ctx, NewRestoreArg(path, rev))
+}
+
+// DownloadRev writes the content of the revision rev of a file to w, and
+// returns its metadata. The content is checked against the content hash of
+// the revision, and contenthash.ErrMismatch returned if it doesn't match.
+func DownloadRev(ctx context.Context, client Client, rev string, w io.Writer) (*FileMetadata, error) {
+	res, content, err := client.DownloadContext(ctx, NewDownloadArg("rev:"+rev))
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	h := contenthash.New()
+	if _, err = io.Copy(io.MultiWriter(w, h), content); err != nil {
+		return nil, err
+	}
+	if res.ContentHash != "" && res.ContentHash != hex.EncodeToString(h.Sum(nil)) {
+		return res, contenthash.ErrMismatch
+	}
+	return res, nil
+}
@@ -0,0 +1,68 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestRevisions(t *testing.T) {
+	old := []byte("old content")
+	var listArg files.ListRevisionsArg
+	var restoreArg files.RestoreArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/list_revisions":
+				_ = json.NewDecoder(r.Body).Decode(&listArg)
+				_, _ = w.Write([]byte(`{"is_deleted": true, "entries": [
+					{"name": "a.txt", "rev": "0000000000b"}, {"name": "a.txt", "rev": "0000000000a"}]}`))
+			case "/files/restore":
+				_ = json.NewDecoder(r.Body).Decode(&restoreArg)
+				_, _ = w.Write([]byte(`{"name": "a.txt", "rev": "0000000000c"}`))
+			case "/files/download":
+				w.Header().Set("Dropbox-API-Result", fmt.Sprintf(`{"name": "a.txt", "rev": "0000000000a", "content_hash": %q}`, contentHash(old)))
+				_, _ = w.Write(old)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	it := files.Revisions(dbx, "id:abc")
+	var revs []string
+	for it.HasMore() {
+		rev, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		revs = append(revs, rev.Rev)
+	}
+	if strings.Join(revs, ",") != "0000000000b,0000000000a" || !it.IsDeleted() || listArg.Mode.Tag != files.ListRevisionsModeId {
+		t.Errorf("Unexpected revisions: %v %v\n", revs, listArg.Mode)
+	}
+	if _, err := it.Next(context.Background()); err != dropbox.ErrNoMorePages {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := files.DownloadRev(context.Background(), dbx, "0000000000a", &buf); err != nil || buf.String() != string(old) {
+		t.Errorf("Unexpected download: %q %v\n", buf.String(), err)
+	}
+	res, err := files.RestoreToRev(context.Background(), dbx, "/a.txt", "0000000000a")
+	if err != nil || res.Rev != "0000000000c" || restoreArg.Rev != "0000000000a" {
+		t.Errorf("Unexpected restore: %+v %v\n", restoreArg, err)
+	}
+}
@@ -0,0 +1,182 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Operations of a RollbackAction
+const (
+	// RollbackRestore restores a file to an earlier revision.
+	RollbackRestore = "restore"
+	// RollbackDelete deletes a file created after the rollback time.
+	RollbackDelete = "delete"
+)
+
+// maxRevisions is the maximum number of revisions returned by
+// `list_revisions`.
+const maxRevisions = 100
+
+// errNoRevision is reported for files whose revision at the rollback time is
+// older than the revisions returned by `list_revisions`.
+var errNoRevision = errors.New("no revision found at the rollback time")
+
+// maxRollbackRetries is the number of times a request of RollbackFolder
+// failing with a transient error is retried.
+const maxRollbackRetries = 3
+
+// RollbackOptions controls how RollbackFolder rolls a folder back.
+type RollbackOptions struct {
+	// Preview only plans the actions, without executing them.
+	Preview bool
+	// Concurrency is the number of files whose revisions are looked up in
+	// parallel. Defaults to 4.
+	Concurrency int
+}
+
+// RollbackAction is a single change made, or planned, by RollbackFolder.
+type RollbackAction struct {
+	// Op is RollbackRestore or RollbackDelete.
+	Op   string
+	Path string
+	// Rev is the revision restored by a RollbackRestore.
+	Rev string
+	// Err is set if the action could not be planned or failed.
+	Err error
+}
+
+// RollbackFolder restores the files under path to their state at time to,
+// the usual recovery after accidental mass edits or deletions: files changed
+// or deleted since then are restored to the revision they had at that time,
+// and files created since then are deleted. Folders are left as they are.
+// With opts.Preview, the returned actions are only planned. Failures of
+// individual files are reported in the actions; the returned error is only
+// set if the folder could not be listed.
+//
+// The revisions of every file changed since then, and of every file ever
+// deleted under path, whose deletion time only `list_revisions` tells, are
+// looked up with up to opts.Concurrency requests in flight. Requests failing
+// with a rate limit or another transient error are retried.
+func RollbackFolder(ctx context.Context, client Client, path string, to time.Time, opts *RollbackOptions) ([]*RollbackAction, error) {
+	if opts == nil {
+		opts = &RollbackOptions{}
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+
+	arg := NewListFolderArg(path)
+	arg.Recursive = true
+	arg.IncludeDeleted = true
+	var candidates []string
+	it := NewListFolderIterator(client, arg)
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range res.Entries {
+			switch e := entry.(type) {
+			case *FileMetadata:
+				if e.ServerModified.After(to) {
+					candidates = append(candidates, e.PathDisplay)
+				}
+			case *DeletedMetadata:
+				candidates = append(candidates, e.PathDisplay)
+			}
+		}
+	}
+
+	planned := make([]*RollbackAction, len(candidates))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, p := range candidates {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, p string) {
+			defer func() { <-sem; wg.Done() }()
+			planned[i] = planRollback(ctx, client, p, to)
+		}(i, p)
+	}
+	wg.Wait()
+	var actions []*RollbackAction
+	for _, action := range planned {
+		if action != nil {
+			actions = append(actions, action)
+		}
+	}
+
+	if opts.Preview {
+		return actions, nil
+	}
+	for _, action := range actions {
+		if action.Err != nil {
+			continue
+		}
+		action.Err = retryTransient(ctx, func() (err error) {
+			switch action.Op {
+			case RollbackRestore:
+				_, err = client.RestoreContext(ctx, NewRestoreArg(action.Path, action.Rev))
+			case RollbackDelete:
+				_, err = client.DeleteV2Context(ctx, NewDeleteArg(action.Path))
+			}
+			return err
+		})
+	}
+	return actions, nil
+}
+
+// retryTransient calls fn until it succeeds, fails with an error that isn't
+// transient or has been retried maxRollbackRetries times.
+func retryTransient(ctx context.Context, fn func() error) error {
+	err := fn()
+	for n := 1; err != nil && n <= maxRollbackRetries && retryableChunkError(err) && waitRetry(ctx, nil, n, err); n++ {
+		err = fn()
+	}
+	return err
+}
+
+// planRollback returns the action rolling the file at path back to time to,
+// or nil if it is unchanged since then.
+func planRollback(ctx context.Context, client Client, path string, to time.Time) *RollbackAction {
+	arg := NewListRevisionsArg(path)
+	arg.Limit = maxRevisions
+	var res *ListRevisionsResult
+	err := retryTransient(ctx, func() (err error) {
+		res, err = client.ListRevisionsContext(ctx, arg)
+		return err
+	})
+	if err != nil {
+		if apiErr, ok := err.(ListRevisionsAPIError); ok && apiErr.EndpointError != nil &&
+			apiErr.EndpointError.Path != nil && apiErr.EndpointError.Path.Tag == LookupErrorNotFile {
+			// A deleted folder.
+			return nil
+		}
+		return &RollbackAction{Op: RollbackRestore, Path: path, Err: err}
+	}
+	if res.IsDeleted && res.ServerDeleted != nil && !res.ServerDeleted.After(to) {
+		return nil
+	}
+
+	// Revisions are listed newest first.
+	for i, rev := range res.Entries {
+		if rev.ServerModified.After(to) {
+			continue
+		}
+		if i == 0 && !res.IsDeleted {
+			return nil
+		}
+		return &RollbackAction{Op: RollbackRestore, Path: path, Rev: rev.Rev}
+	}
+	if len(res.Entries) == maxRevisions {
+		return &RollbackAction{Op: RollbackRestore, Path: path, Err: errNoRevision}
+	}
+	if res.IsDeleted {
+		// Created and deleted since then.
+		return nil
+	}
+	return &RollbackAction{Op: RollbackDelete, Path: path}
+}
@@ -0,0 +1,123 @@
+package files_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestRollbackFolder(t *testing.T) {
+	to := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	before, after := to.Add(-time.Hour), to.Add(time.Hour)
+	rev := func(r string, modified time.Time) *files.FileMetadata {
+		return &files.FileMetadata{Rev: r, ServerModified: modified}
+	}
+	file := func(p string, modified time.Time) *files.FileMetadata {
+		return &files.FileMetadata{Metadata: files.Metadata{PathDisplay: p}, ServerModified: modified}
+	}
+	deleted := func(p string) *files.DeletedMetadata {
+		return &files.DeletedMetadata{Metadata: files.Metadata{PathDisplay: p}}
+	}
+	revisions := map[string]*files.ListRevisionsResult{
+		"/a/edited.txt":  {Entries: []*files.FileMetadata{rev("r3", after), rev("r2", before)}},
+		"/a/created.txt": {Entries: []*files.FileMetadata{rev("r4", after)}},
+		"/a/removed.txt": {IsDeleted: true, ServerDeleted: &after, Entries: []*files.FileMetadata{rev("r5", before)}},
+		"/a/old.txt":     {IsDeleted: true, ServerDeleted: &before, Entries: []*files.FileMetadata{rev("r6", before)}},
+	}
+
+	var mu sync.Mutex
+	var looked, changed []string
+	inFlight, maxInFlight := 0, 0
+	failed := false
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if !arg.Recursive || !arg.IncludeDeleted {
+				t.Errorf("Unexpected arg: %+v\n", arg)
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				file("/a/edited.txt", after), file("/a/same.txt", before), file("/a/created.txt", after),
+				deleted("/a/removed.txt"), deleted("/a/old.txt"), deleted("/a/folder"),
+			}}, nil
+		},
+		ListRevisionsFunc: func(ctx context.Context, arg *files.ListRevisionsArg) (*files.ListRevisionsResult, error) {
+			mu.Lock()
+			looked = append(looked, arg.Path)
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			retry := arg.Path == "/a/edited.txt" && !failed
+			failed = failed || retry
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			if retry {
+				return nil, auth.ServerError{StatusCode: http.StatusServiceUnavailable}
+			}
+			if res, ok := revisions[arg.Path]; ok {
+				return res, nil
+			}
+			return nil, files.ListRevisionsAPIError{EndpointError: &files.ListRevisionsError{
+				Tagged: dropbox.Tagged{Tag: files.ListRevisionsErrorPath},
+				Path:   &files.LookupError{Tagged: dropbox.Tagged{Tag: files.LookupErrorNotFile}},
+			}}
+		},
+		RestoreFunc: func(ctx context.Context, arg *files.RestoreArg) (*files.FileMetadata, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			changed = append(changed, "restore "+arg.Path+" "+arg.Rev)
+			return &files.FileMetadata{}, nil
+		},
+		DeleteV2Func: func(ctx context.Context, arg *files.DeleteArg) (*files.DeleteResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			changed = append(changed, "delete "+arg.Path)
+			return &files.DeleteResult{}, nil
+		},
+	}
+
+	want := []string{"restore /a/edited.txt r2", "delete /a/created.txt", "restore /a/removed.txt r5"}
+	describe := func(actions []*files.RollbackAction) []string {
+		var got []string
+		for _, a := range actions {
+			if a.Err != nil {
+				t.Errorf("Unexpected error for %s: %v\n", a.Path, a.Err)
+			}
+			if a.Op == files.RollbackRestore {
+				got = append(got, a.Op+" "+a.Path+" "+a.Rev)
+			} else {
+				got = append(got, a.Op+" "+a.Path)
+			}
+		}
+		return got
+	}
+
+	actions, err := files.RollbackFolder(context.Background(), dbx, "/a", to, &files.RollbackOptions{Preview: true, Concurrency: 2})
+	if err != nil || !reflect.DeepEqual(describe(actions), want) {
+		t.Errorf("Unexpected actions: %v, %v\n", describe(actions), err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("Unexpected changes of a preview: %v\n", changed)
+	}
+	// same.txt is unchanged since then, edited.txt is retried once.
+	if len(looked) != 6 || maxInFlight > 2 {
+		t.Errorf("Unexpected lookups: %v, %d in flight\n", looked, maxInFlight)
+	}
+
+	actions, err = files.RollbackFolder(context.Background(), dbx, "/a", to, nil)
+	if err != nil || !reflect.DeepEqual(describe(actions), want) {
+		t.Errorf("Unexpected actions: %v, %v\n", describe(actions), err)
+	}
+	if !reflect.DeepEqual(changed, want) {
+		t.Errorf("Unexpected changes: %v\n", changed)
+	}
+}
@@ -0,0 +1,56 @@
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// SaveURLFailedError is returned by SaveURLAndWait when the `save_url` job
+// failed.
+type SaveURLFailedError struct {
+	Failure *SaveUrlError
+}
+
+func (e SaveURLFailedError) Error() string {
+	return fmt.Sprintf("save url failed: %s", e.Failure.Tag)
+}
+
+// SaveURLAndWait saves the content at url to path with `save_url` and polls
+// `save_url/check_job_status` until Dropbox has fetched it, with the poll
+// intervals of opts. It returns the metadata of the saved file.
+func SaveURLAndWait(ctx context.Context, client Client, path string, url string, opts *BatchOptions) (*FileMetadata, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	res, err := client.SaveUrlContext(ctx, NewSaveUrlArg(path, url))
+	if err != nil {
+		return nil, err
+	}
+	if res.Tag != SaveUrlResultAsyncJobId {
+		if res.Complete == nil {
+			return nil, errUnexpectedBatchResult
+		}
+		return res.Complete, nil
+	}
+
+	v, err := async.Await(ctx, res.AsyncJobId, &opts.JobOptions, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		status, err := client.SaveUrlCheckJobStatusContext(ctx, arg)
+		if err != nil {
+			return nil, true, err
+		}
+		if status.Tag == SaveUrlJobStatusFailed && status.Failed != nil {
+			return nil, true, SaveURLFailedError{Failure: status.Failed}
+		}
+		return status.Complete, status.Tag != SaveUrlJobStatusInProgress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m, _ := v.(*FileMetadata)
+	if m == nil {
+		return nil, errUnexpectedBatchResult
+	}
+	return m, nil
+}
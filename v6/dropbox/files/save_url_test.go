@@ -0,0 +1,61 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestSaveURLAndWait(t *testing.T) {
+	checks := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.SaveUrlArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/save_url":
+				fmt.Fprintf(w, `{".tag": "async_job_id", "async_job_id": %q}`, arg.Url)
+			case "/files/save_url/check_job_status":
+				if checks++; checks < 3 {
+					_, _ = w.Write([]byte(`{".tag": "in_progress"}`))
+					return
+				}
+				if checks > 3 {
+					_, _ = w.Write([]byte(`{".tag": "failed", "failed": {".tag": "download_failed"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{".tag": "complete", "name": "page.html", "path_lower": "/page.html", "size": 42}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	opts := &files.BatchOptions{JobOptions: async.JobOptions{PollInterval: time.Millisecond, MaxPollInterval: 4 * time.Millisecond}}
+	res, err := files.SaveURLAndWait(context.Background(), dbx, "/page.html", "https://example.com/", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Name != "page.html" || res.Size != 42 || checks != 3 {
+		t.Errorf("Unexpected result: %+v after %d checks\n", res, checks)
+	}
+
+	_, err = files.SaveURLAndWait(context.Background(), dbx, "/page.html", "https://example.com/", opts)
+	var failed files.SaveURLFailedError
+	if !errors.As(err, &failed) || failed.Failure.Tag != files.SaveUrlErrorDownloadFailed {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
@@ -0,0 +1,79 @@
+package files
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"alpha/get_metadata":                "files.metadata.read",
+	"alpha/upload":                      "files.content.write",
+	"copy":                              "files.content.write",
+	"copy_v2":                           "files.content.write",
+	"copy_batch":                        "files.content.write",
+	"copy_batch_v2":                     "files.content.write",
+	"copy_batch/check":                  "files.content.write",
+	"copy_batch/check_v2":               "files.content.write",
+	"copy_reference/get":                "files.content.write",
+	"copy_reference/save":               "files.content.write",
+	"create_folder":                     "files.content.write",
+	"create_folder_v2":                  "files.content.write",
+	"create_folder_batch":               "files.content.write",
+	"create_folder_batch/check":         "files.content.write",
+	"delete":                            "files.content.write",
+	"delete_v2":                         "files.content.write",
+	"delete_batch":                      "files.content.write",
+	"delete_batch/check":                "files.content.write",
+	"download":                          "files.content.read",
+	"download_zip":                      "files.content.read",
+	"export":                            "files.content.read",
+	"get_file_lock_batch":               "files.content.read",
+	"get_metadata":                      "files.metadata.read",
+	"get_preview":                       "files.content.read",
+	"get_temporary_link":                "files.content.read",
+	"get_temporary_upload_link":         "files.content.write",
+	"get_thumbnail":                     "files.content.read",
+	"get_thumbnail_v2":                  "files.content.read",
+	"get_thumbnail_batch":               "files.content.read",
+	"list_folder":                       "files.metadata.read",
+	"list_folder/continue":              "files.metadata.read",
+	"list_folder/get_latest_cursor":     "files.metadata.read",
+	"list_folder/longpoll":              "files.metadata.read",
+	"list_revisions":                    "files.metadata.read",
+	"lock_file_batch":                   "files.content.write",
+	"move":                              "files.content.write",
+	"move_v2":                           "files.content.write",
+	"move_batch":                        "files.content.write",
+	"move_batch_v2":                     "files.content.write",
+	"move_batch/check":                  "files.content.write",
+	"move_batch/check_v2":               "files.content.write",
+	"paper/create":                      "files.content.write",
+	"paper/update":                      "files.content.write",
+	"permanently_delete":                "files.permanent_delete",
+	"properties/add":                    "files.metadata.write",
+	"properties/overwrite":              "files.metadata.write",
+	"properties/remove":                 "files.metadata.write",
+	"properties/template/get":           "files.metadata.read",
+	"properties/template/list":          "files.metadata.read",
+	"properties/update":                 "files.metadata.write",
+	"restore":                           "files.content.write",
+	"save_url":                          "files.content.write",
+	"save_url/check_job_status":         "files.content.write",
+	"search":                            "files.metadata.read",
+	"search_v2":                         "files.metadata.read",
+	"search/continue_v2":                "files.metadata.read",
+	"tags/add":                          "files.metadata.write",
+	"tags/get":                          "files.metadata.read",
+	"tags/remove":                       "files.metadata.write",
+	"unlock_file_batch":                 "files.content.write",
+	"upload":                            "files.content.write",
+	"upload_session/append":             "files.content.write",
+	"upload_session/append_v2":          "files.content.write",
+	"upload_session/finish":             "files.content.write",
+	"upload_session/finish_batch":       "files.content.write",
+	"upload_session/finish_batch_v2":    "files.content.write",
+	"upload_session/finish_batch/check": "files.content.write",
+	"upload_session/start":              "files.content.write",
+	"upload_session/start_batch":        "files.content.write",
+}
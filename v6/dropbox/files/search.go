@@ -0,0 +1,162 @@
+package files
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// SearchQuery builds the argument of `search_v2`:
+//
+//	q := files.NewSearchQuery("report").InPath("/Work").Extensions("pdf", "docx").WithHighlights()
+//	it := files.NewSearchHitIterator(dbx, q)
+type SearchQuery struct {
+	arg *SearchV2Arg
+}
+
+// NewSearchQuery returns a SearchQuery for query, matching active files
+// and folders anywhere.
+func NewSearchQuery(query string) *SearchQuery {
+	arg := NewSearchV2Arg(query)
+	arg.Options = NewSearchOptions()
+	return &SearchQuery{arg: arg}
+}
+
+// InPath scopes the search to the folder at path.
+func (q *SearchQuery) InPath(path string) *SearchQuery {
+	q.arg.Options.Path = path
+	return q
+}
+
+// Extensions restricts the search to files with the given extensions,
+// without the leading dot.
+func (q *SearchQuery) Extensions(extensions ...string) *SearchQuery {
+	q.arg.Options.FileExtensions = append(q.arg.Options.FileExtensions, extensions...)
+	return q
+}
+
+// Categories restricts the search to the given categories, e.g.
+// FileCategoryImage.
+func (q *SearchQuery) Categories(categories ...string) *SearchQuery {
+	for _, c := range categories {
+		q.arg.Options.FileCategories = append(q.arg.Options.FileCategories, &FileCategory{Tagged: dropbox.Tagged{Tag: c}})
+	}
+	return q
+}
+
+// FilenameOnly matches file names only, not their content.
+func (q *SearchQuery) FilenameOnly() *SearchQuery {
+	q.arg.Options.FilenameOnly = true
+	return q
+}
+
+// Deleted searches deleted files instead of active ones.
+func (q *SearchQuery) Deleted() *SearchQuery {
+	q.arg.Options.FileStatus = &FileStatus{Tagged: dropbox.Tagged{Tag: FileStatusDeleted}}
+	return q
+}
+
+// OrderByLastModified orders matches by modification time rather than
+// relevance.
+func (q *SearchQuery) OrderByLastModified() *SearchQuery {
+	q.arg.Options.OrderBy = &SearchOrderBy{Tagged: dropbox.Tagged{Tag: SearchOrderByLastModifiedTime}}
+	return q
+}
+
+// PageSize sets the number of matches fetched per request, up to
+// dropbox.MaxSearchResults.
+func (q *SearchQuery) PageSize(n uint64) *SearchQuery {
+	if n > dropbox.MaxSearchResults {
+		n = dropbox.MaxSearchResults
+	}
+	q.arg.Options.MaxResults = n
+	return q
+}
+
+// WithHighlights requests the spans of the matches that matched the query.
+func (q *SearchQuery) WithHighlights() *SearchQuery {
+	q.arg.MatchFieldOptions = &SearchMatchFieldOptions{IncludeHighlights: true}
+	return q
+}
+
+// Arg returns the built argument.
+func (q *SearchQuery) Arg() *SearchV2Arg {
+	return q.arg
+}
+
+// Highlight is a span of the text of a SearchHit.
+type Highlight struct {
+	Text string
+	// Highlighted is true for the spans that matched the query.
+	Highlighted bool
+}
+
+// SearchHit is a single match of a search.
+type SearchHit struct {
+	Metadata IsMetadata
+	// MatchType is one of the SearchMatchTypeV2* tags, e.g.
+	// SearchMatchTypeV2Filename.
+	MatchType string
+	// Highlights are set if the query was built WithHighlights.
+	Highlights []Highlight
+}
+
+// Format returns the text of the highlights of h, with the highlighted
+// spans enclosed in before and after, e.g. "<b>" and "</b>".
+func (h *SearchHit) Format(before string, after string) string {
+	var b strings.Builder
+	for _, s := range h.Highlights {
+		if s.Highlighted {
+			b.WriteString(before + s.Text + after)
+		} else {
+			b.WriteString(s.Text)
+		}
+	}
+	return b.String()
+}
+
+// SearchHitIterator iterates over the matches of a search, fetching the
+// pages of `search_v2` and `search/continue_v2` as needed.
+type SearchHitIterator struct {
+	pages *SearchV2Iterator
+	hits  []*SearchMatchV2
+}
+
+// NewSearchHitIterator returns a new SearchHitIterator instance
+func NewSearchHitIterator(client Client, q *SearchQuery) *SearchHitIterator {
+	return &SearchHitIterator{pages: NewSearchV2Iterator(client, q.Arg())}
+}
+
+// HasMore returns false once all matches have been returned. As a page may
+// be empty, Next can still return `dropbox.ErrNoMorePages` after HasMore
+// returned true.
+func (it *SearchHitIterator) HasMore() bool {
+	return len(it.hits) > 0 || it.pages.HasMore()
+}
+
+// Next returns the next match, fetching the next page if needed. It returns
+// `dropbox.ErrNoMorePages` once all matches have been returned.
+func (it *SearchHitIterator) Next(ctx context.Context) (*SearchHit, error) {
+	for len(it.hits) == 0 {
+		res, err := it.pages.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		it.hits = res.Matches
+	}
+	m := it.hits[0]
+	it.hits = it.hits[1:]
+
+	hit := &SearchHit{}
+	if m.Metadata != nil {
+		hit.Metadata = m.Metadata.Metadata
+	}
+	if m.MatchType != nil {
+		hit.MatchType = m.MatchType.Tag
+	}
+	for _, s := range m.HighlightSpans {
+		hit.Highlights = append(hit.Highlights, Highlight{Text: s.HighlightStr, Highlighted: s.IsHighlighted})
+	}
+	return hit, nil
+}
@@ -0,0 +1,58 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestSearchHitIterator(t *testing.T) {
+	pages := map[string]string{
+		"/files/search_v2": `{"matches": [{
+			"metadata": {".tag": "metadata", "metadata": {".tag": "file", "name": "report.pdf"}},
+			"match_type": {".tag": "filename"},
+			"highlight_spans": [{"highlight_str": "2020 ", "is_highlighted": false}, {"highlight_str": "report", "is_highlighted": true}]
+		}], "cursor": "c1", "has_more": true}`,
+		"/files/search/continue_v2": `{"matches": [{
+			"metadata": {".tag": "metadata", "metadata": {".tag": "file", "name": "report.docx"}},
+			"match_type": {".tag": "file_content"}
+		}], "has_more": false}`,
+	}
+	var arg files.SearchV2Arg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/files/search_v2" {
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(pages[r.URL.Path]))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	q := files.NewSearchQuery("report").InPath("/Work").Extensions("pdf", "docx").Categories(files.FileCategoryDocument).WithHighlights()
+	it := files.NewSearchHitIterator(files.New(config), q)
+	var got []string
+	for it.HasMore() {
+		hit, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, hit.Metadata.(*files.FileMetadata).Name+" "+hit.MatchType+" "+hit.Format("[", "]"))
+	}
+	if strings.Join(got, ",") != "report.pdf filename 2020 [report],report.docx file_content " {
+		t.Errorf("Unexpected hits: %q\n", got)
+	}
+	if arg.Options.Path != "/Work" || len(arg.Options.FileExtensions) != 2 || arg.Options.FileCategories[0].Tag != "document" || !arg.MatchFieldOptions.IncludeHighlights {
+		t.Errorf("Unexpected arg: %+v\n", arg.Options)
+	}
+}
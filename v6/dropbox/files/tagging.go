@@ -0,0 +1,125 @@
+package files
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// TagFilesOptions controls how TagEachFileInFolder and TagEachSearchResult
+// apply property groups.
+type TagFilesOptions struct {
+	// Overwrite replaces existing property groups of the same templates via
+	// `properties/overwrite` instead of adding them via `properties/add`.
+	Overwrite bool
+	// Concurrency is the number of files tagged in parallel. Defaults to 4.
+	Concurrency int
+	// Filter, if set, selects the files to tag.
+	Filter func(file *FileMetadata) bool
+}
+
+// TagOutcome is the result of tagging a single file.
+type TagOutcome struct {
+	File *FileMetadata
+	// Err is the error returned by `properties/add` or
+	// `properties/overwrite`, if any.
+	Err error
+}
+
+// TagEachFileInFolder applies groups to every file under path, recursively,
+// with one `properties/add` or `properties/overwrite` request per file, as
+// the API has no batch route for them. Files are tagged one listing page at
+// a time, with up to opts.Concurrency requests in flight. Per-file failures
+// are reported in the outcomes; the returned error is only set if listing
+// the folder fails, in which case the outcomes of the files tagged so far
+// are returned alongside it.
+func TagEachFileInFolder(ctx context.Context, client Client, props file_properties.Client, path string, groups []*file_properties.PropertyGroup, opts *TagFilesOptions) ([]*TagOutcome, error) {
+	arg := NewListFolderArg(path)
+	arg.Recursive = true
+	it := NewListFolderIterator(client, arg)
+	return tagPages(ctx, props, groups, opts, func() ([]*FileMetadata, bool, error) {
+		if !it.HasMore() {
+			return nil, false, nil
+		}
+		res, err := it.Next(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		var batch []*FileMetadata
+		for _, e := range res.Entries {
+			if f, ok := e.(*FileMetadata); ok {
+				batch = append(batch, f)
+			}
+		}
+		return batch, true, nil
+	})
+}
+
+// TagEachSearchResult applies groups to every file matching the search arg,
+// with one request per file. See TagEachFileInFolder for how files are
+// tagged and errors reported.
+func TagEachSearchResult(ctx context.Context, client Client, props file_properties.Client, arg *SearchV2Arg, groups []*file_properties.PropertyGroup, opts *TagFilesOptions) ([]*TagOutcome, error) {
+	it := NewSearchV2Iterator(client, arg)
+	return tagPages(ctx, props, groups, opts, func() ([]*FileMetadata, bool, error) {
+		if !it.HasMore() {
+			return nil, false, nil
+		}
+		res, err := it.Next(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		var batch []*FileMetadata
+		for _, m := range res.Matches {
+			if m.Metadata == nil {
+				continue
+			}
+			if f, ok := m.Metadata.Metadata.(*FileMetadata); ok {
+				batch = append(batch, f)
+			}
+		}
+		return batch, true, nil
+	})
+}
+
+// tagPages tags the files returned by next until it reports that there are
+// no more pages.
+func tagPages(ctx context.Context, props file_properties.Client, groups []*file_properties.PropertyGroup, opts *TagFilesOptions, next func() ([]*FileMetadata, bool, error)) (res []*TagOutcome, err error) {
+	if opts == nil {
+		opts = &TagFilesOptions{}
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+
+	for {
+		batch, more, err := next()
+		if err != nil || !more {
+			return res, err
+		}
+		var outcomes []*TagOutcome
+		for _, f := range batch {
+			if opts.Filter == nil || opts.Filter(f) {
+				outcomes = append(outcomes, &TagOutcome{File: f})
+			}
+		}
+
+		sem := make(chan struct{}, workers)
+		var wg sync.WaitGroup
+		for _, o := range outcomes {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(o *TagOutcome) {
+				defer func() { <-sem; wg.Done() }()
+				if opts.Overwrite {
+					o.Err = props.PropertiesOverwriteContext(ctx, file_properties.NewOverwritePropertyGroupArg(o.File.Id, groups))
+				} else {
+					o.Err = props.PropertiesAddContext(ctx, file_properties.NewAddPropertiesArg(o.File.Id, groups))
+				}
+			}(o)
+		}
+		wg.Wait()
+		res = append(res, outcomes...)
+	}
+}
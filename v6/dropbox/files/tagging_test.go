@@ -0,0 +1,113 @@
+package files_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestTagFiles(t *testing.T) {
+	file := func(id string) *files.FileMetadata {
+		f := &files.FileMetadata{Id: id}
+		f.Name = id
+		return f
+	}
+	folder := &files.FolderMetadata{}
+	folder.Name = "folder"
+	groups := []*file_properties.PropertyGroup{file_properties.NewPropertyGroup("ptid:1",
+		[]*file_properties.PropertyField{{Name: "status", Value: "done"}})}
+
+	var listErr error
+	client := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if arg.Path != "/docs" || !arg.Recursive {
+				t.Errorf("Unexpected listing: %+v\n", arg)
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{file("a"), folder, file("b")},
+				Cursor: "c1", HasMore: true}, nil
+		},
+		ListFolderContinueFunc: func(ctx context.Context, arg *files.ListFolderContinueArg) (*files.ListFolderResult, error) {
+			if listErr != nil {
+				return nil, listErr
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{file("c"), file("skip")}}, nil
+		},
+		SearchV2Func: func(ctx context.Context, arg *files.SearchV2Arg) (*files.SearchV2Result, error) {
+			metadata := func(m files.IsMetadata) *files.SearchMatchV2 {
+				return &files.SearchMatchV2{Metadata: &files.MetadataV2{
+					Tagged: dropbox.Tagged{Tag: files.MetadataV2Metadata}, Metadata: m}}
+			}
+			return &files.SearchV2Result{Matches: []*files.SearchMatchV2{
+				{}, metadata(folder), metadata(file("d")),
+			}}, nil
+		},
+	}
+	var mu sync.Mutex
+	var added, overwritten []string
+	props := &file_properties.Mock{
+		PropertiesAddFunc: func(ctx context.Context, arg *file_properties.AddPropertiesArg) error {
+			if !reflect.DeepEqual(arg.PropertyGroups, groups) {
+				t.Errorf("Unexpected groups: %+v\n", arg.PropertyGroups)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			added = append(added, arg.Path)
+			if arg.Path == "b" {
+				return errors.New("add failed")
+			}
+			return nil
+		},
+		PropertiesOverwriteFunc: func(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg) error {
+			mu.Lock()
+			defer mu.Unlock()
+			overwritten = append(overwritten, arg.Path)
+			return nil
+		},
+	}
+
+	// Every file of every page is tagged, a failure only affecting the
+	// outcome of its file.
+	opts := &files.TagFilesOptions{Concurrency: 2, Filter: func(f *files.FileMetadata) bool {
+		return f.Id != "skip"
+	}}
+	res, err := files.TagEachFileInFolder(context.Background(), client, props, "/docs", groups, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, o := range res {
+		got = append(got, fmt.Sprintf("%s:%v", o.File.Id, o.Err))
+	}
+	if want := []string{"a:<nil>", "b:add failed", "c:<nil>"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected outcomes: %v\n", got)
+	}
+	sort.Strings(added)
+	if !reflect.DeepEqual(added, []string{"a", "b", "c"}) || len(overwritten) != 0 {
+		t.Errorf("Unexpected requests: %v %v\n", added, overwritten)
+	}
+
+	// A listing failure returns the outcomes of the pages already tagged.
+	listErr = errors.New("list failed")
+	res, err = files.TagEachFileInFolder(context.Background(), client, props, "/docs", groups, opts)
+	if err != listErr || len(res) != 2 || res[0].File.Id != "a" || res[1].File.Id != "b" {
+		t.Errorf("Unexpected result: %d %v\n", len(res), err)
+	}
+
+	// Only the files among the search matches are tagged.
+	res, err = files.TagEachSearchResult(context.Background(), client, props, files.NewSearchV2Arg("report"), groups,
+		&files.TagFilesOptions{Overwrite: true})
+	if err != nil || len(res) != 1 || res[0].File.Id != "d" || res[0].Err != nil {
+		t.Errorf("Unexpected result: %+v %v\n", res, err)
+	}
+	if !reflect.DeepEqual(overwritten, []string{"d"}) {
+		t.Errorf("Unexpected requests: %v\n", overwritten)
+	}
+}
@@ -0,0 +1,150 @@
+package files
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// Limits of the `tags` routes.
+const (
+	// MaxItemTags is the maximum number of tags of a single item.
+	MaxItemTags = 20
+	// tagsGetBatch is the number of paths sent with a single `tags/get`
+	// request.
+	tagsGetBatch = 100
+)
+
+// TagManager reads and updates the tags of many items at once. Tags are
+// compared in lowercase, as they are stored by Dropbox.
+type TagManager struct {
+	client Client
+}
+
+// NewTagManager returns a TagManager using client.
+func NewTagManager(client Client) *TagManager {
+	return &TagManager{client: client}
+}
+
+// Get returns the tags of paths, keyed by path, with as few `tags/get`
+// requests as possible. If a request fails, the tags read so far are
+// returned along with the error.
+func (m *TagManager) Get(ctx context.Context, paths []string) (map[string][]string, error) {
+	tags := make(map[string][]string, len(paths))
+	for start := 0; start < len(paths); start += tagsGetBatch {
+		end := start + tagsGetBatch
+		if end > len(paths) {
+			end = len(paths)
+		}
+		res, err := m.client.TagsGetContext(ctx, NewGetTagsArg(paths[start:end]))
+		if err != nil {
+			return tags, err
+		}
+		if len(res.PathsToTags) != end-start {
+			return tags, errUnexpectedBatchResult
+		}
+		for i, p := range res.PathsToTags {
+			var names []string
+			for _, t := range p.Tags {
+				if t.Tag == TagUserGeneratedTag && t.UserGeneratedTag != nil {
+					names = append(names, t.UserGeneratedTag.TagText)
+				}
+			}
+			tags[paths[start+i]] = names
+		}
+	}
+	return tags, nil
+}
+
+// Add adds tag to every item of paths. Failures of individual items are
+// returned keyed by path.
+func (m *TagManager) Add(ctx context.Context, paths []string, tag string) map[string]error {
+	failures := make(map[string]error)
+	for _, p := range paths {
+		if err := m.client.TagsAddContext(ctx, NewAddTagArg(p, tag)); err != nil {
+			failures[p] = err
+		}
+	}
+	return failures
+}
+
+// Remove removes tag from every item of paths. Items that don't have the tag
+// aren't reported as failures.
+func (m *TagManager) Remove(ctx context.Context, paths []string, tag string) map[string]error {
+	failures := make(map[string]error)
+	for _, p := range paths {
+		if err := m.removeTag(ctx, p, tag); err != nil {
+			failures[p] = err
+		}
+	}
+	return failures
+}
+
+// Sync sets the tags of every path of want to exactly the given tags,
+// removing extra tags before adding missing ones so that items stay within
+// MaxItemTags. Failures of individual items are returned keyed by path; the
+// returned error is only set if the current tags can't be read.
+func (m *TagManager) Sync(ctx context.Context, want map[string][]string) (map[string]error, error) {
+	paths := make([]string, 0, len(want))
+	for p := range want {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	current, err := m.Get(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	failures := make(map[string]error)
+	for _, p := range paths {
+		remove, add := diffTags(current[p], want[p])
+		for _, t := range remove {
+			if err = m.removeTag(ctx, p, t); err != nil {
+				break
+			}
+		}
+		for _, t := range add {
+			if err != nil {
+				break
+			}
+			err = m.client.TagsAddContext(ctx, NewAddTagArg(p, t))
+		}
+		if err != nil {
+			failures[p] = err
+		}
+	}
+	return failures, nil
+}
+
+func (m *TagManager) removeTag(ctx context.Context, path string, tag string) error {
+	err := m.client.TagsRemoveContext(ctx, NewRemoveTagArg(path, tag))
+	if e, ok := err.(TagsRemoveAPIError); ok && e.EndpointError != nil && e.EndpointError.Tag == RemoveTagErrorTagNotPresent {
+		return nil
+	}
+	return err
+}
+
+// diffTags returns the tags of have missing from want, and those of want
+// missing from have, ignoring case and duplicates.
+func diffTags(have []string, want []string) (remove []string, add []string) {
+	wanted := make(map[string]bool, len(want))
+	for _, t := range want {
+		wanted[strings.ToLower(t)] = true
+	}
+	present := make(map[string]bool, len(have))
+	for _, t := range have {
+		t = strings.ToLower(t)
+		if !wanted[t] && !present[t] {
+			remove = append(remove, t)
+		}
+		present[t] = true
+	}
+	for _, t := range want {
+		t = strings.ToLower(t)
+		if !present[t] {
+			add = append(add, t)
+			present[t] = true
+		}
+	}
+	return remove, add
+}
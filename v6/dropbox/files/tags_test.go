@@ -0,0 +1,98 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestTagManager(t *testing.T) {
+	var mu sync.Mutex
+	tags := map[string][]string{"/a": {"old", "keep"}}
+	var batches []int
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/tags/get":
+				var arg files.GetTagsArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				batches = append(batches, len(arg.Paths))
+				var entries []string
+				for _, p := range arg.Paths {
+					var ts []string
+					for _, tag := range tags[p] {
+						ts = append(ts, fmt.Sprintf(`{".tag": "user_generated_tag", "tag_text": %q}`, tag))
+					}
+					entries = append(entries, fmt.Sprintf(`{"path": %q, "tags": [%s]}`, p, strings.Join(ts, ",")))
+				}
+				fmt.Fprintf(w, `{"paths_to_tags": [%s]}`, strings.Join(entries, ","))
+			case "/files/tags/add":
+				var arg files.AddTagArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				if arg.Path == "/missing" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"error_summary": "path/not_found/", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+					return
+				}
+				tags[arg.Path] = append(tags[arg.Path], arg.TagText)
+				_, _ = w.Write([]byte("null"))
+			case "/files/tags/remove":
+				var arg files.RemoveTagArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				var kept []string
+				for _, tag := range tags[arg.Path] {
+					if tag != arg.TagText {
+						kept = append(kept, tag)
+					}
+				}
+				tags[arg.Path] = kept
+				_, _ = w.Write([]byte("null"))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	m := files.NewTagManager(files.New(config))
+	var paths []string
+	for i := 0; i < 150; i++ {
+		paths = append(paths, fmt.Sprintf("/%d", i))
+	}
+	if _, err := m.Get(context.Background(), paths); err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 2 || batches[0] != 100 || batches[1] != 50 {
+		t.Errorf("Unexpected batches: %v\n", batches)
+	}
+
+	failures, err := m.Sync(context.Background(), map[string][]string{
+		"/a":       {"Keep", "new"},
+		"/missing": {"new"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 1 || failures["/missing"] == nil {
+		t.Errorf("Unexpected failures: %v\n", failures)
+	}
+	got, err := m.Get(context.Background(), []string{"/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got["/a"], ",") != "keep,new" {
+		t.Errorf("Unexpected tags: %v\n", got["/a"])
+	}
+}
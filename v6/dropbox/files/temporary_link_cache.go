@@ -0,0 +1,110 @@
+package files
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// DefaultTemporaryLinkMargin is the time before their expiry at which a
+// TemporaryLinkCache refreshes links by default.
+const DefaultTemporaryLinkMargin = 10 * time.Minute
+
+// TemporaryLinkCache remembers the links returned by `get_temporary_link`,
+// so that backends serving media from Dropbox don't request a link for every
+// request. Links are requested again lazily once they are about to expire.
+// Concurrent requests for the same path share a single call. A
+// TemporaryLinkCache is safe for concurrent use.
+type TemporaryLinkCache struct {
+	client Client
+	margin time.Duration
+
+	mu    sync.Mutex
+	links map[string]*temporaryLink
+}
+
+type temporaryLink struct {
+	// ready is closed once res and err are set.
+	ready   chan struct{}
+	res     *GetTemporaryLinkResult
+	err     error
+	expires time.Time
+}
+
+// NewTemporaryLinkCache returns an empty cache. Links are refreshed once they
+// expire within margin; DefaultTemporaryLinkMargin is used if margin is zero.
+func NewTemporaryLinkCache(client Client, margin time.Duration) *TemporaryLinkCache {
+	if margin <= 0 {
+		margin = DefaultTemporaryLinkMargin
+	}
+	return &TemporaryLinkCache{client: client, margin: margin, links: make(map[string]*temporaryLink)}
+}
+
+// GetTemporaryLink returns a link to the file at path, and its metadata,
+// valid for at least the margin of the cache. Errors aren't cached.
+func (c *TemporaryLinkCache) GetTemporaryLink(ctx context.Context, path string) (*GetTemporaryLinkResult, error) {
+	key := strings.ToLower(path)
+	c.mu.Lock()
+	l, ok := c.links[key]
+	if ok {
+		select {
+		case <-l.ready:
+			if l.err != nil || time.Until(l.expires) < c.margin {
+				ok = false
+			}
+		default:
+		}
+	}
+	if !ok {
+		l = &temporaryLink{ready: make(chan struct{})}
+		c.links[key] = l
+		c.mu.Unlock()
+		requested := time.Now()
+		l.res, l.err = c.client.GetTemporaryLinkContext(ctx, NewGetTemporaryLinkArg(path))
+		l.expires = requested.Add(dropbox.TemporaryLinkDuration)
+		close(l.ready)
+		if l.err != nil {
+			c.mu.Lock()
+			if c.links[key] == l {
+				delete(c.links, key)
+			}
+			c.mu.Unlock()
+		}
+		return l.res, l.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-l.ready:
+		return l.res, l.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Invalidate removes the link of path from the cache, e.g. after the file
+// was changed or deleted.
+func (c *TemporaryLinkCache) Invalidate(path string) {
+	c.mu.Lock()
+	delete(c.links, strings.ToLower(path))
+	c.mu.Unlock()
+}
+
+// Purge removes expired links from the cache, to bound its memory when many
+// different paths are requested.
+func (c *TemporaryLinkCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, l := range c.links {
+		select {
+		case <-l.ready:
+			if time.Until(l.expires) < c.margin {
+				delete(c.links, key)
+			}
+		default:
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestTemporaryLinkCache(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.GetTemporaryLinkArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			mu.Lock()
+			calls++
+			n := calls
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"metadata": {"name": "a.mp4", "path_lower": %q}, "link": "https://dl.example.com/%d"}`, arg.Path, n)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	c := files.NewTemporaryLinkCache(files.New(config), 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetTemporaryLink(context.Background(), "/A.mp4"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	res, err := c.GetTemporaryLink(context.Background(), "/a.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || res.Link != "https://dl.example.com/1" {
+		t.Errorf("Unexpected link: %d %s\n", calls, res.Link)
+	}
+	c.Invalidate("/a.mp4")
+	if res, _ = c.GetTemporaryLink(context.Background(), "/a.mp4"); res.Link != "https://dl.example.com/2" {
+		t.Errorf("Link not refreshed: %s\n", res.Link)
+	}
+
+	// Links always expire within a margin of their whole lifetime.
+	c = files.NewTemporaryLinkCache(files.New(config), dropbox.TemporaryLinkDuration)
+	_, _ = c.GetTemporaryLink(context.Background(), "/a.mp4")
+	_, _ = c.GetTemporaryLink(context.Background(), "/a.mp4")
+	if calls != 4 {
+		t.Errorf("Expired link not refreshed: %d calls\n", calls)
+	}
+}
@@ -0,0 +1,179 @@
+package files
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// TemporaryUploadOptions controls UploadWithTemporaryLink and
+// UploadToTemporaryLink.
+type TemporaryUploadOptions struct {
+	// Duration is the lifetime of the link. Defaults to the maximum of four
+	// hours.
+	Duration time.Duration
+	// MaxRetries is the number of times the upload is retried after a
+	// transient failure. Defaults to 3; a negative value disables retries.
+	MaxRetries int
+	// RetryPolicy spaces the retries, whose number is set by MaxRetries
+	// rather than MaxAttempts. Defaults to exponential delays from 500ms
+	// with full jitter.
+	RetryPolicy *dropbox.RetryPolicy
+	// HTTPClient sends the content. Defaults to the client used by the
+	// Dropbox client for unauthenticated requests, or http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func (o *TemporaryUploadOptions) maxRetries() int {
+	switch {
+	case o.MaxRetries < 0:
+		return 0
+	case o.MaxRetries == 0:
+		return 3
+	}
+	return o.MaxRetries
+}
+
+// TemporaryUploadError is returned when the content sent to a temporary
+// upload link is rejected.
+type TemporaryUploadError struct {
+	StatusCode int
+	Message    string
+	// RetryAfter is the delay requested by a rate limited response.
+	RetryAfter time.Duration
+}
+
+func (e TemporaryUploadError) Error() string {
+	return fmt.Sprintf("temporary upload link: %s: %s", http.StatusText(e.StatusCode), e.Message)
+}
+
+// Temporary reports whether the upload may succeed if retried, for a rate
+// limited request or a server error.
+func (e TemporaryUploadError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// errTemporaryUploadTooLarge is returned for content that can't be uploaded
+// with a single request.
+var errTemporaryUploadTooLarge = fmt.Errorf("temporary upload links accept up to %d bytes", dropbox.MaxUploadSize)
+
+// UploadWithTemporaryLink gets a link with `get_temporary_upload_link` and
+// uploads size bytes read from r to commit.Path with it. It is the Go
+// counterpart of handing the link to a browser or worker, and returns the
+// content hash of the uploaded file. See UploadToTemporaryLink for retries
+// and progress.
+func UploadWithTemporaryLink(ctx context.Context, client Client, r io.ReadSeeker, size int64, commit *CommitInfo, opts *TemporaryUploadOptions) (string, error) {
+	o := TemporaryUploadOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.HTTPClient == nil {
+		if impl, ok := client.(*apiImpl); ok {
+			o.HTTPClient = impl.NoAuthClient
+		}
+	}
+	if err := checkTemporaryUploadSize(size); err != nil {
+		return "", err
+	}
+
+	arg := NewGetTemporaryUploadLinkArg(commit)
+	if o.Duration > 0 {
+		arg.Duration = o.Duration.Seconds()
+	}
+	res, err := client.GetTemporaryUploadLinkContext(ctx, arg)
+	if err != nil {
+		return "", err
+	}
+	return UploadToTemporaryLink(ctx, res.Link, r, size, &o)
+}
+
+// UploadToTemporaryLink uploads size bytes read from r to link, as returned
+// by `get_temporary_upload_link`, and returns the content hash of the
+// uploaded file. r is rewound to its initial position before every retry.
+// A dropbox.ProgressFunc set on the context is called as the content is
+// sent, from zero again on retries.
+func UploadToTemporaryLink(ctx context.Context, link string, r io.ReadSeeker, size int64, opts *TemporaryUploadOptions) (string, error) {
+	if opts == nil {
+		opts = &TemporaryUploadOptions{}
+	}
+	if err := checkTemporaryUploadSize(size); err != nil {
+		return "", err
+	}
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+
+	upload := func() (string, error) {
+		if _, err := r.Seek(start, io.SeekStart); err != nil {
+			return "", err
+		}
+		return postTemporaryUpload(ctx, httpClient, link, io.LimitReader(r, size), size)
+	}
+	hash, err := upload()
+	for n := 0; err != nil && n < opts.maxRetries() && retryableChunkError(err) && ctx.Err() == nil; n++ {
+		if !waitRetry(ctx, opts.RetryPolicy, n+1, err) {
+			break
+		}
+		hash, err = upload()
+	}
+	return hash, err
+}
+
+func checkTemporaryUploadSize(size int64) error {
+	switch {
+	case size < 0:
+		return errNegativeSize
+	case size > dropbox.MaxUploadSize:
+		return errTemporaryUploadTooLarge
+	}
+	return nil
+}
+
+func postTemporaryUpload(ctx context.Context, httpClient *http.Client, link string, body io.Reader, size int64) (string, error) {
+	var rc io.ReadCloser = io.NopCloser(body)
+	if fn := dropbox.ProgressFromContext(ctx); fn != nil {
+		rc = dropbox.NewProgressReader(rc, fn, size)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", link, rc)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		e := TemporaryUploadError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(b))}
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			e.RetryAfter = time.Duration(secs) * time.Second
+		}
+		return "", e
+	}
+	var res struct {
+		ContentHash string `json:"content-hash"`
+	}
+	if err = json.Unmarshal(b, &res); err != nil {
+		return "", err
+	}
+	return res.ContentHash, nil
+}
@@ -0,0 +1,106 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestUploadWithTemporaryLink(t *testing.T) {
+	content := []byte("temporary upload content")
+	var attempts int
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			switch r.URL.Path {
+			case "/files/get_temporary_upload_link":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"link": "%s/apitul/1/abc"}`, ts.URL)
+			case "/apitul/1/abc":
+				attempts++
+				if attempts == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				if !bytes.Equal(b, content) || r.Header.Get("Content-Type") != "application/octet-stream" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				fmt.Fprintf(w, `{"content-hash": "%s"}`, contentHash(b))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	var last dropbox.Progress
+	ctx := dropbox.WithProgress(context.Background(), func(p dropbox.Progress) { last = p })
+	sum, err := files.UploadWithTemporaryLink(ctx, files.New(config), bytes.NewReader(content), int64(len(content)),
+		files.NewCommitInfo("/a.txt"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 || sum != contentHash(content) {
+		t.Errorf("Unexpected upload: %d %s\n", attempts, sum)
+	}
+	if last.Bytes != int64(len(content)) || last.Total != int64(len(content)) {
+		t.Errorf("Unexpected progress: %+v\n", last)
+	}
+
+	attempts = 0
+	_, err = files.UploadToTemporaryLink(context.Background(), ts.URL+"/apitul/1/abc", bytes.NewReader(content), int64(len(content)),
+		&files.TemporaryUploadOptions{MaxRetries: -1})
+	var uploadErr files.TemporaryUploadError
+	if !errors.As(err, &uploadErr) || uploadErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "connection reset" }
+func (temporaryError) Temporary() bool { return true }
+
+type transportFunc func(r *http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestUploadToTemporaryLinkRetries(t *testing.T) {
+	content := []byte("temporary upload content")
+	for _, test := range []struct {
+		err      error
+		attempts int
+	}{
+		{temporaryError{}, 3},
+		{errors.New("certificate signed by unknown authority"), 1},
+	} {
+		attempts := 0
+		client := &http.Client{Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, test.err
+		})}
+		start := time.Now()
+		_, err := files.UploadToTemporaryLink(context.Background(), "https://content.dropboxapi.com/apitul/1/abc",
+			bytes.NewReader(content), int64(len(content)), &files.TemporaryUploadOptions{MaxRetries: 2,
+				RetryPolicy: &dropbox.RetryPolicy{BaseDelay: 20 * time.Millisecond}, HTTPClient: client})
+		if !errors.Is(err, test.err) || attempts != test.attempts {
+			t.Errorf("Unexpected result for %v: %d attempts, %v\n", test.err, attempts, err)
+		}
+		// The retries wait 20ms then 40ms.
+		if elapsed := time.Since(start); test.attempts > 1 && elapsed < 60*time.Millisecond {
+			t.Errorf("Retries not spaced: %v\n", elapsed)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package files
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// thumbnailExt is the extension of the files of a ThumbnailCache.
+const thumbnailExt = ".thumb"
+
+// ThumbnailOptions selects the thumbnails returned by GetThumbnails and a
+// ThumbnailCache. Empty fields use the defaults of `get_thumbnail_batch`.
+type ThumbnailOptions struct {
+	// Size is one of the ThumbnailSize tags, e.g. ThumbnailSizeW64h64.
+	Size string
+	// Format is one of the ThumbnailFormat tags.
+	Format string
+	// Mode is one of the ThumbnailMode tags.
+	Mode string
+}
+
+// Thumbnail is the thumbnail of a single file.
+type Thumbnail struct {
+	File *FileMetadata
+	// Data is the encoded image.
+	Data []byte
+	// Cached is true if Data was read from the cache.
+	Cached bool
+	// Err is set if the thumbnail could not be fetched.
+	Err error
+}
+
+// ThumbnailCache keeps thumbnails in a local directory, keyed by file ID,
+// revision and options, so that repeated requests for the same thumbnails,
+// as made by gallery-style UIs, are served without calling
+// `get_thumbnail_batch`. The least recently used thumbnails are evicted once
+// the cache exceeds its maximum size. A ThumbnailCache is safe for concurrent
+// use, but its directory must not be shared with another cache.
+type ThumbnailCache struct {
+	client   Client
+	dir      string
+	maxBytes int64
+
+	mu    sync.Mutex
+	lru   *list.List // of *thumbnailEntry, most recently used first
+	index map[string]*list.Element
+	size  int64
+}
+
+type thumbnailEntry struct {
+	name string
+	size int64
+}
+
+// NewThumbnailCache returns a cache storing up to maxBytes of thumbnails in
+// dir, which is created if needed. Thumbnails already in dir are reused.
+func NewThumbnailCache(client Client, dir string, maxBytes int64) (*ThumbnailCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	infos, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type stored struct {
+		entry   *thumbnailEntry
+		modTime time.Time
+	}
+	var files []stored
+	for _, d := range infos {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), thumbnailExt) {
+			continue
+		}
+		info, err := d.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, stored{&thumbnailEntry{d.Name(), info.Size()}, info.ModTime()})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.After(files[j].modTime) })
+
+	c := &ThumbnailCache{client: client, dir: dir, maxBytes: maxBytes,
+		lru: list.New(), index: make(map[string]*list.Element)}
+	for _, f := range files {
+		c.index[f.entry.name] = c.lru.PushBack(f.entry)
+		c.size += f.entry.size
+	}
+	c.mu.Lock()
+	c.evict()
+	c.mu.Unlock()
+	return c, nil
+}
+
+// GetThumbnails returns the thumbnails of files, in the same order, fetching
+// those missing from the cache with as few `get_thumbnail_batch` calls as
+// possible. Failures of individual files are reported in the thumbnails; the
+// returned error is only set if a batch call fails.
+func (c *ThumbnailCache) GetThumbnails(ctx context.Context, files []*FileMetadata, opts *ThumbnailOptions) ([]*Thumbnail, error) {
+	if opts == nil {
+		opts = &ThumbnailOptions{}
+	}
+	res := make([]*Thumbnail, len(files))
+	var missing []int
+	for i, f := range files {
+		res[i] = &Thumbnail{File: f}
+		if data, ok := c.get(thumbnailName(f, opts)); ok {
+			res[i].Data, res[i].Cached = data, true
+		} else {
+			missing = append(missing, i)
+		}
+	}
+
+	paths := make([]string, len(missing))
+	for j, i := range missing {
+		paths[j] = "rev:" + files[i].Rev
+	}
+	err := fetchThumbnails(ctx, c.client, paths, opts, func(j int, data []byte, err error) {
+		t := res[missing[j]]
+		if t.Data, t.Err = data, err; err == nil {
+			c.put(thumbnailName(t.File, opts), data)
+		}
+	})
+	return res, err
+}
+
+func (o *ThumbnailOptions) thumbnailArg(path string) *ThumbnailArg {
+	arg := NewThumbnailArg(path)
+	if o.Size != "" {
+		arg.Size = &ThumbnailSize{Tagged: dropbox.Tagged{Tag: o.Size}}
+	}
+	if o.Format != "" {
+		arg.Format = &ThumbnailFormat{Tagged: dropbox.Tagged{Tag: o.Format}}
+	}
+	if o.Mode != "" {
+		arg.Mode = &ThumbnailMode{Tagged: dropbox.Tagged{Tag: o.Mode}}
+	}
+	return arg
+}
+
+// thumbnailName returns the name of the cache file of the thumbnail of f.
+func thumbnailName(f *FileMetadata, opts *ThumbnailOptions) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{f.Id, f.Rev, opts.Size, opts.Format, opts.Mode}, "\x00")))
+	return hex.EncodeToString(sum[:]) + thumbnailExt
+}
+
+func (c *ThumbnailCache) get(name string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.index[name]
+	if !ok {
+		return nil, false
+	}
+	path := filepath.Join(c.dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		c.remove(e)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return data, true
+}
+
+func (c *ThumbnailCache) put(name string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.index[name]; ok {
+		c.remove(e)
+	}
+	if err := os.WriteFile(filepath.Join(c.dir, name), data, 0600); err != nil {
+		return
+	}
+	entry := &thumbnailEntry{name, int64(len(data))}
+	c.index[name] = c.lru.PushFront(entry)
+	c.size += entry.size
+	c.evict()
+}
+
+// evict removes the least recently used thumbnails until the cache fits its
+// maximum size. c.mu must be held.
+func (c *ThumbnailCache) evict() {
+	for c.size > c.maxBytes && c.lru.Len() > 0 {
+		e := c.lru.Back()
+		_ = os.Remove(filepath.Join(c.dir, e.Value.(*thumbnailEntry).name))
+		c.remove(e)
+	}
+}
+
+// remove drops e from the index. c.mu must be held.
+func (c *ThumbnailCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*thumbnailEntry)
+	delete(c.index, entry.name)
+	c.size -= entry.size
+}
@@ -0,0 +1,124 @@
+package files_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestThumbnailCache(t *testing.T) {
+	var fetched []string
+	client := &files.Mock{
+		GetThumbnailBatchFunc: func(ctx context.Context, arg *files.GetThumbnailBatchArg) (*files.GetThumbnailBatchResult, error) {
+			res := &files.GetThumbnailBatchResult{}
+			for _, e := range arg.Entries {
+				fetched = append(fetched, e.Path)
+				if e.Path == "rev:broken" {
+					res.Entries = append(res.Entries, &files.GetThumbnailBatchResultEntry{
+						Tagged:  dropbox.Tagged{Tag: files.GetThumbnailBatchResultEntryFailure},
+						Failure: &files.ThumbnailError{Tagged: dropbox.Tagged{Tag: files.ThumbnailErrorUnsupportedImage}},
+					})
+					continue
+				}
+				// The data tells the options the thumbnail was fetched with.
+				data := fmt.Sprintf("%s|%s|%s", e.Path, e.Size.Tag, e.Format.Tag)
+				res.Entries = append(res.Entries, &files.GetThumbnailBatchResultEntry{
+					Tagged:  dropbox.Tagged{Tag: files.GetThumbnailBatchResultEntrySuccess},
+					Success: &files.GetThumbnailBatchResultData{Thumbnail: base64.StdEncoding.EncodeToString([]byte(data))},
+				})
+			}
+			return res, nil
+		},
+	}
+	file := func(id string, rev string) *files.FileMetadata {
+		return &files.FileMetadata{Id: id, Rev: rev}
+	}
+	a, b := file("id:a", "ra"), file("id:b", "rb")
+	small := &files.ThumbnailOptions{Size: files.ThumbnailSizeW64h64, Format: files.ThumbnailFormatJpeg}
+	large := &files.ThumbnailOptions{Size: files.ThumbnailSizeW128h128, Format: files.ThumbnailFormatJpeg}
+	png := &files.ThumbnailOptions{Size: files.ThumbnailSizeW64h64, Format: files.ThumbnailFormatPng}
+
+	dir := t.TempDir()
+	// Room for three thumbnails of about 18 bytes.
+	cache, err := files.NewThumbnailCache(client, dir, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(f *files.FileMetadata, opts *files.ThumbnailOptions) *files.Thumbnail {
+		res, err := cache.GetThumbnails(context.Background(), []*files.FileMetadata{f}, opts)
+		if err != nil || len(res) != 1 {
+			t.Fatalf("Unexpected result: %v %v\n", res, err)
+		}
+		return res[0]
+	}
+	check := func(step string, want []string) {
+		if !reflect.DeepEqual(fetched, want) {
+			t.Errorf("Unexpected thumbnails fetched %s: %v\n", step, fetched)
+		}
+		fetched = nil
+	}
+
+	// Misses are fetched in a single batch, and hits served from the cache.
+	res, err := cache.GetThumbnails(context.Background(), []*files.FileMetadata{a, b}, small)
+	if err != nil || len(res) != 2 || res[0].Cached || string(res[1].Data) != "rev:rb|w64h64|jpeg" {
+		t.Fatalf("Unexpected result: %+v %v\n", res, err)
+	}
+	check("on the first request", []string{"rev:ra", "rev:rb"})
+	res, err = cache.GetThumbnails(context.Background(), []*files.FileMetadata{a, b}, small)
+	if err != nil || !res[0].Cached || !res[1].Cached || string(res[0].Data) != "rev:ra|w64h64|jpeg" {
+		t.Fatalf("Unexpected result: %+v %v\n", res, err)
+	}
+	check("on a hit", nil)
+
+	// Thumbnails are keyed by size, format and revision.
+	if th := get(a, large); th.Cached || string(th.Data) != "rev:ra|w128h128|jpeg" {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	if th := get(a, png); th.Cached || string(th.Data) != "rev:ra|w64h64|png" {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	check("for other options", []string{"rev:ra", "rev:ra"})
+	if th := get(a, large); !th.Cached || string(th.Data) != "rev:ra|w128h128|jpeg" {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	check("on a hit", nil)
+
+	// The least recently used thumbnail, of a in small, was evicted.
+	if th := get(b, small); !th.Cached {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	if th := get(a, small); th.Cached {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	check("after an eviction", []string{"rev:ra"})
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 3 {
+		t.Errorf("Unexpected cache files: %d\n", len(entries))
+	}
+
+	// Failures aren't cached.
+	for i := 0; i < 2; i++ {
+		if th := get(file("id:c", "broken"), small); th.Err == nil || th.Data != nil {
+			t.Errorf("Unexpected thumbnail: %+v\n", th)
+		}
+	}
+	check("for a failure", []string{"rev:broken", "rev:broken"})
+
+	// A new cache reuses the thumbnails of the directory.
+	if cache, err = files.NewThumbnailCache(client, dir, 60); err != nil {
+		t.Fatal(err)
+	}
+	if th := get(a, small); !th.Cached || string(th.Data) != "rev:ra|w64h64|jpeg" {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	if th := get(file("id:a", "ra2"), small); th.Cached {
+		t.Errorf("Unexpected thumbnail: %+v\n", th)
+	}
+	check("with a new cache", []string{"rev:ra2"})
+}
@@ -0,0 +1,65 @@
+package files
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// GetThumbnails fetches the thumbnails of the files at paths, which may
+// also be IDs or "rev:" paths, splitting them into as few
+// `get_thumbnail_batch` calls as possible. It returns the decoded images
+// keyed by path; the paths whose thumbnail couldn't be fetched are keyed in
+// failures instead. If a batch call fails, the thumbnails fetched so far are
+// returned along with the error.
+func GetThumbnails(ctx context.Context, client Client, paths []string, opts *ThumbnailOptions) (images map[string][]byte, failures map[string]error, err error) {
+	if opts == nil {
+		opts = &ThumbnailOptions{}
+	}
+	images = make(map[string][]byte)
+	failures = make(map[string]error)
+	err = fetchThumbnails(ctx, client, paths, opts, func(i int, data []byte, err error) {
+		if err != nil {
+			failures[paths[i]] = err
+		} else {
+			images[paths[i]] = data
+		}
+	})
+	return images, failures, err
+}
+
+// fetchThumbnails fetches the thumbnails of paths in batches of
+// dropbox.MaxThumbnailBatch and calls fn with the index of every path and
+// its decoded thumbnail, or the error fetching it.
+func fetchThumbnails(ctx context.Context, client Client, paths []string, opts *ThumbnailOptions, fn func(i int, data []byte, err error)) error {
+	for start := 0; start < len(paths); start += dropbox.MaxThumbnailBatch {
+		end := start + dropbox.MaxThumbnailBatch
+		if end > len(paths) {
+			end = len(paths)
+		}
+		entries := make([]*ThumbnailArg, 0, end-start)
+		for _, path := range paths[start:end] {
+			entries = append(entries, opts.thumbnailArg(path))
+		}
+		out, err := client.GetThumbnailBatchContext(ctx, NewGetThumbnailBatchArg(entries))
+		if err != nil {
+			return err
+		}
+		for j, entry := range out.Entries {
+			if start+j >= end {
+				break
+			}
+			if entry.Tag != GetThumbnailBatchResultEntrySuccess || entry.Success == nil {
+				fn(start+j, nil, GetThumbnailAPIError{
+					APIError:      dropbox.APIError{ErrorSummary: entry.Tag},
+					EndpointError: entry.Failure,
+				})
+				continue
+			}
+			data, err := base64.StdEncoding.DecodeString(entry.Success.Thumbnail)
+			fn(start+j, data, err)
+		}
+	}
+	return nil
+}
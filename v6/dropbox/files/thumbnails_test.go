@@ -0,0 +1,56 @@
+package files_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestGetThumbnails(t *testing.T) {
+	var batches []int
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg files.GetThumbnailBatchArg
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			batches = append(batches, len(arg.Entries))
+			var entries []string
+			for _, e := range arg.Entries {
+				if e.Path == "/missing" {
+					entries = append(entries, `{".tag": "failure", "failure": {".tag": "path", "path": {".tag": "not_found"}}}`)
+					continue
+				}
+				entries = append(entries, fmt.Sprintf(`{".tag": "success", "metadata": {"name": "a"}, "thumbnail": "%s"}`,
+					base64.StdEncoding.EncodeToString([]byte("img"+e.Path))))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"entries": [%s]}`, strings.Join(entries, ","))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	paths := []string{"/missing"}
+	for i := 0; i < 30; i++ {
+		paths = append(paths, fmt.Sprintf("/%d.jpg", i))
+	}
+	images, failures, err := files.GetThumbnails(context.Background(), files.New(config), paths, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 2 || batches[0] != 25 || batches[1] != 6 {
+		t.Errorf("Unexpected batches: %v\n", batches)
+	}
+	if len(images) != 30 || string(images["/29.jpg"]) != "img/29.jpg" || failures["/missing"] == nil {
+		t.Errorf("Unexpected thumbnails: %d %v\n", len(images), failures)
+	}
+}
@@ -0,0 +1,154 @@
+package files
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// TransferReport summarises a completed upload or download.
+type TransferReport struct {
+	// Bytes is the number of bytes successfully transferred.
+	Bytes int64
+	// Duration is the wall time of the whole transfer.
+	Duration time.Duration
+	// AverageThroughput is the average throughput in bytes per second.
+	AverageThroughput float64
+	// Chunks is the number of chunks the content was split into.
+	Chunks int
+	// ChunkRetries maps the index of every retried chunk to the number of
+	// times it was retried.
+	ChunkRetries map[int]int
+	// ChunksReuploaded is the number of chunks that had to be sent more than
+	// once.
+	ChunksReuploaded int
+}
+
+// Retries returns the total number of chunk retries.
+func (r *TransferReport) Retries() int {
+	n := 0
+	for _, c := range r.ChunkRetries {
+		n += c
+	}
+	return n
+}
+
+// TransferStats is a snapshot of a transfer in progress, as reported to the
+// ProgressFunc of the context of the transfer.
+type TransferStats = dropbox.Progress
+
+// TransferStatsFunc is called with live statistics while a transfer is in
+// progress. It may be called concurrently and must not block.
+type TransferStatsFunc = dropbox.ProgressFunc
+
+// transferRecorder accumulates the statistics of a single transfer. It is
+// safe for concurrent use by the goroutines transferring chunks.
+type transferRecorder struct {
+	mu      sync.Mutex
+	start   time.Time
+	total   int64
+	retries int
+	report  TransferReport
+	onStats TransferStatsFunc
+	// onProgress is the ProgressFunc of the context of the transfer.
+	onProgress dropbox.ProgressFunc
+}
+
+func newTransferRecorder(total int64, onStats TransferStatsFunc) *transferRecorder {
+	return &transferRecorder{
+		start:   time.Now(),
+		total:   total,
+		report:  TransferReport{ChunkRetries: map[int]int{}},
+		onStats: onStats,
+	}
+}
+
+func throughput(n int64, d time.Duration) float64 {
+	if d <= 0 {
+		return 0
+	}
+	return float64(n) / d.Seconds()
+}
+
+// takeProgress moves the ProgressFunc of ctx to r, so that it reports the
+// progress of the whole transfer rather than that of every request, and
+// returns the context to use for the requests.
+func (r *transferRecorder) takeProgress(ctx context.Context) context.Context {
+	r.onProgress = dropbox.ProgressFromContext(ctx)
+	if r.onProgress == nil {
+		return ctx
+	}
+	return dropbox.WithProgress(ctx, nil)
+}
+
+// setTotal records the size of the content, once known.
+func (r *transferRecorder) setTotal(total int64) {
+	r.mu.Lock()
+	r.total = total
+	r.mu.Unlock()
+}
+
+// chunkStarted records that chunk i is part of the transfer.
+func (r *transferRecorder) chunkStarted(i int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if i+1 > r.report.Chunks {
+		r.report.Chunks = i + 1
+	}
+}
+
+// chunkRetried records a retry of chunk i.
+func (r *transferRecorder) chunkRetried(i int) {
+	r.mu.Lock()
+	r.report.ChunkRetries[i]++
+	r.retries++
+	stats := r.statsLocked()
+	r.mu.Unlock()
+	r.notify(stats)
+}
+
+// transferred records n bytes that were successfully transferred.
+func (r *transferRecorder) transferred(n int64) {
+	r.mu.Lock()
+	r.report.Bytes += n
+	stats := r.statsLocked()
+	r.mu.Unlock()
+	r.notify(stats)
+}
+
+func (r *transferRecorder) statsLocked() TransferStats {
+	elapsed := time.Since(r.start)
+	return TransferStats{
+		Bytes:      r.report.Bytes,
+		Total:      r.total,
+		Elapsed:    elapsed,
+		Throughput: throughput(r.report.Bytes, elapsed),
+		Retries:    r.retries,
+	}
+}
+
+func (r *transferRecorder) notify(stats TransferStats) {
+	if r.onStats != nil {
+		r.onStats(stats)
+	}
+	if r.onProgress != nil {
+		r.onProgress(stats)
+	}
+}
+
+// finish returns the final report of the transfer.
+func (r *transferRecorder) finish() *TransferReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := r.report
+	report.ChunkRetries = make(map[int]int, len(r.report.ChunkRetries))
+	for i, n := range r.report.ChunkRetries {
+		report.ChunkRetries[i] = n
+	}
+	report.Duration = time.Since(r.start)
+	report.AverageThroughput = throughput(report.Bytes, report.Duration)
+	report.ChunksReuploaded = len(report.ChunkRetries)
+	return &report
+}
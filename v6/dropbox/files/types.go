@@ -23,6 +23,7 @@
 package files
 
 import (
+	"bytes"
 	"encoding/json"
 	"time"
 
@@ -962,15 +963,10 @@ const (
 
 // UnmarshalJSON deserializes into a metadataUnion instance
 func (u *metadataUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "file":
 		if err = json.Unmarshal(body, &u.File); err != nil {
@@ -1008,7 +1004,33 @@ func IsMetadataFromJSON(data []byte) (IsMetadata, error) {
 		return t.Deleted, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownMetadata{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.Metadata); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownMetadata is a Metadata whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsMetadataFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownMetadata struct {
+	Metadata
+	// Tag is the unknown `.tag` of the Metadata.
+	Tag string
+	// Raw is the JSON the Metadata was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownMetadata) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.Metadata)
 }
 
 // DeletedMetadata : Indicates that there used to be a file or folder at this
@@ -1314,15 +1336,10 @@ const (
 
 // UnmarshalJSON deserializes into a FileLockContent instance
 func (u *FileLockContent) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "single_user":
 		if err = json.Unmarshal(body, &u.SingleUser); err != nil {
@@ -2524,15 +2541,10 @@ const (
 
 // UnmarshalJSON deserializes into a mediaMetadataUnion instance
 func (u *mediaMetadataUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "photo":
 		if err = json.Unmarshal(body, &u.Photo); err != nil {
@@ -2562,7 +2574,33 @@ func IsMediaMetadataFromJSON(data []byte) (IsMediaMetadata, error) {
 		return t.Video, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownMediaMetadata{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.MediaMetadata); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownMediaMetadata is a MediaMetadata whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsMediaMetadataFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownMediaMetadata struct {
+	MediaMetadata
+	// Tag is the unknown `.tag` of the MediaMetadata.
+	Tag string
+	// Raw is the JSON the MediaMetadata was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownMediaMetadata) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.MediaMetadata)
 }
 
 // MetadataV2 : Metadata for a file, folder or other resource types.
@@ -3438,15 +3476,10 @@ const (
 
 // UnmarshalJSON deserializes into a RelocationBatchV2JobStatus instance
 func (u *RelocationBatchV2JobStatus) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "complete":
 		if err = json.Unmarshal(body, &u.Complete); err != nil {
@@ -4336,15 +4369,10 @@ const (
 
 // UnmarshalJSON deserializes into a Tag instance
 func (u *Tag) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "user_generated_tag":
 		if err = json.Unmarshal(body, &u.UserGeneratedTag); err != nil {
@@ -4664,15 +4692,10 @@ const (
 
 // UnmarshalJSON deserializes into a UploadSessionLookupError instance
 func (u *UploadSessionLookupError) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "incorrect_offset":
 		if err = json.Unmarshal(body, &u.IncorrectOffset); err != nil {
@@ -4709,15 +4732,10 @@ const (
 
 // UnmarshalJSON deserializes into a UploadSessionAppendError instance
 func (u *UploadSessionAppendError) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "incorrect_offset":
 		if err = json.Unmarshal(body, &u.IncorrectOffset); err != nil {
@@ -4795,15 +4813,10 @@ const (
 
 // UnmarshalJSON deserializes into a UploadSessionFinishBatchJobStatus instance
 func (u *UploadSessionFinishBatchJobStatus) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "complete":
 		if err = json.Unmarshal(body, &u.Complete); err != nil {
@@ -0,0 +1,135 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// UploadOptions builds the CommitInfo of an upload:
+//
+//	opts := files.NewUploadOptions("/notes.txt").Update(rev).ClientModified(modTime)
+//	res, _, err := files.NewUploader(dbx).UploadWithOptions(ctx, f, size, opts)
+//	if conflict, ok := err.(*files.ConflictError); ok {
+//		...
+//	}
+type UploadOptions struct {
+	commit *CommitInfo
+}
+
+// NewUploadOptions returns UploadOptions uploading to path, in add mode:
+// the upload fails with a ConflictError if a different file exists at path.
+func NewUploadOptions(path string) *UploadOptions {
+	commit := NewCommitInfo(path)
+	commit.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeAdd}}
+	return &UploadOptions{commit: commit}
+}
+
+// Add never overwrites an existing file. This is the default.
+func (o *UploadOptions) Add() *UploadOptions {
+	o.commit.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeAdd}}
+	return o
+}
+
+// Overwrite replaces any existing file.
+func (o *UploadOptions) Overwrite() *UploadOptions {
+	o.commit.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeOverwrite}}
+	return o
+}
+
+// Update replaces the existing file only if its revision is rev, so that
+// concurrent changes aren't lost.
+func (o *UploadOptions) Update(rev string) *UploadOptions {
+	o.commit.Mode = &WriteMode{Tagged: dropbox.Tagged{Tag: WriteModeUpdate}, Update: rev}
+	return o
+}
+
+// Autorename lets Dropbox rename the uploaded file, e.g. to "notes (1).txt",
+// instead of failing with a ConflictError.
+func (o *UploadOptions) Autorename() *UploadOptions {
+	o.commit.Autorename = true
+	return o
+}
+
+// StrictConflict reports a conflict even if the existing file has the same
+// content, which Dropbox otherwise accepts as a no-op.
+func (o *UploadOptions) StrictConflict() *UploadOptions {
+	o.commit.StrictConflict = true
+	return o
+}
+
+// ClientModified sets the modification time shown for the file, rounded to
+// the second as Dropbox expects.
+func (o *UploadOptions) ClientModified(t time.Time) *UploadOptions {
+	t = t.UTC().Truncate(time.Second)
+	o.commit.ClientModified = &t
+	return o
+}
+
+// Mute doesn't notify the user's desktop clients of the upload.
+func (o *UploadOptions) Mute() *UploadOptions {
+	o.commit.Mute = true
+	return o
+}
+
+// CommitInfo returns a copy of the built CommitInfo, for use with the
+// upload routes.
+func (o *UploadOptions) CommitInfo() *CommitInfo {
+	commit := *o.commit
+	return &commit
+}
+
+// ConflictError is returned by UploadWithOptions when the upload conflicts
+// with an existing file or folder.
+type ConflictError struct {
+	Path string
+	// Kind is one of the WriteConflictError tags, e.g. WriteConflictErrorFile.
+	Kind string
+	// Err is the error returned by the route.
+	Err error
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("upload to %s conflicts with an existing %s", e.Path, e.Kind)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// UploadWithOptions uploads like Upload, with the CommitInfo built by opts.
+// Conflicts are returned as a *ConflictError.
+func (u *Uploader) UploadWithOptions(ctx context.Context, r io.Reader, size int64, opts *UploadOptions) (*FileMetadata, *TransferReport, error) {
+	commit := opts.CommitInfo()
+	res, report, err := u.Upload(ctx, r, size, commit)
+	if kind := writeConflict(err); kind != "" {
+		err = &ConflictError{Path: commit.Path, Kind: kind, Err: err}
+	}
+	return res, report, err
+}
+
+// writeConflict returns the kind of the write conflict reported by err, or
+// "" if err isn't a conflict.
+func writeConflict(err error) string {
+	var reason *WriteError
+	switch e := err.(type) {
+	case UploadAPIError:
+		if e.EndpointError != nil && e.EndpointError.Path != nil {
+			reason = e.EndpointError.Path.Reason
+		}
+	case UploadSessionFinishAPIError:
+		if e.EndpointError != nil {
+			reason = e.EndpointError.Path
+		}
+	}
+	if reason == nil || reason.Tag != WriteErrorConflict {
+		return ""
+	}
+	if reason.Conflict == nil {
+		return WriteConflictErrorOther
+	}
+	return reason.Conflict.Tag
+}
@@ -0,0 +1,50 @@
+package files_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestUploadWithOptions(t *testing.T) {
+	var commit files.CommitInfo
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.ReadAll(r.Body)
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &commit)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_summary": "path/conflict/file/", "error": {".tag": "path",
+				"reason": {".tag": "conflict", "conflict": {".tag": "file"}}, "upload_session_id": "s"}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	modified := time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)
+	opts := files.NewUploadOptions("/notes.txt").Update("0123456789a").ClientModified(modified).Mute()
+	_, _, err := files.NewUploader(files.New(config)).UploadWithOptions(context.Background(), strings.NewReader("notes"), 5, opts)
+	var conflict *files.ConflictError
+	if !errors.As(err, &conflict) || conflict.Kind != files.WriteConflictErrorFile || conflict.Path != "/notes.txt" {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	var apiErr files.UploadAPIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("Route error not wrapped: %v\n", err)
+	}
+	if commit.Mode.Tag != files.WriteModeUpdate || commit.Mode.Update != "0123456789a" || !commit.Mute ||
+		!commit.ClientModified.Equal(modified.Truncate(time.Second)) {
+		t.Errorf("Unexpected commit: %+v %+v\n", commit, commit.Mode)
+	}
+}
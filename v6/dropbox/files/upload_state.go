@@ -0,0 +1,123 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+// UploadState is the state of an upload session, as passed to
+// Uploader.OnCheckpoint. It can be serialized, e.g. to JSON, and passed to
+// Uploader.Resume by another process to finish an interrupted upload.
+// Dropbox keeps upload sessions for 7 days.
+type UploadState struct {
+	// SessionID is the ID of the upload session.
+	SessionID string `json:"session_id"`
+	// Size is the size of the content.
+	Size int64 `json:"size"`
+	// ChunkSize is the size of the chunks of the session.
+	ChunkSize int64 `json:"chunk_size"`
+	// Offset is the committed offset: all content before it was appended.
+	Offset int64 `json:"offset"`
+	// Appended lists the offsets of the chunks past Offset that were
+	// appended, as chunks complete out of order.
+	Appended []int64 `json:"appended,omitempty"`
+}
+
+// Done reports whether all the content was appended, in which case only
+// the session remains to be finished.
+func (s UploadState) Done() bool {
+	return s.Offset >= s.Size
+}
+
+func (s UploadState) appended(offset int64) bool {
+	for _, o := range s.Appended {
+		if o == offset {
+			return true
+		}
+	}
+	return false
+}
+
+// errInvalidUploadState is returned by Resume for states that weren't
+// produced by an Uploader.
+var errInvalidUploadState = errors.New("invalid upload state")
+
+// sessionProgress tracks the state of an upload session as chunks complete.
+// It is safe for concurrent use.
+type sessionProgress struct {
+	mu           sync.Mutex
+	state        UploadState
+	onCheckpoint func(state UploadState)
+}
+
+func newSessionProgress(state UploadState, onCheckpoint func(state UploadState)) *sessionProgress {
+	p := &sessionProgress{state: state, onCheckpoint: onCheckpoint}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.checkpointLocked()
+	return p
+}
+
+func (p *sessionProgress) snapshot() UploadState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.copyLocked()
+}
+
+func (p *sessionProgress) copyLocked() UploadState {
+	state := p.state
+	state.Appended = append([]int64(nil), p.state.Appended...)
+	return state
+}
+
+// appended records that the n bytes at offset were appended, advancing the
+// committed offset past the chunks that are now contiguous.
+func (p *sessionProgress) appended(offset int64, n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if offset == p.state.Offset {
+		p.state.Offset += n
+	} else {
+		p.state.Appended = append(p.state.Appended, offset)
+		sort.Slice(p.state.Appended, func(i, j int) bool { return p.state.Appended[i] < p.state.Appended[j] })
+	}
+	for len(p.state.Appended) > 0 && p.state.Appended[0] == p.state.Offset {
+		p.state.Offset += p.state.ChunkSize
+		if p.state.Offset > p.state.Size {
+			p.state.Offset = p.state.Size
+		}
+		p.state.Appended = p.state.Appended[1:]
+	}
+	p.checkpointLocked()
+}
+
+func (p *sessionProgress) checkpointLocked() {
+	if p.onCheckpoint != nil {
+		p.onCheckpoint(p.copyLocked())
+	}
+}
+
+// Resume finishes the upload session described by state, saved from
+// OnCheckpoint, to commit.Path. The content is read from r, which holds the
+// whole content as originally uploaded: Resume seeks to the committed offset
+// and only uploads the chunks that weren't appended. The returned report
+// covers the chunks uploaded by Resume only.
+func (u *Uploader) Resume(ctx context.Context, r io.ReadSeeker, state UploadState, commit *CommitInfo) (*FileMetadata, *TransferReport, error) {
+	if state.SessionID == "" || state.Size < 0 || state.ChunkSize <= 0 || state.Offset < 0 || state.Offset > state.Size {
+		return nil, nil, errInvalidUploadState
+	}
+	rec := newTransferRecorder(state.Size, u.OnStats)
+	ctx = rec.takeProgress(ctx)
+	if _, err := r.Seek(state.Offset, io.SeekStart); err != nil {
+		return nil, rec.finish(), err
+	}
+	p := newSessionProgress(state, u.OnCheckpoint)
+	if err := u.appendChunks(ctx, r, p, rec); err != nil {
+		return nil, rec.finish(), err
+	}
+	res, err := u.finishSession(ctx, state.SessionID, state.Size, commit)
+	return res, rec.finish(), err
+}
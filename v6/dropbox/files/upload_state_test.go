@@ -0,0 +1,97 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestUploaderResume(t *testing.T) {
+	const size = 10<<20 + 1
+	content := bytes.Repeat([]byte("0123456789abcdef"), size/16+1)[:size]
+	var mu sync.Mutex
+	received := make([]byte, size)
+	var offsets []uint64
+	failing := true
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/upload_session/start":
+				_, _ = w.Write([]byte(`{"session_id": "s1"}`))
+			case "/files/upload_session/append_v2":
+				var arg files.UploadSessionAppendArg
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+				if arg.Cursor.Offset == 4<<20 && failing {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				offsets = append(offsets, arg.Cursor.Offset)
+				copy(received[arg.Cursor.Offset:], body)
+				_, _ = w.Write([]byte(`null`))
+			case "/files/upload_session/finish":
+				_, _ = w.Write([]byte(`{"name": "a", "id": "id:a", "rev": "0123456789a", "size": 10485761}`))
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	var saved []byte
+	u := files.NewUploader(files.New(config))
+	u.ChunkSize = 4 << 20
+	u.SimpleUploadThreshold = 1 << 20
+	u.Concurrency = 1
+	u.MaxRetries = -1
+	u.OnCheckpoint = func(state files.UploadState) {
+		saved, _ = json.Marshal(state)
+	}
+	if _, _, err := u.Upload(context.Background(), bytes.NewReader(content), size, files.NewCommitInfo("/a")); err == nil {
+		t.Fatal("Upload did not fail")
+	}
+
+	var state files.UploadState
+	if err := json.Unmarshal(saved, &state); err != nil {
+		t.Fatal(err)
+	}
+	if state.SessionID != "s1" || state.Offset != 4<<20 || state.Done() {
+		t.Fatalf("Unexpected state: %+v\n", state)
+	}
+	// Pretend the last chunk was appended before the interruption.
+	copy(received[8<<20:], content[8<<20:])
+	state.Appended = []int64{8 << 20}
+	mu.Lock()
+	failing, offsets = false, nil
+	mu.Unlock()
+
+	u = files.NewUploader(files.New(config))
+	u.OnCheckpoint = func(s files.UploadState) { state = s }
+	res, report, err := u.Resume(context.Background(), bytes.NewReader(content), state, files.NewCommitInfo("/a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Name != "a" || !state.Done() || len(state.Appended) != 0 {
+		t.Errorf("Unexpected result: %v %+v\n", res.Name, state)
+	}
+	if len(offsets) != 1 || offsets[0] != 4<<20 || report.Bytes != 4<<20 {
+		t.Errorf("Unexpected chunks: %v %+v\n", offsets, report)
+	}
+	if !bytes.Equal(received, content) {
+		t.Error("Uploaded content differs")
+	}
+}
@@ -0,0 +1,314 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/contenthash"
+)
+
+// Defaults of an Uploader
+const (
+	// DefaultUploadChunkSize is the default size of the chunks of an upload
+	// session.
+	DefaultUploadChunkSize int64 = 16 << 20
+	// DefaultSimpleUploadThreshold is the size up to which content is
+	// uploaded with a single `upload` request by default.
+	DefaultSimpleUploadThreshold int64 = 8 << 20
+)
+
+// Uploader uploads content of any size, with a single `upload` request for
+// small content and an upload session otherwise. The chunks of a session are
+// uploaded concurrently and retried if they fail, and Dropbox checks the
+// content hash of every request. An Uploader may be used for several
+// uploads, concurrently, once configured. A dropbox.ProgressFunc set on the
+// context is called with the progress of the whole upload.
+//
+//	u := files.NewUploader(dbx)
+//	u.Concurrency = 8
+//	res, report, err := u.Upload(ctx, f, size, files.NewCommitInfo("/backup.tar"))
+type Uploader struct {
+	client Client
+
+	// ChunkSize is the size of the chunks of an upload session. It is
+	// rounded up to a multiple of dropbox.UploadChunkAlignment and capped at
+	// dropbox.MaxUploadChunkSize. Defaults to DefaultUploadChunkSize.
+	ChunkSize int64
+	// Concurrency is the number of chunks uploaded in parallel, each held in
+	// memory while in flight. Defaults to 4.
+	Concurrency int
+	// SimpleUploadThreshold is the size up to which content is uploaded with
+	// a single request. Defaults to DefaultSimpleUploadThreshold.
+	SimpleUploadThreshold int64
+	// MaxRetries is the number of times a failed chunk is retried. Defaults
+	// to 3; a negative value disables retries.
+	MaxRetries int
+	// RetryPolicy spaces the retries of failed chunks, whose number is set
+	// by MaxRetries rather than MaxAttempts. Defaults to exponential delays
+	// from 500ms with full jitter.
+	RetryPolicy *dropbox.RetryPolicy
+	// OnStats, if set, is called with live statistics.
+	OnStats TransferStatsFunc
+	// OnCheckpoint, if set, is called with the state of an upload session
+	// once it is started and after every chunk, so that it can be saved and
+	// the upload resumed with Resume if interrupted. Calls are serialized.
+	OnCheckpoint func(state UploadState)
+}
+
+// NewUploader returns an Uploader with default settings.
+func NewUploader(client Client) *Uploader {
+	return &Uploader{client: client}
+}
+
+// errNegativeSize is returned for uploads of unknown size.
+var errNegativeSize = errors.New("upload size must not be negative")
+
+func (u *Uploader) chunkSize() int64 {
+	size := u.ChunkSize
+	if size <= 0 {
+		size = DefaultUploadChunkSize
+	}
+	if rem := size % dropbox.UploadChunkAlignment; rem != 0 {
+		size += dropbox.UploadChunkAlignment - rem
+	}
+	if size > dropbox.MaxUploadChunkSize {
+		size = dropbox.MaxUploadChunkSize - dropbox.MaxUploadChunkSize%dropbox.UploadChunkAlignment
+	}
+	return size
+}
+
+func (u *Uploader) concurrency() int {
+	if u.Concurrency <= 0 {
+		return 4
+	}
+	return u.Concurrency
+}
+
+func (u *Uploader) maxRetries() int {
+	switch {
+	case u.MaxRetries < 0:
+		return 0
+	case u.MaxRetries == 0:
+		return 3
+	}
+	return u.MaxRetries
+}
+
+// Upload uploads size bytes read from r to commit.Path. It returns the
+// metadata of the uploaded file along with a report of the transfer, which
+// is also returned, covering the chunks uploaded so far, on failure.
+func (u *Uploader) Upload(ctx context.Context, r io.Reader, size int64, commit *CommitInfo) (*FileMetadata, *TransferReport, error) {
+	if size < 0 {
+		return nil, nil, errNegativeSize
+	}
+	rec := newTransferRecorder(size, u.OnStats)
+	ctx = rec.takeProgress(ctx)
+	threshold := u.SimpleUploadThreshold
+	if threshold <= 0 {
+		threshold = DefaultSimpleUploadThreshold
+	}
+	if threshold > dropbox.MaxUploadSize {
+		threshold = dropbox.MaxUploadSize
+	}
+
+	var res *FileMetadata
+	var err error
+	if size <= threshold {
+		res, err = u.uploadSimple(ctx, r, size, commit, rec)
+	} else {
+		res, err = u.uploadSession(ctx, r, size, commit, rec)
+	}
+	return res, rec.finish(), err
+}
+
+func (u *Uploader) uploadSimple(ctx context.Context, r io.Reader, size int64, commit *CommitInfo, rec *transferRecorder) (*FileMetadata, error) {
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	rec.chunkStarted(0)
+	var res *FileMetadata
+	err := u.retry(ctx, 0, rec, func() (err error) {
+		res, err = u.client.UploadContext(ctx, &UploadArg{CommitInfo: *commit, ContentHash: sumContent(buf)}, bytes.NewReader(buf))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rec.transferred(size)
+	return res, nil
+}
+
+func (u *Uploader) uploadSession(ctx context.Context, r io.Reader, size int64, commit *CommitInfo, rec *transferRecorder) (*FileMetadata, error) {
+	start := NewUploadSessionStartArg()
+	start.SessionType = &UploadSessionType{Tagged: dropbox.Tagged{Tag: UploadSessionTypeConcurrent}}
+	session, err := u.client.UploadSessionStartContext(ctx, start, bytes.NewReader(nil))
+	if err != nil {
+		return nil, err
+	}
+	p := newSessionProgress(UploadState{
+		SessionID: session.SessionId,
+		Size:      size,
+		ChunkSize: u.chunkSize(),
+	}, u.OnCheckpoint)
+	if err = u.appendChunks(ctx, r, p, rec); err != nil {
+		return nil, err
+	}
+	return u.finishSession(ctx, session.SessionId, size, commit)
+}
+
+// appendChunks uploads the chunks of the session tracked by p that weren't
+// appended yet. r must be positioned at the committed offset of p; chunks
+// already appended past it are skipped.
+func (u *Uploader) appendChunks(ctx context.Context, r io.Reader, p *sessionProgress, rec *transferRecorder) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	state := p.snapshot()
+	sessionID, size, chunkSize := state.SessionID, state.Size, state.ChunkSize
+	sem := make(chan struct{}, u.concurrency())
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+
+	for i, offset := int(state.Offset/chunkSize), state.Offset; offset < size; i, offset = i+1, offset+chunkSize {
+		n := chunkSize
+		if size-offset < n {
+			n = size - offset
+		}
+		if state.appended(offset) {
+			if _, err := io.CopyN(io.Discard, r, n); err != nil {
+				fail(err)
+				break
+			}
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			<-sem
+			fail(err)
+			break
+		}
+
+		rec.chunkStarted(i)
+		wg.Add(1)
+		go func(i int, offset int64, buf []byte) {
+			defer func() { <-sem; wg.Done() }()
+			arg := NewUploadSessionAppendArg(NewUploadSessionCursor(sessionID, uint64(offset)))
+			arg.Close = offset+int64(len(buf)) == size
+			arg.ContentHash = sumContent(buf)
+			err := u.retry(ctx, i, rec, func() error {
+				return u.client.UploadSessionAppendV2Context(ctx, arg, bytes.NewReader(buf))
+			})
+			if err != nil {
+				fail(err)
+				return
+			}
+			rec.transferred(int64(len(buf)))
+			p.appended(offset, int64(len(buf)))
+		}(i, offset, buf)
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if firstErr == nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+func (u *Uploader) finishSession(ctx context.Context, sessionID string, size int64, commit *CommitInfo) (*FileMetadata, error) {
+	var res *FileMetadata
+	arg := NewUploadSessionFinishArg(NewUploadSessionCursor(sessionID, uint64(size)), commit)
+	err := u.retry(ctx, -1, nil, func() (err error) {
+		res, err = u.client.UploadSessionFinishContext(ctx, arg, bytes.NewReader(nil))
+		return err
+	})
+	return res, err
+}
+
+// retry calls fn until it succeeds, fails with an error that isn't worth
+// retrying, or has been retried u.MaxRetries times, waiting between attempts
+// as u.RetryPolicy says. Retries of chunk i are recorded in rec, if set.
+func (u *Uploader) retry(ctx context.Context, i int, rec *transferRecorder, fn func() error) error {
+	err := fn()
+	for n := 0; err != nil && n < u.maxRetries() && retryableChunkError(err) && ctx.Err() == nil; n++ {
+		if !waitRetry(ctx, u.RetryPolicy, n+1, err) {
+			return err
+		}
+		if rec != nil {
+			rec.chunkRetried(i)
+		}
+		err = fn()
+	}
+	return err
+}
+
+// sumContent returns the content hash of buf, sent along with buf so that
+// Dropbox rejects content corrupted in transit.
+func sumContent(buf []byte) string {
+	h := contenthash.New()
+	h.Write(buf)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// defaultRetryPolicy spaces the retries of the transfers without a
+// RetryPolicy.
+var defaultRetryPolicy = &dropbox.RetryPolicy{Jitter: dropbox.JitterFull}
+
+// waitRetry waits before the attempt-th retry of a request that failed with
+// err, for the delay of policy or, if longer, the delay Dropbox asked for.
+// It returns false if ctx is done first.
+func waitRetry(ctx context.Context, policy *dropbox.RetryPolicy, attempt int, err error) bool {
+	if policy == nil {
+		policy = defaultRetryPolicy
+	}
+	d := policy.Delay(attempt, 0)
+	if after := dropbox.RetryAfter(err); after > d {
+		d = after
+	}
+	var uploadErr TemporaryUploadError
+	if errors.As(err, &uploadErr) && uploadErr.RetryAfter > d {
+		d = uploadErr.RetryAfter
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryableChunkError reports whether err is a transient failure worth
+// retrying, see dropbox.IsRetryable. Other errors, including those the SDK
+// doesn't know, aren't retried.
+func retryableChunkError(err error) bool {
+	if _, ok := err.(PermanentError); ok {
+		return false
+	}
+	return dropbox.IsRetryable(err)
+}
@@ -0,0 +1,145 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestUploaderSession(t *testing.T) {
+	const size = 10<<20 + 1
+	content := bytes.Repeat([]byte("0123456789abcdef"), size/16+1)[:size]
+	var mu sync.Mutex
+	received := make([]byte, size)
+	failed := false
+	var finishArg files.UploadSessionFinishArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/upload_session/start":
+				_, _ = w.Write([]byte(`{"session_id": "s1"}`))
+			case "/files/upload_session/append_v2":
+				var arg files.UploadSessionAppendArg
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+				if arg.Cursor.Offset == 4<<20 && !failed {
+					failed = true
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				copy(received[arg.Cursor.Offset:], body)
+				_, _ = w.Write([]byte(`null`))
+			case "/files/upload_session/finish":
+				_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &finishArg)
+				_, _ = w.Write([]byte(`{"name": "a", "id": "id:a", "rev": "0123456789a", "size": 10485761}`))
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	// The statistics, with the retries, are reported to both OnStats and the
+	// ProgressFunc of the context.
+	var statsRetries, progressRetries int
+	u := files.NewUploader(files.New(config))
+	u.ChunkSize = 4 << 20
+	u.SimpleUploadThreshold = 1 << 20
+	u.OnStats = func(stats files.TransferStats) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stats.Retries > statsRetries {
+			statsRetries = stats.Retries
+		}
+	}
+	ctx := dropbox.WithProgress(context.Background(), func(p dropbox.Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		if p.Retries > progressRetries {
+			progressRetries = p.Retries
+		}
+	})
+	res, report, err := u.Upload(ctx, bytes.NewReader(content), size, files.NewCommitInfo("/a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statsRetries != 1 || progressRetries != 1 {
+		t.Errorf("Unexpected retries: %d %d\n", statsRetries, progressRetries)
+	}
+	if res.Name != "a" || finishArg.Cursor.Offset != size || finishArg.Commit.Path != "/a" {
+		t.Errorf("Unexpected result: %v %+v\n", res.Name, finishArg.Cursor)
+	}
+	if !bytes.Equal(received, content) {
+		t.Error("Uploaded content differs")
+	}
+	if report.Bytes != size || report.Chunks != 3 || report.Retries() != 1 || report.ChunkRetries[1] != 1 {
+		t.Errorf("Unexpected report: %+v\n", report)
+	}
+}
+
+func TestUploaderRetry(t *testing.T) {
+	calls := 0
+	var failure error
+	dbx := &files.Mock{
+		UploadFunc: func(ctx context.Context, arg *files.UploadArg, content io.Reader) (*files.FileMetadata, error) {
+			calls++
+			if calls <= 2 {
+				return nil, failure
+			}
+			return &files.FileMetadata{Metadata: files.Metadata{Name: "a"}}, nil
+		},
+		DownloadFunc: func(ctx context.Context, arg *files.DownloadArg) (*files.FileMetadata, io.ReadCloser, error) {
+			calls++
+			return nil, nil, failure
+		},
+	}
+	u := files.NewUploader(dbx)
+	u.RetryPolicy = &dropbox.RetryPolicy{BaseDelay: 20 * time.Millisecond}
+
+	// Errors the SDK doesn't know aren't retried.
+	failure = errors.New("unknown failure")
+	if _, _, err := u.Upload(context.Background(), strings.NewReader("a"), 1, files.NewCommitInfo("/a")); err != failure || calls != 1 {
+		t.Errorf("Unexpected result: %v after %d calls\n", err, calls)
+	}
+	calls = 0
+	f, err := os.Create(filepath.Join(t.TempDir(), "a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	d := files.NewDownloader(dbx)
+	if _, _, err := d.Download(context.Background(), "/a", f); err != failure || calls != 1 {
+		t.Errorf("Unexpected result: %v after %d calls\n", err, calls)
+	}
+
+	// Server errors are retried after the delays of the policy.
+	calls = 0
+	failure = auth.ServerError{StatusCode: http.StatusServiceUnavailable}
+	start := time.Now()
+	if _, _, err := u.Upload(context.Background(), strings.NewReader("a"), 1, files.NewCommitInfo("/a")); err != nil || calls != 3 {
+		t.Errorf("Unexpected result: %v after %d calls\n", err, calls)
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("Unexpected delay between retries: %v\n", elapsed)
+	}
+}
@@ -0,0 +1,167 @@
+package files
+
+import (
+	"context"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// WalkFunc is called by Walk for every entry. If it returns fs.SkipDir for
+// a folder, the content of the folder is skipped; any other error stops the
+// walk and is returned by Walk.
+type WalkFunc func(entry IsMetadata) error
+
+// WalkOptions controls a Walk.
+type WalkOptions struct {
+	// Concurrency is the number of calls to the WalkFunc made in parallel,
+	// in which case it must be safe for concurrent use. Defaults to 1.
+	Concurrency int
+	// IncludeDeleted also reports the entries of deleted files and folders.
+	IncludeDeleted bool
+	// Cursor, if set, resumes a previous walk from the cursor last passed to
+	// OnCursor. Folders skipped before that cursor are not remembered.
+	Cursor string
+	// OnCursor, if set, is called with the cursor of every page once all its
+	// entries were walked, so that an interrupted walk can be resumed.
+	OnCursor func(cursor string)
+}
+
+// Walk calls fn for every entry under path, "" for the whole account, using
+// a recursive `list_folder`. Entries are walked one listing page at a time.
+// Within a page, the callbacks of all folders complete before those of
+// their descendants, so that a folder can be skipped even when fn runs
+// concurrently.
+func Walk(ctx context.Context, client Client, path string, fn WalkFunc, opts *WalkOptions) error {
+	if opts == nil {
+		opts = &WalkOptions{}
+	}
+	w := &walker{fn: fn, workers: opts.Concurrency, skipped: map[string]bool{}}
+	if w.workers <= 0 {
+		w.workers = 1
+	}
+
+	arg := NewListFolderArg(path)
+	arg.Recursive = true
+	arg.IncludeDeleted = opts.IncludeDeleted
+	cursor := opts.Cursor
+	for {
+		var res *ListFolderResult
+		var err error
+		if cursor == "" {
+			res, err = client.ListFolderContext(ctx, arg)
+		} else {
+			res, err = client.ListFolderContinueContext(ctx, NewListFolderContinueArg(cursor))
+		}
+		if err != nil {
+			return err
+		}
+		if err = w.page(ctx, res.Entries); err != nil {
+			return err
+		}
+		cursor = res.Cursor
+		if opts.OnCursor != nil {
+			opts.OnCursor(cursor)
+		}
+		if !res.HasMore {
+			return nil
+		}
+	}
+}
+
+// walker holds the state of a Walk.
+type walker struct {
+	fn      WalkFunc
+	workers int
+	// skipped holds the lower-cased paths of the skipped folders.
+	skipped map[string]bool
+}
+
+// isSkipped reports whether path is inside a skipped folder.
+func (w *walker) isSkipped(path string) bool {
+	for p := path; p != ""; {
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			return false
+		}
+		p = p[:i]
+		if w.skipped[p] {
+			return true
+		}
+	}
+	return false
+}
+
+// page walks the entries of a page: folders first, shallowest first, then
+// the other entries.
+func (w *walker) page(ctx context.Context, entries []IsMetadata) error {
+	levels := map[int][]IsMetadata{}
+	var depths []int
+	var others []IsMetadata
+	for _, e := range entries {
+		if f, ok := e.(*FolderMetadata); ok {
+			d := strings.Count(f.PathLower, "/")
+			if levels[d] == nil {
+				depths = append(depths, d)
+			}
+			levels[d] = append(levels[d], e)
+		} else {
+			others = append(others, e)
+		}
+	}
+	sort.Ints(depths)
+	for _, d := range depths {
+		if err := w.run(ctx, levels[d]); err != nil {
+			return err
+		}
+	}
+	return w.run(ctx, others)
+}
+
+// run calls fn for the entries not inside skipped folders, with up to
+// w.workers calls in flight, and records the folders to skip.
+func (w *walker) run(ctx context.Context, entries []IsMetadata) error {
+	sem := make(chan struct{}, w.workers)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	var skip []string
+	for _, e := range entries {
+		path := metadataPathLower(e)
+		if w.isSkipped(path) {
+			continue
+		}
+		sem <- struct{}{}
+		mu.Lock()
+		stop := firstErr != nil
+		mu.Unlock()
+		if stop || ctx.Err() != nil {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(e IsMetadata, path string) {
+			defer func() { <-sem; wg.Done() }()
+			err := w.fn(e)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == fs.SkipDir:
+				if _, ok := e.(*FolderMetadata); ok {
+					skip = append(skip, path)
+				}
+			case err != nil && firstErr == nil:
+				firstErr = err
+			}
+		}(e, path)
+	}
+	wg.Wait()
+	for _, p := range skip {
+		w.skipped[p] = true
+	}
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
@@ -0,0 +1,82 @@
+package files_test
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestWalk(t *testing.T) {
+	pages := map[string]string{
+		"/files/list_folder": `{"entries": [
+			{".tag": "folder", "name": "b", "path_lower": "/a/b"},
+			{".tag": "folder", "name": "a", "path_lower": "/a"},
+			{".tag": "file", "name": "c.txt", "path_lower": "/a/b/c.txt"},
+			{".tag": "file", "name": "d.txt", "path_lower": "/d.txt"}
+		], "cursor": "c1", "has_more": true}`,
+		"/files/list_folder/continue": `{"entries": [
+			{".tag": "file", "name": "e.txt", "path_lower": "/a/e.txt"},
+			{".tag": "folder", "name": "f", "path_lower": "/f"},
+			{".tag": "file", "name": "g.txt", "path_lower": "/f/g.txt"}
+		], "cursor": "c2", "has_more": false}`,
+	}
+	var routes []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			routes = append(routes, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(pages[r.URL.Path]))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	var mu sync.Mutex
+	var walked, cursors []string
+	fn := func(entry files.IsMetadata) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch e := entry.(type) {
+		case *files.FolderMetadata:
+			walked = append(walked, e.PathLower)
+			if e.PathLower == "/a" {
+				return fs.SkipDir
+			}
+		case *files.FileMetadata:
+			walked = append(walked, e.PathLower)
+		}
+		return nil
+	}
+	opts := &files.WalkOptions{
+		Concurrency: 4,
+		OnCursor:    func(cursor string) { cursors = append(cursors, cursor) },
+	}
+	if err := files.Walk(context.Background(), client, "", fn, opts); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(walked)
+	if strings.Join(walked, ",") != "/a,/d.txt,/f,/f/g.txt" || strings.Join(cursors, ",") != "c1,c2" {
+		t.Errorf("Unexpected walk: %v %v\n", walked, cursors)
+	}
+
+	routes, walked = nil, nil
+	opts.Cursor = "c1"
+	if err := files.Walk(context.Background(), client, "", fn, opts); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(walked)
+	if strings.Join(routes, ",") != "/files/list_folder/continue" || strings.Join(walked, ",") != "/a/e.txt,/f,/f/g.txt" {
+		t.Errorf("Unexpected resumed walk: %v %v\n", routes, walked)
+	}
+}
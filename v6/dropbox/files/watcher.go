@@ -0,0 +1,262 @@
+package files
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+)
+
+// EventType is the type of an Event.
+type EventType int
+
+// Types of Event
+const (
+	// EventAdded reports a file or folder that was created.
+	EventAdded EventType = iota + 1
+	// EventModified reports a file that was modified.
+	EventModified
+	// EventDeleted reports a file or folder that was deleted. The entries
+	// of the content of a deleted folder aren't reported.
+	EventDeleted
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventAdded:
+		return "added"
+	case EventModified:
+		return "modified"
+	case EventDeleted:
+		return "deleted"
+	}
+	return "unknown"
+}
+
+// Event is a change reported by a Watcher.
+type Event struct {
+	Type EventType
+	// Path is the display path of the entry.
+	Path string
+	// Metadata is the entry as returned by `list_folder/continue`: a
+	// *DeletedMetadata for EventDeleted. Entries found deleted after the
+	// cursor was reset only have their lower-cased path.
+	Metadata IsMetadata
+}
+
+// Watcher follows the changes under a folder with `list_folder/longpoll`
+// and delivers them as events on a channel. Errors are not retried, beyond
+// the retries of the Config.RetryPolicy of the client.
+//
+//	w := files.NewWatcher(dbx, "/Photos")
+//	events, err := w.Start(ctx)
+//	...
+//	for e := range events {
+//		fmt.Println(e.Type, e.Path)
+//	}
+//	err = w.Err()
+//
+// Watcher is experimental and requires experimental.Watcher to be enabled.
+type Watcher struct {
+	client Client
+	path   string
+	// listExisting lists the existing entries on start, to tell
+	// EventAdded from EventModified. Without it, changes to files and
+	// folders are all reported as EventModified.
+	listExisting bool
+	// onReset, if set, is called when the cursor was reset and the listing
+	// started over.
+	onReset func()
+
+	// known holds the lower-cased paths of the existing entries.
+	known  map[string]bool
+	cursor string
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewWatcher returns a Watcher for the changes under path, "" for the whole
+// account.
+func NewWatcher(client Client, path string) *Watcher {
+	return &Watcher{client: client, path: path, listExisting: true}
+}
+
+// Start lists the existing entries under the path of w and starts watching
+// for changes. The returned channel is closed once ctx is done or watching
+// fails, after which Err reports why. Start must be called only once.
+func (w *Watcher) Start(ctx context.Context) (<-chan Event, error) {
+	if err := CheckExperimental(w.client, experimental.Watcher); err != nil {
+		return nil, err
+	}
+	if err := w.list(ctx, nil); err != nil {
+		return nil, err
+	}
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		err := w.run(ctx, events)
+		w.mu.Lock()
+		w.err = err
+		w.mu.Unlock()
+	}()
+	return events, nil
+}
+
+// Err returns the error that stopped w. If the context of w is done, Err
+// matches ctx.Err() with errors.Is.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+func (w *Watcher) listArg() *ListFolderArg {
+	arg := NewListFolderArg(w.path)
+	arg.Recursive = true
+	arg.IncludeDeleted = true
+	return arg
+}
+
+// list lists the existing entries and sets the cursor to follow. If events
+// is set, the differences with the previously known entries are sent on it.
+func (w *Watcher) list(ctx context.Context, events chan<- Event) error {
+	if !w.listExisting {
+		latest, err := w.client.ListFolderGetLatestCursorContext(ctx, w.listArg())
+		if err != nil {
+			return err
+		}
+		w.cursor = latest.Cursor
+		return nil
+	}
+
+	previous := w.known
+	w.known = map[string]bool{}
+	arg := w.listArg()
+	arg.IncludeDeleted = false
+	it := NewListFolderIterator(w.client, arg)
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, entry := range res.Entries {
+			path := metadataPathLower(entry)
+			w.known[path] = true
+			if events != nil && !previous[path] {
+				if err = send(ctx, events, Event{Type: EventAdded, Path: metadataPathDisplay(entry), Metadata: entry}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	w.cursor = it.Cursor()
+	if events != nil {
+		for path := range previous {
+			parent := path[:strings.LastIndex(path, "/")]
+			if !w.known[path] && (w.known[parent] || !previous[parent]) {
+				if err := send(ctx, events, Event{Type: EventDeleted, Path: path, Metadata: &DeletedMetadata{Metadata: Metadata{PathLower: path}}}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func send(ctx context.Context, events chan<- Event, e Event) error {
+	select {
+	case events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Watcher) run(ctx context.Context, events chan<- Event) error {
+	for {
+		poll, err := w.client.ListFolderLongpollContext(ctx, NewListFolderLongpollArg(w.cursor))
+		if err != nil {
+			return err
+		}
+		if poll.Changes {
+			if err = w.changes(ctx, events); err != nil {
+				return err
+			}
+		}
+		if poll.Backoff > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(poll.Backoff) * time.Second):
+			}
+		}
+	}
+}
+
+// changes fetches the changes since the cursor and sends them on events.
+func (w *Watcher) changes(ctx context.Context, events chan<- Event) error {
+	for hasMore := true; hasMore; {
+		res, err := w.client.ListFolderContinueContext(ctx, NewListFolderContinueArg(w.cursor))
+		if e, ok := err.(ListFolderContinueAPIError); ok && e.EndpointError != nil && e.EndpointError.Tag == ListFolderContinueErrorReset {
+			if w.onReset != nil {
+				w.onReset()
+			}
+			return w.list(ctx, events)
+		}
+		if err != nil {
+			return err
+		}
+		for _, entry := range res.Entries {
+			if err = send(ctx, events, w.event(entry)); err != nil {
+				return err
+			}
+		}
+		w.cursor, hasMore = res.Cursor, res.HasMore
+	}
+	return nil
+}
+
+// event returns the event for entry and updates the known entries.
+func (w *Watcher) event(entry IsMetadata) Event {
+	e := Event{Type: EventModified, Path: metadataPathDisplay(entry), Metadata: entry}
+	path := metadataPathLower(entry)
+	switch {
+	case isDeleted(entry):
+		e.Type = EventDeleted
+		if w.listExisting {
+			delete(w.known, path)
+			for p := range w.known {
+				if strings.HasPrefix(p, path+"/") {
+					delete(w.known, p)
+				}
+			}
+		}
+	case w.listExisting && !w.known[path]:
+		e.Type = EventAdded
+		w.known[path] = true
+	}
+	return e
+}
+
+func isDeleted(entry IsMetadata) bool {
+	_, ok := entry.(*DeletedMetadata)
+	return ok
+}
+
+// metadataPathDisplay returns the display path of m.
+func metadataPathDisplay(m IsMetadata) string {
+	switch m := m.(type) {
+	case *FileMetadata:
+		return m.PathDisplay
+	case *FolderMetadata:
+		return m.PathDisplay
+	case *DeletedMetadata:
+		return m.PathDisplay
+	case *UnknownMetadata:
+		return m.PathDisplay
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package files_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestWatcher(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/list_folder":
+				_, _ = w.Write([]byte(`{"entries": [{".tag": "file", "name": "a.txt", "path_lower": "/a.txt", "path_display": "/a.txt"}], "cursor": "c1", "has_more": false}`))
+			case "/files/list_folder/get_latest_cursor":
+				_, _ = w.Write([]byte(`{"cursor": "c1"}`))
+			case "/files/list_folder/longpoll":
+				// Read the body so that the cancellation of the request is
+				// noticed.
+				_, _ = io.Copy(io.Discard, r.Body)
+				mu.Lock()
+				polls++
+				first := polls == 1
+				mu.Unlock()
+				if !first {
+					select {
+					case <-r.Context().Done():
+					case <-time.After(5 * time.Second):
+					}
+				}
+				_, _ = w.Write([]byte(`{"changes": true}`))
+			case "/files/list_folder/continue":
+				_, _ = w.Write([]byte(`{"entries": [
+					{".tag": "file", "name": "a.txt", "path_lower": "/a.txt", "path_display": "/a.txt"},
+					{".tag": "file", "name": "B.txt", "path_lower": "/b.txt", "path_display": "/B.txt"},
+					{".tag": "deleted", "name": "a.txt", "path_lower": "/a.txt", "path_display": "/a.txt"}
+				], "cursor": "c2", "has_more": false}`))
+			case "/files/get_metadata":
+				_, _ = w.Write([]byte(`{".tag": "file", "name": "a.txt", "path_lower": "/a.txt"}`))
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		Experimental: []experimental.Feature{experimental.Watcher},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	ctx, cancel := context.WithCancel(context.Background())
+	w := files.NewWatcher(client, "")
+	events, err := w.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for e := range events {
+		got = append(got, e.Type.String()+" "+e.Path)
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+	if strings.Join(got, ",") != "modified /a.txt,added /B.txt,deleted /a.txt" {
+		t.Errorf("Unexpected events: %v\n", got)
+	}
+	if !errors.Is(w.Err(), context.Canceled) {
+		t.Errorf("Unexpected error: %v\n", w.Err())
+	}
+
+	// Watch invalidates the cache while it is being read.
+	mu.Lock()
+	polls = 0
+	mu.Unlock()
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	cache := files.NewMetadataCache(client)
+	done := make(chan error)
+	go func() { done <- cache.Watch(ctx, "") }()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := cache.GetMetadata(ctx, "/a.txt"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
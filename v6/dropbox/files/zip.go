@@ -0,0 +1,101 @@
+package files
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ZipEntryError is returned by ExtractZip for an archive entry whose name
+// would be extracted outside of the destination directory.
+type ZipEntryError struct {
+	Name string
+}
+
+func (e ZipEntryError) Error() string {
+	return fmt.Sprintf("zip entry %q escapes the destination directory", e.Name)
+}
+
+// DownloadZip streams the zip archive of the folder at path, as returned by
+// `download_zip`, to w. It returns the metadata of the folder and the number
+// of bytes written.
+func DownloadZip(ctx context.Context, client Client, path string, w io.Writer) (*FolderMetadata, int64, error) {
+	res, content, err := client.DownloadZipContext(ctx, NewDownloadZipArg(path))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer content.Close()
+	n, err := io.Copy(w, content)
+	if err != nil {
+		return nil, n, err
+	}
+	return res.Metadata, n, nil
+}
+
+// ExtractZip downloads the zip archive of the folder at path and extracts it
+// into dir, which is created if needed. The entries of the archive are named
+// after the folder, so that the folder "/Photos" is extracted to
+// "dir/Photos". As archive/zip needs random access to the archive, it is
+// spooled to a temporary file rather than held in memory. Entries that would
+// be written outside of dir are rejected with a ZipEntryError.
+func ExtractZip(ctx context.Context, client Client, path string, dir string) (*FolderMetadata, error) {
+	tmp, err := os.CreateTemp("", "dropbox-zip-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	res, size, err := DownloadZip(ctx, client, path, tmp)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	for _, f := range zr.File {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err = extractZipEntry(f, dir); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func extractZipEntry(f *zip.File, dir string) error {
+	name := filepath.Join(dir, filepath.FromSlash(f.Name))
+	if rel, err := filepath.Rel(dir, name); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ZipEntryError{f.Name}
+	}
+	if f.FileInfo().IsDir() {
+		return os.MkdirAll(name, 0755)
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
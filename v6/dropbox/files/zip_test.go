@@ -0,0 +1,64 @@
+package files_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestExtractZip(t *testing.T) {
+	archive := func(names ...string) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, name := range names {
+			w, _ := zw.Create(name)
+			_, _ = w.Write([]byte("content of " + name))
+		}
+		_ = zw.Close()
+		return buf.Bytes()
+	}
+	var content []byte
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Dropbox-API-Result", `{"metadata": {"name": "Photos", "path_lower": "/photos"}}`)
+			_, _ = w.Write(content)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	dir := t.TempDir()
+	content = archive("Photos/", "Photos/a.jpg", "Photos/2020/b.jpg")
+	res, err := files.ExtractZip(context.Background(), dbx, "/photos", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Name != "Photos" {
+		t.Errorf("Unexpected metadata: %+v\n", res)
+	}
+	if b, err := os.ReadFile(filepath.Join(dir, "Photos", "2020", "b.jpg")); err != nil || string(b) != "content of Photos/2020/b.jpg" {
+		t.Errorf("Unexpected content: %q %v\n", b, err)
+	}
+
+	content = archive("../evil.txt")
+	_, err = files.ExtractZip(context.Background(), dbx, "/photos", dir)
+	var entryErr files.ZipEntryError
+	if !errors.As(err, &entryErr) || entryErr.Name != "../evil.txt" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "..", "evil.txt")); err == nil {
+		t.Error("Entry extracted outside of the destination directory")
+	}
+}
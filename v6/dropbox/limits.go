@@ -0,0 +1,98 @@
+package dropbox
+
+import "time"
+
+// Documented operational limits of the API.
+const (
+	// MaxUploadSize is the maximum size of a file uploaded with a single
+	// `upload` request.
+	MaxUploadSize int64 = 150 << 20
+	// MaxUploadChunkSize is the maximum size of the data sent with a single
+	// upload session request.
+	MaxUploadChunkSize int64 = 150 << 20
+	// UploadChunkAlignment is the size that the data of every request of a
+	// concurrent upload session, except the last one, must be a multiple of.
+	UploadChunkAlignment int64 = 4 << 20
+	// MaxUploadSessionSize is the maximum size of a file uploaded with an
+	// upload session.
+	MaxUploadSessionSize int64 = 350 << 30
+	// MaxUploadSessionBatch is the maximum number of entries of
+	// `upload_session/start_batch` and `upload_session/finish_batch_v2`.
+	MaxUploadSessionBatch = 1000
+	// MaxRelocationBatch is the maximum number of entries of `copy_batch`
+	// and `move_batch`.
+	MaxRelocationBatch = 1000
+	// MaxDeleteBatch is the maximum number of entries of `delete_batch`.
+	MaxDeleteBatch = 1000
+	// MaxCreateFolderBatch is the maximum number of paths of
+	// `create_folder_batch`.
+	MaxCreateFolderBatch = 10000
+	// MaxThumbnailBatch is the maximum number of entries of
+	// `get_thumbnail_batch`.
+	MaxThumbnailBatch = 25
+	// MaxFileMetadataBatch is the maximum number of files of
+	// `sharing/get_file_metadata/batch` and `sharing/list_file_members/batch`.
+	MaxFileMetadataBatch = 100
+	// MaxTeamMembersAddBatch is the maximum number of members of
+	// `team/members/add`.
+	MaxTeamMembersAddBatch = 20
+	// MaxListFolderLimit is the maximum `limit` of `list_folder`.
+	MaxListFolderLimit uint32 = 2000
+	// MaxSearchResults is the maximum `max_results` of `search_v2`.
+	MaxSearchResults uint64 = 1000
+	// MaxPathComponentLength is the maximum length in bytes of a single
+	// component of a path.
+	MaxPathComponentLength = 255
+	// TemporaryLinkDuration is the lifetime of links returned by
+	// `get_temporary_link`.
+	TemporaryLinkDuration = 4 * time.Hour
+)
+
+// Limits aggregates the limits that apply to an account, so that helpers and
+// applications can size their batch jobs accordingly.
+type Limits struct {
+	MaxUploadSize          int64
+	MaxUploadChunkSize     int64
+	UploadChunkAlignment   int64
+	MaxUploadSessionSize   int64
+	MaxUploadSessionBatch  int
+	MaxRelocationBatch     int
+	MaxDeleteBatch         int
+	MaxCreateFolderBatch   int
+	MaxThumbnailBatch      int
+	MaxFileMetadataBatch   int
+	MaxTeamMembersAddBatch int
+	MaxListFolderLimit     uint32
+	MaxSearchResults       uint64
+	MaxPathComponentLength int
+	TemporaryLinkDuration  time.Duration
+
+	// FeaturesKnown is true if the values below were discovered at runtime,
+	// e.g. by users.GetLimits.
+	FeaturesKnown bool
+	// FileLocking is true if the user can lock files in shared folders.
+	FileLocking bool
+	// PaperAsFiles is true if Paper docs are stored as files.
+	PaperAsFiles bool
+}
+
+// DefaultLimits returns the documented limits of the API.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxUploadSize:          MaxUploadSize,
+		MaxUploadChunkSize:     MaxUploadChunkSize,
+		UploadChunkAlignment:   UploadChunkAlignment,
+		MaxUploadSessionSize:   MaxUploadSessionSize,
+		MaxUploadSessionBatch:  MaxUploadSessionBatch,
+		MaxRelocationBatch:     MaxRelocationBatch,
+		MaxDeleteBatch:         MaxDeleteBatch,
+		MaxCreateFolderBatch:   MaxCreateFolderBatch,
+		MaxThumbnailBatch:      MaxThumbnailBatch,
+		MaxFileMetadataBatch:   MaxFileMetadataBatch,
+		MaxTeamMembersAddBatch: MaxTeamMembersAddBatch,
+		MaxListFolderLimit:     MaxListFolderLimit,
+		MaxSearchResults:       MaxSearchResults,
+		MaxPathComponentLength: MaxPathComponentLength,
+		TemporaryLinkDuration:  TemporaryLinkDuration,
+	}
+}
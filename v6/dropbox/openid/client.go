@@ -47,6 +47,11 @@ type UserinfoAPIError struct {
 	EndpointError *UserInfoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UserinfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UserinfoContext(ctx context.Context, arg *UserInfoArgs) (res *UserInfoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
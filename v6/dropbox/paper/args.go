@@ -0,0 +1,78 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package paper
+
+// WithCustomMessage sets CustomMessage and returns u
+func (u *AddPaperDocUser) WithCustomMessage(CustomMessage string) *AddPaperDocUser {
+	u.CustomMessage = CustomMessage
+	return u
+}
+
+// WithQuiet sets Quiet and returns u
+func (u *AddPaperDocUser) WithQuiet(Quiet bool) *AddPaperDocUser {
+	u.Quiet = Quiet
+	return u
+}
+
+// WithFilterBy sets FilterBy and returns u
+func (u *ListPaperDocsArgs) WithFilterBy(FilterBy *ListPaperDocsFilterBy) *ListPaperDocsArgs {
+	u.FilterBy = FilterBy
+	return u
+}
+
+// WithSortBy sets SortBy and returns u
+func (u *ListPaperDocsArgs) WithSortBy(SortBy *ListPaperDocsSortBy) *ListPaperDocsArgs {
+	u.SortBy = SortBy
+	return u
+}
+
+// WithSortOrder sets SortOrder and returns u
+func (u *ListPaperDocsArgs) WithSortOrder(SortOrder *ListPaperDocsSortOrder) *ListPaperDocsArgs {
+	u.SortOrder = SortOrder
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListPaperDocsArgs) WithLimit(Limit int32) *ListPaperDocsArgs {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListUsersOnFolderArgs) WithLimit(Limit int32) *ListUsersOnFolderArgs {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListUsersOnPaperDocArgs) WithLimit(Limit int32) *ListUsersOnPaperDocArgs {
+	u.Limit = Limit
+	return u
+}
+
+// WithFilterBy sets FilterBy and returns u
+func (u *ListUsersOnPaperDocArgs) WithFilterBy(FilterBy *UserOnPaperDocFilter) *ListUsersOnPaperDocArgs {
+	u.FilterBy = FilterBy
+	return u
+}
+
+// WithParentFolderId sets ParentFolderId and returns u
+func (u *PaperDocCreateArgs) WithParentFolderId(ParentFolderId string) *PaperDocCreateArgs {
+	u.ParentFolderId = ParentFolderId
+	return u
+}
+
+// WithParentFolderId sets ParentFolderId and returns u
+func (u *PaperFolderCreateArg) WithParentFolderId(ParentFolderId string) *PaperFolderCreateArg {
+	u.ParentFolderId = ParentFolderId
+	return u
+}
+
+// WithIsTeamFolder sets IsTeamFolder and returns u
+func (u *PaperFolderCreateArg) WithIsTeamFolder(IsTeamFolder bool) *PaperFolderCreateArg {
+	u.IsTeamFolder = IsTeamFolder
+	return u
+}
@@ -25,7 +25,6 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"log"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
@@ -255,8 +254,13 @@ type DocsArchiveAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsArchiveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsArchiveContext(ctx context.Context, arg *RefPaperDoc) (err error) {
-	log.Printf("WARNING: API `DocsArchive` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsArchive", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -295,8 +299,13 @@ type DocsCreateAPIError struct {
 	EndpointError *PaperDocCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsCreateContext(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
-	log.Printf("WARNING: API `DocsCreate` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsCreate", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -339,8 +348,13 @@ type DocsDownloadAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsDownloadAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsDownloadContext(ctx context.Context, arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
-	log.Printf("WARNING: API `DocsDownload` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsDownload", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -383,8 +397,13 @@ type DocsFolderUsersListAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsFolderUsersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
-	log.Printf("WARNING: API `DocsFolderUsersList` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsFolderUsersList", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -427,8 +446,13 @@ type DocsFolderUsersListContinueAPIError struct {
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsFolderUsersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
-	log.Printf("WARNING: API `DocsFolderUsersListContinue` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsFolderUsersListContinue", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -471,8 +495,13 @@ type DocsGetFolderInfoAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsGetFolderInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
-	log.Printf("WARNING: API `DocsGetFolderInfo` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsGetFolderInfo", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -515,8 +544,13 @@ type DocsListAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsListContext(ctx context.Context, arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
-	log.Printf("WARNING: API `DocsList` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsList", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -559,8 +593,13 @@ type DocsListContinueAPIError struct {
 	EndpointError *ListDocsCursorError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
-	log.Printf("WARNING: API `DocsListContinue` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsListContinue", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -603,8 +642,13 @@ type DocsPermanentlyDeleteAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsPermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc) (err error) {
-	log.Printf("WARNING: API `DocsPermanentlyDelete` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsPermanentlyDelete", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -643,8 +687,13 @@ type DocsSharingPolicyGetAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsSharingPolicyGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc) (res *SharingPolicy, err error) {
-	log.Printf("WARNING: API `DocsSharingPolicyGet` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsSharingPolicyGet", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -687,8 +736,13 @@ type DocsSharingPolicySetAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsSharingPolicySetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy) (err error) {
-	log.Printf("WARNING: API `DocsSharingPolicySet` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsSharingPolicySet", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -727,8 +781,13 @@ type DocsUpdateAPIError struct {
 	EndpointError *PaperDocUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsUpdateContext(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
-	log.Printf("WARNING: API `DocsUpdate` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsUpdate", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -771,8 +830,13 @@ type DocsUsersAddAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsUsersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
-	log.Printf("WARNING: API `DocsUsersAdd` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsUsersAdd", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -815,8 +879,13 @@ type DocsUsersListAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsUsersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
-	log.Printf("WARNING: API `DocsUsersList` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsUsersList", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -859,8 +928,13 @@ type DocsUsersListContinueAPIError struct {
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsUsersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
-	log.Printf("WARNING: API `DocsUsersListContinue` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsUsersListContinue", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -903,8 +977,13 @@ type DocsUsersRemoveAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DocsUsersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser) (err error) {
-	log.Printf("WARNING: API `DocsUsersRemove` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("DocsUsersRemove", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -943,8 +1022,13 @@ type FoldersCreateAPIError struct {
 	EndpointError *PaperFolderCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e FoldersCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) FoldersCreateContext(ctx context.Context, arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error) {
-	log.Printf("WARNING: API `FoldersCreate` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("FoldersCreate", "")
 
 	req := dropbox.Request{
 		Host:         "api",
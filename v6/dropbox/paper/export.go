@@ -0,0 +1,126 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+)
+
+// ErrDocChanged is returned by DocsExport when the doc is edited while its
+// export is retried.
+var ErrDocChanged = errors.New("paper doc changed during export")
+
+// DocsExportOptions controls DocsExport.
+type DocsExportOptions struct {
+	// MaxRetries is the number of times the export is retried after a
+	// transient failure. Defaults to 3; a negative value disables retries.
+	MaxRetries int
+}
+
+// DocsExport streams the export of the Paper doc docID to w in format, one
+// of the ExportFormat tags, or Markdown if format is empty, and returns the
+// title, revision and MIME type of the doc. Server and rate limit errors, as
+// well as downloads interrupted midway, are retried; the content already
+// written to w is skipped on retry, as long as the doc wasn't edited in the
+// meantime.
+func DocsExport(ctx context.Context, client Client, docID string, format string, w io.Writer, opts *DocsExportOptions) (*PaperDocExportResult, error) {
+	if opts == nil {
+		opts = &DocsExportOptions{}
+	}
+	maxRetries := opts.MaxRetries
+	switch {
+	case maxRetries < 0:
+		maxRetries = 0
+	case maxRetries == 0:
+		maxRetries = 3
+	}
+	if format == "" {
+		format = ExportFormatMarkdown
+	}
+	arg := NewPaperDocExport(docID, &ExportFormat{Tagged: dropbox.Tagged{Tag: format}})
+
+	var first *PaperDocExportResult
+	var written int64
+	for n := 0; ; n++ {
+		res, content, err := client.DocsDownloadContext(ctx, arg)
+		if err == nil {
+			if first == nil {
+				first = res
+			} else if res.Revision != first.Revision {
+				content.Close()
+				return nil, ErrDocChanged
+			}
+			var writeErr bool
+			writeErr, err = copySkipping(w, content, &written)
+			content.Close()
+			if err == nil {
+				return first, nil
+			}
+			if writeErr {
+				return nil, err
+			}
+		}
+		if n >= maxRetries || !retryableExport(ctx, err) {
+			return nil, err
+		}
+	}
+}
+
+// copySkipping copies r to w, skipping the *written bytes already copied by
+// a previous attempt, and counts the bytes copied in *written. writeErr
+// reports whether err was returned by w rather than r.
+func copySkipping(w io.Writer, r io.Reader, written *int64) (writeErr bool, err error) {
+	if _, err = io.CopyN(io.Discard, r, *written); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return false, err
+	}
+	src := &errReader{r: r}
+	n, err := io.Copy(w, src)
+	*written += n
+	return err != nil && src.err == nil, err
+}
+
+// errReader records the error returned by r.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
+// retryableExport reports whether err is a transient failure, waiting for
+// the delay requested by rate limit errors. It returns false if ctx is done
+// first.
+func retryableExport(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch e := err.(type) {
+	case auth.RateLimitAPIError:
+		if e.RateLimitError != nil {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(time.Duration(e.RateLimitError.RetryAfter) * time.Second):
+			}
+		}
+		return true
+	case auth.ServerError, dropbox.SDKInternalError:
+		return true
+	case DocsDownloadAPIError, auth.AuthAPIError, auth.AccessAPIError, auth.BadRequest, dropbox.CancellationError:
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
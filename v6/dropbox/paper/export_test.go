@@ -0,0 +1,68 @@
+package paper_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/paper"
+)
+
+func TestPaperDocsExport(t *testing.T) {
+	content := bytes.Repeat([]byte("# Heading\n"), 1000)
+	attempts := 0
+	changing := false
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			var arg paper.PaperDocExport
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+			if arg.ExportFormat.Tag != paper.ExportFormatMarkdown {
+				t.Errorf("Unexpected format: %v\n", arg.ExportFormat.Tag)
+			}
+			revision := 1
+			if changing {
+				revision = attempts
+			}
+			w.Header().Set("Dropbox-API-Result", fmt.Sprintf(`{"owner": "a@example.com", "title": "Notes", "revision": %d, "mime_type": "text/x-markdown"}`, revision))
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			if attempts == 1 {
+				// Interrupt the download midway.
+				_, _ = w.Write(content[:len(content)/2])
+				return
+			}
+			_, _ = w.Write(content)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := paper.New(config)
+	var buf bytes.Buffer
+	res, err := paper.DocsExport(context.Background(), dbx, "doc1", "", &buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 || !bytes.Equal(buf.Bytes(), content) || res.Title != "Notes" || res.Revision != 1 {
+		t.Errorf("Unexpected export: %d attempts, %d bytes, %+v\n", attempts, buf.Len(), res)
+	}
+
+	attempts, changing = 0, true
+	if _, err = paper.DocsExport(context.Background(), dbx, "doc1", paper.ExportFormatMarkdown, io.Discard, nil); err != paper.ErrDocChanged {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	attempts = 0
+	_, err = paper.DocsExport(context.Background(), dbx, "doc1", "", io.Discard, &paper.DocsExportOptions{MaxRetries: -1})
+	if err == nil || attempts != 1 {
+		t.Errorf("Unexpected retries: %d %v\n", attempts, err)
+	}
+}
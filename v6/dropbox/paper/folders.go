@@ -0,0 +1,121 @@
+package paper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// FolderTree resolves Paper folder paths such as "/Team/Specs/2024" to
+// folder IDs, creating the missing folders with MkdirAll. As the Paper API
+// can't list folders, the tree only knows the folders it created and those
+// containing the docs added with AddDoc or Load. A FolderTree isn't safe for
+// concurrent use.
+//
+//	tree := paper.NewFolderTree(dbx)
+//	if err := tree.Load(ctx, paper.NewDocsQuery()); err != nil {
+//		...
+//	}
+//	ids, err := tree.MkdirAll(ctx, "/Team/Specs/2024", true)
+type FolderTree struct {
+	client Client
+	ids    map[string]string
+}
+
+// NewFolderTree returns a new, empty FolderTree instance
+func NewFolderTree(client Client) *FolderTree {
+	return &FolderTree{client: client, ids: make(map[string]string)}
+}
+
+// splitFolderPath returns the names of the folders of path.
+func splitFolderPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// AddDoc records the folders containing the doc docID, and returns the path
+// of its folder, or "" if it isn't in a folder.
+func (t *FolderTree) AddDoc(ctx context.Context, docID string) (string, error) {
+	res, err := t.client.DocsGetFolderInfoContext(ctx, NewRefPaperDoc(docID))
+	if err != nil {
+		return "", err
+	}
+	path := ""
+	for _, f := range res.Folders {
+		path += "/" + f.Name
+		t.ids[path] = f.Id
+	}
+	return path, nil
+}
+
+// Load records the folders containing the docs of q. If a request fails,
+// the folders found so far are kept.
+func (t *FolderTree) Load(ctx context.Context, q *DocsQuery) error {
+	it := NewDocIDIterator(t.client, q)
+	for it.HasMore() {
+		id, err := it.Next(ctx)
+		if err == dropbox.ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err = t.AddDoc(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resolve returns the IDs of the folders of path, from the top-level
+// folder down, and whether they are all known. Names are case sensitive.
+func (t *FolderTree) Resolve(path string) ([]string, bool) {
+	var ids []string
+	prefix := ""
+	for _, name := range splitFolderPath(path) {
+		prefix += "/" + name
+		id, ok := t.ids[prefix]
+		if !ok {
+			return ids, false
+		}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+// MkdirAll returns the IDs of the folders of path, from the top-level
+// folder down, creating the folders that aren't known. isTeamFolder sets
+// whether a created top-level folder is a team folder; subfolders inherit
+// the type of their parent. If a request fails, the IDs resolved so far are
+// returned along with the error.
+func (t *FolderTree) MkdirAll(ctx context.Context, path string, isTeamFolder bool) ([]string, error) {
+	ids, ok := t.Resolve(path)
+	if ok {
+		return ids, nil
+	}
+	names := splitFolderPath(path)
+	prefix := ""
+	for _, name := range names[:len(ids)] {
+		prefix += "/" + name
+	}
+	for _, name := range names[len(ids):] {
+		arg := NewPaperFolderCreateArg(name)
+		if len(ids) > 0 {
+			arg.ParentFolderId = ids[len(ids)-1]
+		} else {
+			arg.IsTeamFolder = isTeamFolder
+		}
+		res, err := t.client.FoldersCreateContext(ctx, arg)
+		if err != nil {
+			return ids, err
+		}
+		prefix += "/" + name
+		t.ids[prefix] = res.FolderId
+		ids = append(ids, res.FolderId)
+	}
+	return ids, nil
+}
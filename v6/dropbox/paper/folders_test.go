@@ -0,0 +1,74 @@
+package paper_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/paper"
+)
+
+func TestPaperFolderTree(t *testing.T) {
+	var created []paper.PaperFolderCreateArg
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/paper/docs/list":
+				_, _ = w.Write([]byte(`{"doc_ids": ["d1", "d2"], "cursor": {"value": "c1"}, "has_more": false}`))
+			case "/paper/docs/get_folder_info":
+				var arg paper.RefPaperDoc
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				if arg.DocId == "d1" {
+					_, _ = w.Write([]byte(`{"folders": [{"id": "f1", "name": "Team"}, {"id": "f2", "name": "Specs"}]}`))
+				} else {
+					_, _ = w.Write([]byte(`{}`))
+				}
+			case "/paper/folders/create":
+				var arg paper.PaperFolderCreateArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				created = append(created, arg)
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"folder_id": "new%d"}`, len(created))))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	tree := paper.NewFolderTree(paper.New(config))
+	if err := tree.Load(context.Background(), paper.NewDocsQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if ids, ok := tree.Resolve("/Team/Specs/"); !ok || strings.Join(ids, ",") != "f1,f2" {
+		t.Errorf("Unexpected resolution: %v %v\n", ids, ok)
+	}
+	ids, err := tree.MkdirAll(context.Background(), "/Team/Specs/2024/Q1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, ",") != "f1,f2,new1,new2" {
+		t.Errorf("Unexpected IDs: %v\n", ids)
+	}
+	if len(created) != 2 || created[0].Name != "2024" || created[0].ParentFolderId != "f2" ||
+		created[1].Name != "Q1" || created[1].ParentFolderId != "new1" {
+		t.Errorf("Unexpected folders created: %+v\n", created)
+	}
+
+	ids, err = tree.MkdirAll(context.Background(), "Drafts", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || len(created) != 3 || !created[2].IsTeamFolder || created[2].ParentFolderId != "" {
+		t.Errorf("Unexpected top-level folder: %v %+v\n", ids, created)
+	}
+	if _, err = tree.MkdirAll(context.Background(), "/Team/Specs/2024/Q1", true); err != nil || len(created) != 3 {
+		t.Errorf("Unexpected folder creation: %v %+v\n", err, created)
+	}
+}
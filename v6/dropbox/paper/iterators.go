@@ -0,0 +1,192 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package paper
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// DocsFolderUsersListIterator iterates over the pages returned by `DocsFolderUsersList` and
+// `DocsFolderUsersListContinue`.
+type DocsFolderUsersListIterator struct {
+	client  Client
+	arg     *ListUsersOnFolderArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewDocsFolderUsersListIterator returns a new DocsFolderUsersListIterator instance.
+// arg is required, as `DocsFolderUsersList` has required arguments.
+func NewDocsFolderUsersListIterator(client Client, arg *ListUsersOnFolderArgs) *DocsFolderUsersListIterator {
+	if arg == nil {
+		panic("paper: NewDocsFolderUsersListIterator called with a nil arg")
+	}
+	return &DocsFolderUsersListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *DocsFolderUsersListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *DocsFolderUsersListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *DocsFolderUsersListIterator) Next(ctx context.Context) (res *ListUsersOnFolderResponse, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.DocsFolderUsersListContext(ctx, it.arg)
+	default:
+		arg := NewListUsersOnFolderContinueArgs(it.arg.DocId, it.cursor)
+		res, err = it.client.DocsFolderUsersListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = ""
+	if res.Cursor != nil {
+		it.cursor = res.Cursor.Value
+	}
+	it.hasMore = res.HasMore
+	return
+}
+
+// DocsListIterator iterates over the pages returned by `DocsList` and
+// `DocsListContinue`.
+type DocsListIterator struct {
+	client  Client
+	arg     *ListPaperDocsArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewDocsListIterator returns a new DocsListIterator instance.
+// A nil arg lists with the default arguments.
+func NewDocsListIterator(client Client, arg *ListPaperDocsArgs) *DocsListIterator {
+	if arg == nil {
+		arg = NewListPaperDocsArgs()
+	}
+	return &DocsListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *DocsListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *DocsListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *DocsListIterator) Next(ctx context.Context) (res *ListPaperDocsResponse, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.DocsListContext(ctx, it.arg)
+	default:
+		arg := NewListPaperDocsContinueArgs(it.cursor)
+		res, err = it.client.DocsListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = ""
+	if res.Cursor != nil {
+		it.cursor = res.Cursor.Value
+	}
+	it.hasMore = res.HasMore
+	return
+}
+
+// DocsUsersListIterator iterates over the pages returned by `DocsUsersList` and
+// `DocsUsersListContinue`.
+type DocsUsersListIterator struct {
+	client  Client
+	arg     *ListUsersOnPaperDocArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewDocsUsersListIterator returns a new DocsUsersListIterator instance.
+// arg is required, as `DocsUsersList` has required arguments.
+func NewDocsUsersListIterator(client Client, arg *ListUsersOnPaperDocArgs) *DocsUsersListIterator {
+	if arg == nil {
+		panic("paper: NewDocsUsersListIterator called with a nil arg")
+	}
+	return &DocsUsersListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *DocsUsersListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *DocsUsersListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *DocsUsersListIterator) Next(ctx context.Context) (res *ListUsersOnPaperDocResponse, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.DocsUsersListContext(ctx, it.arg)
+	default:
+		arg := NewListUsersOnPaperDocContinueArgs(it.arg.DocId, it.cursor)
+		res, err = it.client.DocsUsersListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = ""
+	if res.Cursor != nil {
+		it.cursor = res.Cursor.Value
+	}
+	it.hasMore = res.HasMore
+	return
+}
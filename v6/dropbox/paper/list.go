@@ -0,0 +1,124 @@
+package paper
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// maxDocsListLimit is the maximum `limit` of `docs/list`.
+const maxDocsListLimit = 1000
+
+// DocsQuery builds the argument of `docs/list`:
+//
+//	q := paper.NewDocsQuery().Created().SortByModified().Descending()
+//	ids, err := paper.ListDocIDs(ctx, dbx, q)
+type DocsQuery struct {
+	arg *ListPaperDocsArgs
+}
+
+// NewDocsQuery returns a DocsQuery listing the docs accessed by the user,
+// least recently accessed first.
+func NewDocsQuery() *DocsQuery {
+	return &DocsQuery{arg: NewListPaperDocsArgs()}
+}
+
+// Accessed lists the docs accessed by the user. This is the default.
+func (q *DocsQuery) Accessed() *DocsQuery {
+	q.arg.FilterBy = &ListPaperDocsFilterBy{Tagged: dropbox.Tagged{Tag: ListPaperDocsFilterByDocsAccessed}}
+	return q
+}
+
+// Created lists the docs created by the user only.
+func (q *DocsQuery) Created() *DocsQuery {
+	q.arg.FilterBy = &ListPaperDocsFilterBy{Tagged: dropbox.Tagged{Tag: ListPaperDocsFilterByDocsCreated}}
+	return q
+}
+
+// SortByAccessed orders docs by the time the user last accessed them. This
+// is the default.
+func (q *DocsQuery) SortByAccessed() *DocsQuery {
+	q.arg.SortBy = &ListPaperDocsSortBy{Tagged: dropbox.Tagged{Tag: ListPaperDocsSortByAccessed}}
+	return q
+}
+
+// SortByModified orders docs by the time they were last modified.
+func (q *DocsQuery) SortByModified() *DocsQuery {
+	q.arg.SortBy = &ListPaperDocsSortBy{Tagged: dropbox.Tagged{Tag: ListPaperDocsSortByModified}}
+	return q
+}
+
+// SortByCreated orders docs by the time they were created.
+func (q *DocsQuery) SortByCreated() *DocsQuery {
+	q.arg.SortBy = &ListPaperDocsSortBy{Tagged: dropbox.Tagged{Tag: ListPaperDocsSortByCreated}}
+	return q
+}
+
+// Descending reverses the order of the docs.
+func (q *DocsQuery) Descending() *DocsQuery {
+	q.arg.SortOrder = &ListPaperDocsSortOrder{Tagged: dropbox.Tagged{Tag: ListPaperDocsSortOrderDescending}}
+	return q
+}
+
+// PageSize sets the number of docs fetched per request, up to 1000.
+func (q *DocsQuery) PageSize(n int32) *DocsQuery {
+	if n > maxDocsListLimit {
+		n = maxDocsListLimit
+	}
+	q.arg.Limit = n
+	return q
+}
+
+// Arg returns the built argument.
+func (q *DocsQuery) Arg() *ListPaperDocsArgs {
+	return q.arg
+}
+
+// DocIDIterator iterates over the IDs of the docs of a query, fetching the
+// pages of `docs/list` and `docs/list/continue` as needed.
+type DocIDIterator struct {
+	pages *DocsListIterator
+	ids   []string
+}
+
+// NewDocIDIterator returns a new DocIDIterator instance
+func NewDocIDIterator(client Client, q *DocsQuery) *DocIDIterator {
+	return &DocIDIterator{pages: NewDocsListIterator(client, q.Arg())}
+}
+
+// HasMore returns false once all IDs have been returned. As a page may be
+// empty, Next can still return `dropbox.ErrNoMorePages` after HasMore
+// returned true.
+func (it *DocIDIterator) HasMore() bool {
+	return len(it.ids) > 0 || it.pages.HasMore()
+}
+
+// Next returns the next doc ID, fetching the next page if needed. It returns
+// `dropbox.ErrNoMorePages` once all IDs have been returned.
+func (it *DocIDIterator) Next(ctx context.Context) (string, error) {
+	for len(it.ids) == 0 {
+		res, err := it.pages.Next(ctx)
+		if err != nil {
+			return "", err
+		}
+		it.ids = res.DocIds
+	}
+	id := it.ids[0]
+	it.ids = it.ids[1:]
+	return id, nil
+}
+
+// ListDocIDs returns the IDs of all the docs of q. If a request fails, the
+// IDs fetched so far are returned along with the error.
+func ListDocIDs(ctx context.Context, client Client, q *DocsQuery) ([]string, error) {
+	var ids []string
+	it := NewDocsListIterator(client, q.Arg())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, res.DocIds...)
+	}
+	return ids, nil
+}
@@ -0,0 +1,63 @@
+package paper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/paper"
+)
+
+func TestPaperListDocIDs(t *testing.T) {
+	var listArg paper.ListPaperDocsArgs
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/paper/docs/list":
+				_ = json.NewDecoder(r.Body).Decode(&listArg)
+				_, _ = w.Write([]byte(`{"doc_ids": ["a", "b"], "cursor": {"value": "c1"}, "has_more": true}`))
+			case "/paper/docs/list/continue":
+				var arg paper.ListPaperDocsContinueArgs
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				if arg.Cursor != "c1" {
+					t.Errorf("Unexpected cursor: %v\n", arg.Cursor)
+				}
+				_, _ = w.Write([]byte(`{"doc_ids": ["c"], "cursor": {"value": "c2"}, "has_more": false}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	q := paper.NewDocsQuery().Created().SortByModified().Descending().PageSize(5000)
+	ids, err := paper.ListDocIDs(context.Background(), paper.New(config), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, ",") != "a,b,c" {
+		t.Errorf("Unexpected IDs: %v\n", ids)
+	}
+	if listArg.FilterBy.Tag != paper.ListPaperDocsFilterByDocsCreated || listArg.SortBy.Tag != paper.ListPaperDocsSortByModified ||
+		listArg.SortOrder.Tag != paper.ListPaperDocsSortOrderDescending || listArg.Limit != 1000 {
+		t.Errorf("Unexpected query: %+v\n", listArg)
+	}
+
+	it := paper.NewDocIDIterator(paper.New(config), paper.NewDocsQuery())
+	var n int
+	for it.HasMore() {
+		if _, err := it.Next(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Unexpected number of IDs: %d\n", n)
+	}
+}
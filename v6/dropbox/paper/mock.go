@@ -0,0 +1,257 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package paper
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	DocsArchiveFunc                 func(ctx context.Context, arg *RefPaperDoc) (err error)
+	DocsCreateFunc                  func(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error)
+	DocsDownloadFunc                func(ctx context.Context, arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error)
+	DocsFolderUsersListFunc         func(ctx context.Context, arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error)
+	DocsFolderUsersListContinueFunc func(ctx context.Context, arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error)
+	DocsGetFolderInfoFunc           func(ctx context.Context, arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error)
+	DocsListFunc                    func(ctx context.Context, arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error)
+	DocsListContinueFunc            func(ctx context.Context, arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error)
+	DocsPermanentlyDeleteFunc       func(ctx context.Context, arg *RefPaperDoc) (err error)
+	DocsSharingPolicyGetFunc        func(ctx context.Context, arg *RefPaperDoc) (res *SharingPolicy, err error)
+	DocsSharingPolicySetFunc        func(ctx context.Context, arg *PaperDocSharingPolicy) (err error)
+	DocsUpdateFunc                  func(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error)
+	DocsUsersAddFunc                func(ctx context.Context, arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error)
+	DocsUsersListFunc               func(ctx context.Context, arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersListContinueFunc       func(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersRemoveFunc             func(ctx context.Context, arg *RemovePaperDocUser) (err error)
+	FoldersCreateFunc               func(ctx context.Context, arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) DocsArchiveContext(ctx context.Context, arg *RefPaperDoc) (err error) {
+	if m.DocsArchiveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsArchiveFunc(ctx, arg)
+}
+
+func (m *Mock) DocsArchive(arg *RefPaperDoc) (err error) {
+	return m.DocsArchiveContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsCreateContext(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+	if m.DocsCreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsCreateFunc(ctx, arg, content)
+}
+
+func (m *Mock) DocsCreate(arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+	return m.DocsCreateContext(context.Background(), arg, content)
+}
+
+func (m *Mock) DocsDownloadContext(ctx context.Context, arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	if m.DocsDownloadFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsDownloadFunc(ctx, arg)
+}
+
+func (m *Mock) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	return m.DocsDownloadContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
+	if m.DocsFolderUsersListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsFolderUsersListFunc(ctx, arg)
+}
+
+func (m *Mock) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
+	return m.DocsFolderUsersListContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
+	if m.DocsFolderUsersListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsFolderUsersListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
+	return m.DocsFolderUsersListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
+	if m.DocsGetFolderInfoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsGetFolderInfoFunc(ctx, arg)
+}
+
+func (m *Mock) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
+	return m.DocsGetFolderInfoContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsListContext(ctx context.Context, arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
+	if m.DocsListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsListFunc(ctx, arg)
+}
+
+func (m *Mock) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
+	return m.DocsListContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
+	if m.DocsListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
+	return m.DocsListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc) (err error) {
+	if m.DocsPermanentlyDeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsPermanentlyDeleteFunc(ctx, arg)
+}
+
+func (m *Mock) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
+	return m.DocsPermanentlyDeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc) (res *SharingPolicy, err error) {
+	if m.DocsSharingPolicyGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsSharingPolicyGetFunc(ctx, arg)
+}
+
+func (m *Mock) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error) {
+	return m.DocsSharingPolicyGetContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy) (err error) {
+	if m.DocsSharingPolicySetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsSharingPolicySetFunc(ctx, arg)
+}
+
+func (m *Mock) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error) {
+	return m.DocsSharingPolicySetContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsUpdateContext(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+	if m.DocsUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsUpdateFunc(ctx, arg, content)
+}
+
+func (m *Mock) DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+	return m.DocsUpdateContext(context.Background(), arg, content)
+}
+
+func (m *Mock) DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
+	if m.DocsUsersAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsUsersAddFunc(ctx, arg)
+}
+
+func (m *Mock) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
+	return m.DocsUsersAddContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	if m.DocsUsersListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsUsersListFunc(ctx, arg)
+}
+
+func (m *Mock) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	return m.DocsUsersListContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	if m.DocsUsersListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsUsersListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
+	return m.DocsUsersListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser) (err error) {
+	if m.DocsUsersRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DocsUsersRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
+	return m.DocsUsersRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) FoldersCreateContext(ctx context.Context, arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error) {
+	if m.FoldersCreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.FoldersCreateFunc(ctx, arg)
+}
+
+func (m *Mock) FoldersCreate(arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error) {
+	return m.FoldersCreateContext(context.Background(), arg)
+}
@@ -0,0 +1,27 @@
+package paper
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"docs/archive":                    "files.content.write",
+	"docs/create":                     "files.content.write",
+	"docs/download":                   "files.content.read",
+	"docs/folder_users/list":          "sharing.read",
+	"docs/folder_users/list/continue": "sharing.read",
+	"docs/get_folder_info":            "sharing.read",
+	"docs/list":                       "files.metadata.read",
+	"docs/list/continue":              "files.metadata.read",
+	"docs/permanently_delete":         "files.permanent_delete",
+	"docs/sharing_policy/get":         "sharing.read",
+	"docs/sharing_policy/set":         "sharing.write",
+	"docs/update":                     "files.content.write",
+	"docs/users/add":                  "sharing.write",
+	"docs/users/list":                 "sharing.read",
+	"docs/users/list/continue":        "sharing.read",
+	"docs/users/remove":               "sharing.write",
+	"folders/create":                  "files.content.write",
+}
@@ -0,0 +1,142 @@
+package paper2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/paper2"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestPaper2(t *testing.T) {
+	if !paper2.IsDoc("/Notes/Plan.PAPER") || !paper2.IsDoc("id:abc") || paper2.IsDoc("/Notes/plan.txt") {
+		t.Error("Unexpected IsDoc")
+	}
+
+	link := `{".tag": "file", "url": "https://db.tt/p", "name": "plan.paper", "path_lower": "/notes/plan.paper",
+		"link_permissions": {"can_revoke": true}, "client_modified": "2024-01-01T00:00:00Z",
+		"server_modified": "2024-01-01T00:00:00Z", "rev": "a1b2c3d4e5", "size": 1}`
+	requests := map[string]map[string]interface{}{}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			route := strings.TrimPrefix(r.URL.Path, "/sharing/")
+			requests[route] = arg
+			w.Header().Set("Content-Type", "application/json")
+			switch route {
+			case "create_shared_link_with_settings":
+				if arg["path"] == "/notes/old.paper" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"error_summary": "shared_link_already_exists/..", "error": {".tag": "shared_link_already_exists",
+						"shared_link_already_exists": {".tag": "metadata", "metadata": ` + link + `}}}`))
+					return
+				}
+				_, _ = w.Write([]byte(link))
+			case "modify_shared_link_settings":
+				_, _ = w.Write([]byte(link))
+			case "add_file_member":
+				_, _ = w.Write([]byte(`[{"member": {".tag": "email", "email": "a@example.com"}, "result": {".tag": "success"}},
+					{"member": {".tag": "email", "email": "b@example.com"}, "result": {".tag": "member_error", "member_error": {".tag": "invalid_member"}}}]`))
+			case "remove_file_member_2":
+				_, _ = w.Write([]byte(`{".tag": "member_error", "member_error": {".tag": "no_explicit_access", "no_explicit_access": {}}}`))
+			}
+		}))
+	defer srv.Close()
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	ctx := context.Background()
+
+	// Paths that aren't Paper docs are rejected without a request.
+	if _, err := paper2.CreateLink(ctx, dbx, "/notes/plan.txt", nil); err != paper2.ErrNotPaperDoc {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if _, err := paper2.AddMembers(ctx, dbx, "/notes/plan.txt", paper2.EmailMembers("a@example.com"), nil); err != paper2.ErrNotPaperDoc {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if _, err := paper2.RemoveMember(ctx, dbx, "/notes/plan.txt", paper2.EmailMembers("a@example.com")[0]); err != paper2.ErrNotPaperDoc {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("Unexpected requests: %v\n", requests)
+	}
+
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	res, err := paper2.CreateLink(ctx, dbx, "/notes/plan.paper", &paper2.LinkSettings{
+		Audience: sharing.LinkAudienceTeam, Access: sharing.RequestedLinkAccessLevelEditor, Password: "pw", Expires: &expires})
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings, _ := requests["create_shared_link_with_settings"]["settings"].(map[string]interface{})
+	audience, _ := settings["audience"].(map[string]interface{})
+	access, _ := settings["access"].(map[string]interface{})
+	if audience[".tag"] != "team" || access[".tag"] != "editor" || settings["require_password"] != true ||
+		settings["link_password"] != "pw" || settings["expires"] != "2030-01-01T00:00:00Z" {
+		t.Errorf("Unexpected settings: %v\n", settings)
+	}
+	if l, ok := res.(*sharing.FileLinkMetadata); !ok || l.Url != "https://db.tt/p" {
+		t.Errorf("Unexpected link: %+v\n", res)
+	}
+
+	// The settings of an existing link are updated, except for its access.
+	if _, err = paper2.CreateLink(ctx, dbx, "/notes/old.paper", &paper2.LinkSettings{Access: sharing.RequestedLinkAccessLevelEditor, Password: "pw"}); err != nil {
+		t.Fatal(err)
+	}
+	modified := requests["modify_shared_link_settings"]
+	settings, _ = modified["settings"].(map[string]interface{})
+	if modified["url"] != "https://db.tt/p" || settings["access"] != nil || settings["link_password"] != "pw" {
+		t.Errorf("Unexpected update: %v\n", modified)
+	}
+
+	results, err := paper2.AddMembers(ctx, dbx, "id:abc", paper2.EmailMembers("a@example.com", "b@example.com"),
+		&paper2.MemberOptions{Message: "Have a look", MessageAsComment: true, Quiet: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := requests["add_file_member"]
+	level, _ := add["access_level"].(map[string]interface{})
+	members, _ := add["members"].([]interface{})
+	if add["file"] != "id:abc" || level[".tag"] != "editor" || len(members) != 2 || add["custom_message"] != "Have a look" ||
+		add["add_message_as_comment"] != true || add["quiet"] != true {
+		t.Errorf("Unexpected request: %v\n", add)
+	}
+	if len(results) != 2 || results[0].Result.Tag != sharing.FileMemberActionIndividualResultSuccess ||
+		results[1].Member.Email != "b@example.com" || results[1].Result.MemberError == nil ||
+		results[1].Result.MemberError.Tag != sharing.FileMemberActionErrorInvalidMember {
+		t.Errorf("Unexpected results: %+v\n", results)
+	}
+
+	// A comment needs a message.
+	if _, err = paper2.AddMembers(ctx, dbx, "id:abc", paper2.EmailMembers("a@example.com"),
+		&paper2.MemberOptions{AccessLevel: sharing.AccessLevelViewer, MessageAsComment: true}); err != nil {
+		t.Fatal(err)
+	}
+	add = requests["add_file_member"]
+	level, _ = add["access_level"].(map[string]interface{})
+	if level[".tag"] != "viewer" || add["add_message_as_comment"] != false {
+		t.Errorf("Unexpected request: %v\n", add)
+	}
+
+	removed, err := paper2.RemoveMember(ctx, dbx, "/notes/plan.paper", paper2.EmailMembers("a@example.com")[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	remove := requests["remove_file_member_2"]
+	member, _ := remove["member"].(map[string]interface{})
+	if remove["file"] != "/notes/plan.paper" || member[".tag"] != "email" || member["email"] != "a@example.com" {
+		t.Errorf("Unexpected request: %v\n", remove)
+	}
+	if removed.Tag != sharing.FileMemberRemoveActionResultMemberError || removed.MemberError == nil ||
+		removed.MemberError.Tag != sharing.FileMemberActionErrorNoExplicitAccess {
+		t.Errorf("Unexpected result: %+v\n", removed)
+	}
+}
@@ -0,0 +1,58 @@
+package dropbox
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// Pool manages a fixed set of clients built from the same Config and hands
+// them out in turn, for servers that issue many requests concurrently. Each
+// client has its own transport, and therefore its own connections, while all
+// of them share a single token source so that an access token is refreshed
+// once for the whole pool. A Pool is safe for concurrent use.
+//
+//	pool := dropbox.NewPool(config, 8, func(c dropbox.Config) interface{} {
+//		return files.New(c)
+//	})
+//	dbx := pool.Get().(files.Client)
+type Pool struct {
+	clients []interface{}
+	next    uint32
+}
+
+// NewPool returns a Pool of size clients, each built by newClient, typically
+// the New function of a namespace package. Config.Transport, if set, is
+// shared by all clients.
+func NewPool(c Config, size int, newClient func(c Config) interface{}) *Pool {
+	if size < 1 {
+		size = 1
+	}
+	if c.Client == nil && c.TokenSource == nil {
+		domain := c.Domain
+		if domain == "" {
+			domain = defaultDomain
+		}
+		c.TokenSource = c.tokenSource(domain)
+	}
+
+	p := &Pool{clients: make([]interface{}, size)}
+	for i := range p.clients {
+		cc := c
+		if cc.Transport == nil {
+			cc.Transport = http.DefaultTransport.(*http.Transport).Clone()
+		}
+		p.clients[i] = newClient(cc)
+	}
+	return p
+}
+
+// Get returns the next client of the pool.
+func (p *Pool) Get() interface{} {
+	i := atomic.AddUint32(&p.next, 1) - 1
+	return p.clients[i%uint32(len(p.clients))]
+}
+
+// Len returns the number of clients in the pool.
+func (p *Pool) Len() int {
+	return len(p.clients)
+}
@@ -0,0 +1,77 @@
+package dropbox
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Progress is a snapshot of a transfer in progress.
+type Progress struct {
+	// Bytes is the number of bytes transferred so far.
+	Bytes int64
+	// Total is the size of the content, or -1 if unknown.
+	Total int64
+	// Elapsed is the time since the transfer started.
+	Elapsed time.Duration
+	// Throughput is the average throughput so far in bytes per second.
+	Throughput float64
+	// Retries is the number of chunk retries so far of a transfer made by a
+	// transfer helper; always 0 for a single request.
+	Retries int
+}
+
+// ProgressFunc is called as content is transferred. It must not block.
+type ProgressFunc func(p Progress)
+
+type progressKey struct{}
+
+// WithProgress returns a copy of ctx carrying fn, which is called as the
+// content of upload and download routes called with it is transferred. The
+// progress of a request restarts from zero if it is retried. The transfer
+// helpers, such as files.Uploader, report the progress of the whole
+// transfer instead.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// ProgressFromContext returns the ProgressFunc of ctx, or nil if none.
+func ProgressFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return fn
+}
+
+// progressReader reports the progress of reads from a request or response
+// body.
+type progressReader struct {
+	io.ReadCloser
+	fn      ProgressFunc
+	total   int64
+	started time.Time
+	n       int64
+}
+
+func newProgressReader(r io.ReadCloser, fn ProgressFunc, total int64) *progressReader {
+	return &progressReader{ReadCloser: r, fn: fn, total: total, started: time.Now()}
+}
+
+// NewProgressReader returns a reader of r that calls fn as it is read, for
+// content transferred outside of the routes, e.g. with temporary links. total
+// is the size of the content, or -1 if unknown.
+func NewProgressReader(r io.ReadCloser, fn ProgressFunc, total int64) io.ReadCloser {
+	return newProgressReader(r, fn, total)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.n += int64(n)
+		elapsed := time.Since(r.started)
+		var throughput float64
+		if elapsed > 0 {
+			throughput = float64(r.n) / elapsed.Seconds()
+		}
+		r.fn(Progress{Bytes: r.n, Total: r.total, Elapsed: elapsed, Throughput: throughput})
+	}
+	return n, err
+}
@@ -0,0 +1,181 @@
+package dropbox
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Jitter selects how a RetryPolicy randomizes its delays, so that many
+// clients failing at the same time don't retry in lockstep.
+type Jitter uint
+
+const (
+	// JitterNone uses the exponential delay as is.
+	JitterNone Jitter = iota
+	// JitterFull draws the delay uniformly between 0 and the exponential
+	// delay. It spreads retries the most and is a good default for large
+	// fleets of workers.
+	JitterFull
+	// JitterEqual keeps half of the exponential delay and draws the other
+	// half, so that no retry happens too early.
+	JitterEqual
+	// JitterDecorrelated draws the delay between BaseDelay and three times
+	// the previous delay, so that delays grow without aligning on powers of
+	// two.
+	JitterDecorrelated
+)
+
+// RetryPolicy controls how requests failing with a rate limit (429) or a
+// server error (5xx) are retried. Only requests whose body can be rewound,
+// i.e. without a body or with one that implements io.Seeker, are retried. A
+// Retry-After header sent by Dropbox takes precedence over shorter delays. A
+// RetryPolicy is safe for concurrent use and may be shared by several
+// clients.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including
+	// the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled for every
+	// subsequent one. Defaults to 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts. Defaults to 30s.
+	MaxDelay time.Duration
+	// Jitter randomizes the delays.
+	Jitter Jitter
+	// Seed, if non-zero, seeds the random source of the policy, making its
+	// delays reproducible.
+	Seed int64
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func (p *RetryPolicy) retries(statusCode int, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500 && statusCode <= 599
+}
+
+// Delay returns the delay before retrying a request for the attempt-th
+// time, starting at 1. prev is the delay before the previous retry, or 0,
+// and is only used by JitterDecorrelated.
+func (p *RetryPolicy) Delay(attempt int, prev time.Duration) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	max := p.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+
+	d := base
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	switch p.Jitter {
+	case JitterFull:
+		d = p.random(0, d)
+	case JitterEqual:
+		d = d/2 + p.random(0, d-d/2)
+	case JitterDecorrelated:
+		if prev < base {
+			prev = base
+		}
+		upper := prev * 3
+		if upper > max || upper < prev {
+			upper = max
+		}
+		d = p.random(base, upper)
+	}
+	return d
+}
+
+// random returns a duration in [min, max).
+func (p *RetryPolicy) random(min time.Duration, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.rnd == nil {
+		seed := p.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		p.rnd = rand.New(rand.NewSource(seed))
+	}
+	return min + time.Duration(p.rnd.Int63n(int64(max-min)))
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp,
+// if any.
+func retryAfter(resp *http.Response) time.Duration {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// IsRetryable reports whether a request that failed with err may succeed if
+// sent again unchanged: rate limits, including route errors tagged
+// too_many_write_operations, server errors, route errors tagged
+// transient_error or internal_error, and temporary network errors. Errors
+// of a done context aren't retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var temp interface{ Temporary() bool }
+	if errors.As(err, &temp) && temp.Temporary() {
+		return true
+	}
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return true
+	}
+	var sdkErr SDKInternalError
+	if errors.As(err, &sdkErr) {
+		return sdkErr.StatusCode == http.StatusTooManyRequests || sdkErr.StatusCode >= 500 && sdkErr.StatusCode <= 599
+	}
+	var apiErr APIError
+	return errors.As(err, &apiErr) && apiErr.hasTag("transient_error", "internal_error")
+}
+
+// RetryAfter returns the delay Dropbox asked to wait before retrying a
+// request that failed with err, a rate limit, or 0 if it didn't.
+func RetryAfter(err error) time.Duration {
+	var after interface{ RetryAfter() time.Duration }
+	if errors.As(err, &after) {
+		return after.RetryAfter()
+	}
+	return 0
+}
+
+// IsFatalAuth reports whether err is an authentication or authorization
+// error that retrying can't fix, such as a revoked or expired access token
+// that couldn't be refreshed, a suspended user, a missing scope, or Paper
+// being disabled: the user has to authorize the app again or be granted
+// access.
+func IsFatalAuth(err error) bool {
+	var fatal interface{ FatalAuth() bool }
+	if errors.As(err, &fatal) {
+		return fatal.FatalAuth()
+	}
+	var sdkErr SDKInternalError
+	return errors.As(err, &sdkErr) && (sdkErr.StatusCode == http.StatusUnauthorized || sdkErr.StatusCode == http.StatusForbidden)
+}
@@ -0,0 +1,164 @@
+// Package roundtrip checks that sample API payloads survive a round trip
+// through the generated types, to catch the fields and union tags the SDK
+// silently drops when the API spec moves ahead of it.
+//
+//	var res files.ListFolderResult
+//	report, err := roundtrip.CheckFile("testdata/list_folder.json", &res)
+//	if err == nil && len(report.Dropped) > 0 {
+//		t.Errorf("dropped fields:\n%v", report)
+//	}
+package roundtrip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// Drop is a value of the payload missing after the round trip.
+type Drop struct {
+	// Path locates the value, e.g. `$.entries[0].media_info`.
+	Path  string
+	Value json.RawMessage
+	// Tag is the .tag of the union holding the value, if any. A value
+	// dropped from a union usually means the SDK doesn't know Tag.
+	Tag string
+}
+
+func (d *Drop) String() string {
+	if d.Tag != "" {
+		return fmt.Sprintf("%s (tag %q): %s", d.Path, d.Tag, d.Value)
+	}
+	return fmt.Sprintf("%s: %s", d.Path, d.Value)
+}
+
+// Report lists the values of a payload lost in a round trip.
+type Report struct {
+	Dropped []*Drop
+}
+
+func (r *Report) String() string {
+	lines := make([]string, len(r.Dropped))
+	for i, d := range r.Dropped {
+		lines[i] = d.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Check decodes payload into v, a pointer to a generated type, and compares
+// payload with the encoding of v; see Compare.
+func Check(payload []byte, v interface{}) (*Report, error) {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return nil, err
+	}
+	return Compare(payload, v)
+}
+
+// CheckFile is Check with the payload read from the file name.
+func CheckFile(name string, v interface{}) (*Report, error) {
+	payload, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return Check(payload, v)
+}
+
+// Compare reports the values of payload missing from the encoding of v,
+// decoded from payload, e.g. with files.IsMetadataFromJSON for polymorphic
+// types. Values that decode to the zero value, such as null or false, are
+// not reported. The generated types encode the struct members of unions
+// nested under their tag instead of inline, and don't encode the .tag of
+// polymorphic structs such as files.FileMetadata; neither is reported.
+func Compare(payload []byte, v interface{}) (*Report, error) {
+	var in interface{}
+	if err := json.Unmarshal(payload, &in); err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err = json.Unmarshal(encoded, &out); err != nil {
+		return nil, err
+	}
+	r := &Report{}
+	r.compare("$", "", in, out)
+	return r, nil
+}
+
+func (r *Report) compare(path string, tag string, in interface{}, out interface{}) {
+	if out == nil {
+		if !isZero(in) {
+			value, _ := json.Marshal(in)
+			r.Dropped = append(r.Dropped, &Drop{Path: path, Value: value, Tag: tag})
+		}
+		return
+	}
+	switch in := in.(type) {
+	case map[string]interface{}:
+		o, _ := out.(map[string]interface{})
+		t, _ := in[".tag"].(string)
+		o = inline(o, t)
+		keys := make([]string, 0, len(in))
+		for k := range in {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if _, ok := o[k]; k == ".tag" && !ok {
+				continue
+			}
+			r.compare(path+"."+k, t, in[k], o[k])
+		}
+	case []interface{}:
+		o, _ := out.([]interface{})
+		for i, e := range in {
+			var oe interface{}
+			if i < len(o) {
+				oe = o[i]
+			}
+			r.compare(fmt.Sprintf("%s[%d]", path, i), tag, e, oe)
+		}
+	}
+}
+
+// inline moves the fields of the union member nested under tag back into
+// the union, as on the wire.
+func inline(o map[string]interface{}, tag string) map[string]interface{} {
+	nested, ok := o[tag].(map[string]interface{})
+	if tag == "" || !ok {
+		return o
+	}
+	merged := make(map[string]interface{}, len(o)+len(nested))
+	for k, v := range o {
+		merged[k] = v
+	}
+	for k, v := range nested {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// isZero reports whether v, decoded from JSON, decodes to a zero value.
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
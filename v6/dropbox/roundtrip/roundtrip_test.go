@@ -0,0 +1,40 @@
+package roundtrip_test
+
+import (
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/roundtrip"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	payload := []byte(`{"file": "id:a", "result": {".tag": "result", "member_count": 1,
+		"members": {"users": [], "groups": [], "invitees": [], "cursor": "c", "new_field": "x"}}}`)
+	var res sharing.ListFileMembersBatchResult
+	report, err := roundtrip.Check(payload, &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Dropped) != 1 || report.Dropped[0].Path != "$.result.members.new_field" {
+		t.Errorf("Unexpected report: %v\n", report)
+	}
+
+	payload = []byte(`{"file": "id:a", "result": {".tag": "future_result", "future_result": {"count": 2}}}`)
+	if report, err = roundtrip.Check(payload, &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Dropped) != 1 || report.Dropped[0].Tag != "future_result" || string(report.Dropped[0].Value) != `{"count":2}` {
+		t.Errorf("Unexpected report: %v\n", report)
+	}
+
+	payload = []byte(`{".tag": "file", "name": "a.txt", "id": "id:a", "path_lower": "/a.txt", "size": 3, "is_downloadable": true,
+		"client_modified": "2024-05-01T10:00:00Z", "server_modified": "2024-05-01T10:00:00Z", "rev": "015", "content_hash": "abc"}`)
+	md, err := files.IsMetadataFromJSON(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report, err = roundtrip.Compare(payload, md); err != nil || len(report.Dropped) != 0 {
+		t.Errorf("Unexpected report: %v %v\n", report, err)
+	}
+}
@@ -30,7 +30,11 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/experimental"
 	"golang.org/x/oauth2"
 )
 
@@ -49,14 +53,43 @@ func Version() (string, string) {
 	return sdkVersion, specVersion
 }
 
+// ErrNoMorePages is returned by the Next method of iterators once all pages
+// have been fetched.
+var ErrNoMorePages = errors.New("no more pages")
+
+// ErrNotMocked is returned by the routes of a Mock client whose function
+// field is nil.
+var ErrNotMocked = errors.New("route not mocked")
+
 // Tagged is used for tagged unions.
 type Tagged struct {
 	Tag string `json:".tag"`
 }
 
+// UserMessage is an error message meant for end users.
+type UserMessage struct {
+	Text string `json:"text"`
+	// Locale is the locale of Text, e.g. "en".
+	Locale string `json:"locale"`
+}
+
 // APIError is the base type for endpoint-specific errors.
 type APIError struct {
 	ErrorSummary string `json:"error_summary"`
+	// UserMessage, if set, can be shown to end users, e.g. in place of the
+	// summary of a failure to share a folder. Its locale can be chosen with
+	// Config.UserLocale.
+	UserMessage *UserMessage `json:"user_message,omitempty"`
+	// Body is the untouched body of the error response, e.g. to log the
+	// union tags the SDK doesn't know yet.
+	Body string `json:"-"`
+	// StatusCode is the HTTP status of the error response.
+	StatusCode int `json:"-"`
+	// Route is the route called, e.g. "files/upload".
+	Route string `json:"-"`
+	// RequestID is the request ID assigned by Dropbox, useful when reporting
+	// issues.
+	RequestID string `json:"-"`
 }
 
 func (e APIError) Error() string {
@@ -66,26 +99,87 @@ func (e APIError) Error() string {
 type SDKInternalError struct {
 	StatusCode int
 	Content    string
+	// Route is the route called, e.g. "files/upload".
+	Route string
+	// RequestID is the request ID assigned by Dropbox.
+	RequestID string
 }
 
 func (e SDKInternalError) Error() string {
 	return fmt.Sprintf("Unexpected error: %v (code: %v)", e.Content, e.StatusCode)
 }
 
+// APIError returns the APIError of the response, with the response body as
+// summary until it is parsed.
+func (e SDKInternalError) APIError() APIError {
+	return APIError{
+		ErrorSummary: e.Content,
+		Body:         e.Content,
+		StatusCode:   e.StatusCode,
+		Route:        e.Route,
+		RequestID:    e.RequestID,
+	}
+}
+
 // Config contains parameters for configuring the SDK.
 type Config struct {
+	// Credentials sent with requests. Defaults to AuthTypeUser.
+	AuthType AuthType
 	// OAuth2 access token
 	Token string
+	// Expiry of Token, if known
+	TokenExpiry time.Time
+	// OAuth2 refresh token. If set, short-lived access tokens are refreshed
+	// transparently using AppKey and AppSecret.
+	RefreshToken string
+	// App key, needed to refresh access tokens and for app authentication
+	AppKey string
+	// App secret, needed to refresh access tokens of apps that do not use
+	// PKCE and for app authentication
+	AppSecret string
+	// Called with the new token whenever the access token was refreshed, e.g.
+	// to persist it
+	OnTokenRefresh func(tok *oauth2.Token)
+	// Source of the access tokens sent with requests, e.g. to share an
+	// existing token cache. It is consulted before every request and takes
+	// precedence over Token and RefreshToken.
+	TokenSource oauth2.TokenSource
+	// Persists tokens across runs. If set, the stored token is loaded before
+	// the first request and fills in Token, TokenExpiry and RefreshToken when
+	// they are empty; refreshed tokens are saved back to it.
+	TokenStore TokenStore
 	// Logging level for SDK generated logs
 	LogLevel LogLevel
-	// Logging target for verbose SDK logging
-	Logger *log.Logger
+	// Logging target for verbose SDK logging and deprecation warnings.
+	// Defaults to the standard logger.
+	Logger Logger
+	// Disables the warnings logged the first time a deprecated route is
+	// called
+	SuppressDeprecationWarnings bool
 	// Used with APIs that support operations as another user
 	AsMemberID string
 	// Used with APIs that support operations as an admin
 	AsAdminID string
 	// Path relative to which action should be taken
 	PathRoot string
+	// Locale of the error messages meant for end users, e.g. "fr-FR", as
+	// returned in APIError.UserMessage. Defaults to the locale of the user.
+	UserLocale string
+	// Experimental subsystems to enable, see package experimental
+	Experimental []experimental.Feature
+	// Retries requests failing with a rate limit or server error. Requests
+	// are not retried by default.
+	RetryPolicy *RetryPolicy
+	// Called with every request before it is sent, e.g. to audit-log
+	// mutating calls. A non-nil error aborts the request and is returned
+	// to the caller.
+	RequestHook func(ctx context.Context, req Request) error
+	// Called with the outcome of every request that was sent, e.g. to
+	// record its status and request ID.
+	ResponseHook func(ctx context.Context, req Request, resp Response)
+	// Base transport of requests sent with an access token. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
 	// No need to set -- for testing only
 	Domain string
 	// No need to set -- for testing only
@@ -96,6 +190,19 @@ type Config struct {
 	URLGenerator func(hostType string, namespace string, route string) string
 }
 
+// AuthType defines the credentials sent with requests.
+type AuthType uint
+
+const (
+	// AuthTypeUser sends the access token. This is the default.
+	AuthTypeUser AuthType = iota
+	// AuthTypeApp sends the app key and secret, for routes that accept app
+	// authentication such as `check/app` or
+	// `sharing/get_shared_link_metadata`. Other routes fail without being
+	// sent.
+	AuthTypeApp
+)
+
 // LogLevel defines a type that can set the desired level of logging the SDK will generate.
 type LogLevel uint
 
@@ -113,11 +220,19 @@ func (l LogLevel) shouldLog(v LogLevel) bool {
 	return l > v || l&v == v
 }
 
+// Logger is the target of SDK logs. It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
 func (c *Config) doLog(l LogLevel, format string, v ...interface{}) {
 	if !c.LogLevel.shouldLog(l) {
 		return
 	}
+	c.printf(format, v...)
+}
 
+func (c *Config) printf(format string, v ...interface{}) {
 	if c.Logger != nil {
 		c.Logger.Printf(format, v...)
 	} else {
@@ -147,14 +262,62 @@ func (c Config) WithRoot(nsID string) Config {
 }
 
 // Context is the base client context used to implement per-namespace clients.
+// A Context, and the clients built on it, are safe for concurrent use by
+// multiple goroutines: its fields are not modified after NewContext returns,
+// and token refreshes are serialized so that concurrent requests wait for a
+// single refresh.
 type Context struct {
 	Config          Config
 	Client          *http.Client
 	NoAuthClient    *http.Client
 	HeaderGenerator func(hostType string, namespace string, route string) map[string]string
 	URLGenerator    func(hostType string, namespace string, route string) string
+
+	// tokens is set if access tokens are refreshed by the SDK
+	tokens expirer
+	// invalidated is set to 1 by Invalidate
+	invalidated int32
+	// deprecated holds the deprecated routes already warned about
+	deprecated *sync.Map
+}
+
+// ErrInvalidated is returned for requests made through a Context, or a
+// client, that was invalidated, e.g. by auth.Logout.
+var ErrInvalidated = errors.New("client was invalidated")
+
+// CheckExperimental returns an experimental.DisabledError unless f is
+// enabled for c.
+func (c *Context) CheckExperimental(f experimental.Feature) error {
+	return experimental.Check(c.Config.Experimental, f)
+}
+
+// WarnDeprecated logs that the deprecated route fn was called, and its
+// replacement if any, once per route unless
+// Config.SuppressDeprecationWarnings is set. It is called by the generated
+// clients.
+func (c *Context) WarnDeprecated(fn string, replacement string) {
+	if c.Config.SuppressDeprecationWarnings {
+		return
+	}
+	if c.deprecated != nil {
+		if _, warned := c.deprecated.LoadOrStore(fn, true); warned {
+			return
+		}
+	}
+	c.Config.printf("WARNING: API `%s` is deprecated", fn)
+	if replacement != "" {
+		c.Config.printf("Use API `%s` instead", replacement)
+	}
 }
 
+// Invalidate marks c unusable: subsequent requests fail with ErrInvalidated
+// without being sent.
+func (c *Context) Invalidate() {
+	atomic.StoreInt32(&c.invalidated, 1)
+}
+
+// Request describes a single route call. It is built by the generated
+// clients and passed to Execute.
 type Request struct {
 	Host      string
 	Namespace string
@@ -166,11 +329,281 @@ type Request struct {
 	ExtraHeaders map[string]string
 }
 
+// Response describes the outcome of a route call, as passed to
+// Config.ResponseHook.
+type Response struct {
+	// StatusCode of the HTTP response, 0 if none was received
+	StatusCode int
+	// RequestID assigned by Dropbox, useful when reporting issues
+	RequestID string
+	// Err is set if no response was received
+	Err error
+}
+
+// CancellationError is returned when a request fails because its context
+// was cancelled or its deadline exceeded. errors.Is reports it as
+// context.Canceled or context.DeadlineExceeded.
+type CancellationError struct {
+	Namespace string
+	Route     string
+	// Elapsed is the time since the request was started.
+	Elapsed time.Duration
+	// Bytes is the amount of content uploaded or downloaded so far, for
+	// upload and download style routes.
+	Bytes int64
+	// Err is the error of the context.
+	Err error
+}
+
+func (e CancellationError) Error() string {
+	return fmt.Sprintf("%s/%s: %v after %v (%d bytes transferred)", e.Namespace, e.Route, e.Err, e.Elapsed.Round(time.Millisecond), e.Bytes)
+}
+
+// Unwrap returns the error of the context.
+func (e CancellationError) Unwrap() error {
+	return e.Err
+}
+
 func (c *Context) Execute(ctx context.Context, req Request, body io.Reader) ([]byte, io.ReadCloser, error) {
+	if err := validate(req); err != nil {
+		return nil, nil, err
+	}
+	started := time.Now()
+	var sent int64
+	b, content, err := c.execute(ctx, req, body, &sent)
+	if err != nil {
+		return nil, nil, cancellationError(ctx, req, started, atomic.LoadInt64(&sent), err)
+	}
+	if content != nil {
+		content = &contentReader{ReadCloser: content, ctx: ctx, req: req, started: started}
+	}
+	return b, content, nil
+}
+
+// cancellationError returns a CancellationError in place of err if ctx is
+// done.
+func cancellationError(ctx context.Context, req Request, started time.Time, n int64, err error) error {
+	if ctx.Err() == nil {
+		return err
+	}
+	if _, ok := err.(CancellationError); ok {
+		return err
+	}
+	return CancellationError{
+		Namespace: req.Namespace,
+		Route:     req.Route,
+		Elapsed:   time.Since(started),
+		Bytes:     n,
+		Err:       ctx.Err(),
+	}
+}
+
+// contentReader reports the content read so far in errors caused by the
+// cancellation of a download.
+type contentReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	req     Request
+	started time.Time
+	n       int64
+}
+
+func (r *contentReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	if err != nil && err != io.EOF {
+		err = cancellationError(r.ctx, r.req, r.started, r.n, err)
+	}
+	return n, err
+}
+
+// countingReader counts the bytes read from a request body.
+type countingReader struct {
+	io.ReadCloser
+	n *int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	atomic.AddInt64(r.n, int64(n))
+	return n, err
+}
+
+func (c *Context) execute(ctx context.Context, req Request, body io.Reader, sent *int64) ([]byte, io.ReadCloser, error) {
+	if atomic.LoadInt32(&c.invalidated) != 0 {
+		return nil, nil, ErrInvalidated
+	}
+	if c.Config.RequestHook != nil {
+		if err := c.Config.RequestHook(ctx, req); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// A request rejected because its access token expired is replayed once
+	// with a refreshed token, and one that failed with a rate limit or server
+	// error is retried according to Config.RetryPolicy, provided its body
+	// can be rewound.
+	var start int64
+	seeker, replayable := body.(io.Seeker)
+	if body == nil {
+		replayable = true
+	} else if replayable {
+		var err error
+		if start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			replayable = false
+		}
+		if _, ok := body.(io.Closer); ok && replayable {
+			// Keep the transport from closing the body before the replay.
+			body = io.NopCloser(body)
+		}
+	}
+
+	var resp *http.Response
+	var delay time.Duration
+	refreshed := false
+retry:
+	for attempt := 1; ; attempt++ {
+		var err error
+		if resp, err = c.send(ctx, req, body, sent); err != nil {
+			return nil, nil, err
+		}
+		if !replayable {
+			break retry
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized && c.tokens != nil && !refreshed:
+			b, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+			stale := strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+			if !isExpiredAccessToken(b) || !c.tokens.expire(stale) {
+				return nil, nil, responseError(req, resp, b)
+			}
+			c.Config.LogInfo("Access token expired, refreshing and retrying %s/%s", req.Namespace, req.Route)
+			refreshed = true
+		case c.Config.RetryPolicy.retries(resp.StatusCode, attempt):
+			delay = c.Config.RetryPolicy.Delay(attempt, delay)
+			if after := retryAfter(resp); after > delay {
+				delay = after
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			c.Config.LogInfo("Retrying %s/%s in %v after status %d", req.Namespace, req.Route, delay, resp.StatusCode)
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, nil, ctx.Err()
+			case <-timer.C:
+			}
+		default:
+			break retry
+		}
+
+		if seeker != nil {
+			if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+		switch req.Style {
+		case "rpc", "upload":
+			if resp.Body == nil {
+				return nil, nil, errors.New("Expected body in RPC response, got nil")
+			}
+
+			b, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return b, nil, nil
+		case "download":
+			if resp.Body == nil {
+				return nil, nil, errors.New("Expected body in download response, got nil")
+			}
+
+			// The content is handed to the caller unread; it is the caller's
+			// responsibility to close it.
+			b := []byte(resp.Header.Get("Dropbox-API-Result"))
+			if fn := ProgressFromContext(ctx); fn != nil {
+				return b, newProgressReader(resp.Body, fn, resp.ContentLength), nil
+			}
+			return b, resp.Body, nil
+		}
+	}
+
+	// Error responses, including those of download-style routes, carry the
+	// error in the body rather than in the Dropbox-API-Result header. Read
+	// it in full so that it can be parsed by the caller.
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, responseError(req, resp, b)
+}
+
+// responseError returns the error of the response resp to req, whose body
+// is b.
+func responseError(req Request, resp *http.Response, b []byte) SDKInternalError {
+	return SDKInternalError{
+		StatusCode: resp.StatusCode,
+		Content:    string(b),
+		Route:      req.Namespace + "/" + req.Route,
+		RequestID:  resp.Header.Get("X-Dropbox-Request-Id"),
+	}
+}
+
+// isExpiredAccessToken reports whether the body of a 401 response is an
+// `expired_access_token` auth error.
+func isExpiredAccessToken(body []byte) bool {
+	var authErr struct {
+		Error Tagged `json:"error"`
+	}
+	return json.Unmarshal(body, &authErr) == nil && authErr.Error.Tag == "expired_access_token"
+}
+
+// acceptsAuth reports whether auth, the comma-separated authentication
+// types of a route, includes typ.
+func acceptsAuth(auth string, typ string) bool {
+	for _, a := range strings.Split(auth, ",") {
+		if strings.TrimSpace(a) == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// send sends a single HTTP request for req, counting the bytes of body sent.
+func (c *Context) send(ctx context.Context, req Request, body io.Reader, sent *int64) (*http.Response, error) {
+	appAuth := c.Config.AuthType == AuthTypeApp && req.Auth != "noauth"
+	if appAuth && !acceptsAuth(req.Auth, "app") {
+		return nil, fmt.Errorf("%s/%s does not accept app authentication", req.Namespace, req.Route)
+	}
+
 	url := c.URLGenerator(req.Host, req.Namespace, req.Route)
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	atomic.StoreInt64(sent, 0)
+	if fn := ProgressFromContext(ctx); fn != nil && req.Style == "upload" && httpReq.Body != nil {
+		total := httpReq.ContentLength
+		if total == 0 && httpReq.Body != http.NoBody {
+			total = -1
+		}
+		httpReq.Body = newProgressReader(httpReq.Body, fn, total)
+	}
+	if httpReq.Body != nil {
+		httpReq.Body = &countingReader{ReadCloser: httpReq.Body, n: sent}
 	}
 
 	for k, v := range req.ExtraHeaders {
@@ -197,17 +630,20 @@ func (c *Context) Execute(ctx context.Context, req Request, body io.Reader) ([]b
 	if c.Config.PathRoot != "" {
 		httpReq.Header.Add("Dropbox-API-Path-Root", c.Config.PathRoot)
 	}
+	if c.Config.UserLocale != "" {
+		httpReq.Header.Add("Dropbox-API-User-Locale", c.Config.UserLocale)
+	}
 
 	if req.Arg != nil {
 		serializedArg, err := json.Marshal(req.Arg)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		switch req.Style {
 		case "rpc":
 			if body != nil {
-				return nil, nil, errors.New("RPC style requests can not have body")
+				return nil, errors.New("RPC style requests can not have body")
 			}
 
 			httpReq.Header.Set("Content-Type", "application/json")
@@ -223,42 +659,21 @@ func (c *Context) Execute(ctx context.Context, req Request, body io.Reader) ([]b
 	if req.Auth == "noauth" {
 		client = c.NoAuthClient
 	}
-
-	resp, err := client.Do(httpReq)
-	if err != nil {
-		return nil, nil, err
+	if appAuth {
+		httpReq.SetBasicAuth(c.Config.AppKey, c.Config.AppSecret)
+		client = c.NoAuthClient
 	}
 
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
-		switch req.Style {
-		case "rpc", "upload":
-			if resp.Body == nil {
-				return nil, nil, errors.New("Expected body in RPC response, got nil")
-			}
-
-			b, err := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			if err != nil {
-				return nil, nil, err
-			}
-
-			return b, nil, nil
-		case "download":
-			b := []byte(resp.Header.Get("Dropbox-API-Result"))
-			return b, resp.Body, nil
+	resp, err := client.Do(httpReq)
+	if c.Config.ResponseHook != nil {
+		r := Response{Err: err}
+		if resp != nil {
+			r.StatusCode = resp.StatusCode
+			r.RequestID = resp.Header.Get("X-Dropbox-Request-Id")
 		}
+		c.Config.ResponseHook(ctx, req, r)
 	}
-
-	b, err := io.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return nil, nil, SDKInternalError{
-		StatusCode: resp.StatusCode,
-		Content:    string(b),
-	}
+	return resp, err
 }
 
 // NewContext returns a new Context with the given Config.
@@ -268,11 +683,14 @@ func NewContext(c Config) Context {
 		domain = defaultDomain
 	}
 
+	var tokens expirer
 	client := c.Client
 	if client == nil {
-		var conf = &oauth2.Config{Endpoint: OAuthEndpoint(domain)}
-		tok := &oauth2.Token{AccessToken: c.Token}
-		client = conf.Client(context.Background(), tok)
+		src := c.tokenSource(domain)
+		tokens, _ = src.(expirer)
+		// Not oauth2.NewClient, which caches tokens without an expiry and so
+		// would never consult a custom TokenSource again.
+		client = &http.Client{Transport: &oauth2.Transport{Source: src, Base: c.Transport}}
 	}
 
 	noAuthClient := c.Client
@@ -300,7 +718,167 @@ func NewContext(c Config) Context {
 		}
 	}
 
-	return Context{c, client, noAuthClient, headerGenerator, urlGenerator}
+	return Context{
+		Config:          c,
+		Client:          client,
+		NoAuthClient:    noAuthClient,
+		HeaderGenerator: headerGenerator,
+		URLGenerator:    urlGenerator,
+		tokens:          tokens,
+		deprecated:      &sync.Map{},
+	}
+}
+
+// tokenSource returns the source of the access tokens sent with requests.
+// Tokens are refreshed if a refresh token is configured; concurrent requests
+// wait for a single refresh to complete.
+func (c *Config) tokenSource(domain string) oauth2.TokenSource {
+	if c.TokenSource != nil {
+		return c.TokenSource
+	}
+	if c.TokenStore != nil {
+		return &storedTokenSource{config: *c, domain: domain}
+	}
+	tok := &oauth2.Token{AccessToken: c.Token, Expiry: c.TokenExpiry}
+	if c.RefreshToken == "" {
+		return oauth2.StaticTokenSource(tok)
+	}
+
+	endpoint := OAuthEndpoint(domain)
+	if c.URLGenerator != nil {
+		endpoint.TokenURL = c.URLGenerator(hostAPI, "oauth2", "token")
+	}
+	if c.AppSecret == "" {
+		endpoint.AuthStyle = oauth2.AuthStyleInParams
+	}
+	conf := &oauth2.Config{ClientID: c.AppKey, ClientSecret: c.AppSecret, Endpoint: endpoint}
+	refresher := conf.TokenSource(context.Background(), &oauth2.Token{RefreshToken: c.RefreshToken})
+	return &refreshingTokenSource{tok: tok, src: &refreshNotifier{src: refresher, last: c.Token, fn: c.OnTokenRefresh}}
+}
+
+// expirer is implemented by token sources that can be told that an access
+// token was rejected.
+type expirer interface {
+	// expire discards the access token stale if it is still the current
+	// one, so that the next token is refreshed. It returns false if tokens
+	// can't be refreshed.
+	expire(stale string) bool
+}
+
+// refreshingTokenSource caches the access token and refreshes it from src
+// when it expires or is rejected.
+type refreshingTokenSource struct {
+	mu  sync.Mutex
+	tok *oauth2.Token
+	src oauth2.TokenSource
+}
+
+func (s *refreshingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tok.Valid() {
+		return s.tok, nil
+	}
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.tok = tok
+	return tok, nil
+}
+
+func (s *refreshingTokenSource) expire(stale string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tok != nil && s.tok.AccessToken == stale {
+		s.tok = nil
+	}
+	return true
+}
+
+// refreshNotifier calls fn whenever src returns a new access token.
+type refreshNotifier struct {
+	src  oauth2.TokenSource
+	fn   func(tok *oauth2.Token)
+	mu   sync.Mutex
+	last string
+}
+
+func (n *refreshNotifier) Token() (*oauth2.Token, error) {
+	tok, err := n.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	refreshed := tok.AccessToken != n.last
+	n.last = tok.AccessToken
+	n.mu.Unlock()
+	if refreshed && n.fn != nil {
+		n.fn(tok)
+	}
+	return tok, nil
+}
+
+// TokenStore loads and saves the tokens of a Config, see Config.TokenStore.
+type TokenStore interface {
+	// Load returns the stored token, or nil if there is none.
+	Load() (*oauth2.Token, error)
+	// Save stores tok, replacing any previously stored token.
+	Save(tok *oauth2.Token) error
+	// Clear removes the stored token, if any.
+	Clear() error
+}
+
+// storedTokenSource loads the token of config from its store on first use.
+type storedTokenSource struct {
+	config Config
+	domain string
+	once   sync.Once
+	src    oauth2.TokenSource
+	err    error
+}
+
+func (s *storedTokenSource) Token() (*oauth2.Token, error) {
+	s.once.Do(s.load)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.src.Token()
+}
+
+func (s *storedTokenSource) expire(stale string) bool {
+	s.once.Do(s.load)
+	e, ok := s.src.(expirer)
+	return ok && e.expire(stale)
+}
+
+func (s *storedTokenSource) load() {
+	c := s.config
+	store := c.TokenStore
+	tok, err := store.Load()
+	if err != nil {
+		s.err = err
+		return
+	}
+	if tok != nil {
+		if c.Token == "" {
+			c.Token, c.TokenExpiry = tok.AccessToken, tok.Expiry
+		}
+		if c.RefreshToken == "" {
+			c.RefreshToken = tok.RefreshToken
+		}
+	}
+	onRefresh := c.OnTokenRefresh
+	c.OnTokenRefresh = func(tok *oauth2.Token) {
+		if err := store.Save(tok); err != nil {
+			c.LogInfo("Failed to save refreshed token: %v", err)
+		}
+		if onRefresh != nil {
+			onRefresh(tok)
+		}
+	}
+	c.TokenStore = nil
+	s.src = c.tokenSource(s.domain)
 }
 
 // OAuthEndpoint constructs an `oauth2.Endpoint` for the given domain
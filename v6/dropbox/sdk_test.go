@@ -21,16 +21,32 @@
 package dropbox_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/account"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/paper"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_log"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+	"golang.org/x/oauth2"
 )
 
 func generateURL(base string, namespace string, route string) string {
@@ -111,6 +127,64 @@ func TestAuthError(t *testing.T) {
 	}
 }
 
+func TestMissingScopeError(t *testing.T) {
+	eString := `{"error_summary": "missing_scope/..", "error": {".tag": "missing_scope", "required_scope": "account_info.read"}}`
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(eString))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	_, e := client.GetCurrentAccount()
+	re, ok := e.(auth.MissingScopeError)
+	if !ok {
+		t.Fatalf("Unexpected error type: %T\n", e)
+	}
+	if re.RequiredScope != "account_info.read" || re.AuthError.Tag != auth.AuthErrorMissingScope {
+		t.Errorf("Unexpected error: %v\n", re)
+	}
+	switch e.(type) {
+	case auth.MissingScopeError:
+	default:
+		t.Errorf("Unexpected error type: %T\n", e)
+	}
+	var authErr auth.AuthAPIError
+	if !errors.As(e, &authErr) || authErr.AuthError.Tag != auth.AuthErrorMissingScope {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+	var apiErr dropbox.APIError
+	if !errors.As(e, &apiErr) || apiErr.ErrorSummary != "missing_scope/.." {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+}
+
+func TestRequiredScopes(t *testing.T) {
+	for _, c := range []struct {
+		scopes       map[string]string
+		route, scope string
+	}{
+		{files.RequiredScopes, "upload", "files.content.write"},
+		{files.RequiredScopes, "list_folder", "files.metadata.read"},
+		{sharing.RequiredScopes, "share_folder", "sharing.write"},
+		{team.RequiredScopes, "members/list_v2", "members.read"},
+		{users.RequiredScopes, "get_current_account", "account_info.read"},
+	} {
+		if c.scopes[c.route] != c.scope {
+			t.Errorf("Unexpected scope of %s: %q\n", c.route, c.scopes[c.route])
+		}
+	}
+	if _, ok := check.RequiredScopes["app"]; ok {
+		t.Errorf("Unexpected scope for check/app\n")
+	}
+}
+
 func TestAccessError(t *testing.T) {
 	eString := `{"error_summary": "access_error/...",
 	"error": {
@@ -168,6 +242,1009 @@ func TestAppError(t *testing.T) {
 	}
 }
 
+func TestDownloadError(t *testing.T) {
+	eString := `{"error_summary": "path/not_found/...", "error": {".tag": "path", "path": {".tag": "not_found"}}}`
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(eString))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	res, content, e := client.Download(files.NewDownloadArg("/missing"))
+	if res != nil || content != nil {
+		t.Errorf("Unexpected result: %v %v\n", res, content)
+	}
+	re, ok := e.(files.DownloadAPIError)
+	if !ok {
+		t.Fatalf("Unexpected error type: %T\n", e)
+	}
+	if re.ErrorSummary != "path/not_found/..." {
+		t.Errorf("Unexpected error summary: %s\n", re.ErrorSummary)
+	}
+	if re.EndpointError.Tag != files.DownloadErrorPath {
+		t.Errorf("Unexpected tag: %s\n", re.EndpointError.Tag)
+	}
+	if re.EndpointError.Path.Tag != files.LookupErrorNotFound {
+		t.Errorf("Unexpected tag: %s\n", re.EndpointError.Path.Tag)
+	}
+}
+
+func TestDownloadLargeFileIsStreamed(t *testing.T) {
+	const chunk = 1 << 20
+	const chunks = 32
+	returned := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Dropbox-API-Result", `{"name": "large.bin", "size": 33554432}`)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			buf := bytes.Repeat([]byte{'x'}, chunk)
+			_, _ = w.Write(buf)
+			w.(http.Flusher).Flush()
+			// Hold the rest of the content back until the client has
+			// returned from Download. This would deadlock if the content
+			// were buffered before returning.
+			select {
+			case <-returned:
+			case <-r.Context().Done():
+				return
+			}
+			for i := 1; i < chunks; i++ {
+				if _, err := w.Write(buf); err != nil {
+					return
+				}
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, content, err := client.DownloadContext(ctx, files.NewDownloadArg("/large.bin"))
+	close(returned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+	if res.Name != "large.bin" || res.Size != chunk*chunks {
+		t.Errorf("Unexpected metadata: %v\n", res)
+	}
+	n, err := io.Copy(io.Discard, content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != chunk*chunks {
+		t.Errorf("Want %d bytes got %d\n", chunk*chunks, n)
+	}
+}
+
+func TestDownloadEarlyCloseReleasesConnection(t *testing.T) {
+	closed := make(chan struct{}, 1)
+	handlerDone := make(chan struct{}, 1)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			defer func() { handlerDone <- struct{}{} }()
+			w.Header().Set("Dropbox-API-Result", `{"name": "endless.bin"}`)
+			buf := bytes.Repeat([]byte{'x'}, 32*1024)
+			// Stream until the client goes away.
+			for {
+				if _, err := w.Write(buf); err != nil {
+					return
+				}
+			}
+		}))
+	ts.Config.ConnState = func(c net.Conn, s http.ConnState) {
+		if s == http.StateClosed {
+			select {
+			case closed <- struct{}{}:
+			default:
+			}
+		}
+	}
+	ts.Start()
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	_, content, err := client.Download(files.NewDownloadArg("/endless.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.CopyN(io.Discard, content, 1024); err != nil {
+		t.Fatal(err)
+	}
+	if err = content.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ch := range []chan struct{}{closed, handlerDone} {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatal("Connection was not released after closing the content")
+		}
+	}
+}
+
+func TestRequestHook(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"account_id": "dbid:123", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	var seen []dropbox.Request
+	hookErr := errors.New("denied by policy")
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		},
+		RequestHook: func(ctx context.Context, req dropbox.Request) error {
+			seen = append(seen, req)
+			if req.Route == "get_account" {
+				return hookErr
+			}
+			return nil
+		}}
+	client := users.New(config)
+	if _, err := client.GetCurrentAccount(); err != nil {
+		t.Fatal(err)
+	}
+	arg := users.NewGetAccountArg("dbid:123")
+	if _, err := client.GetAccount(arg); err != hookErr {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if calls != 1 {
+		t.Errorf("Want 1 call to the server got %d\n", calls)
+	}
+	if len(seen) != 2 {
+		t.Fatalf("Want 2 hook calls got %d\n", len(seen))
+	}
+	if seen[0].Namespace != "users" || seen[0].Route != "get_current_account" {
+		t.Errorf("Unexpected request: %+v\n", seen[0])
+	}
+	if seen[1].Route != "get_account" || seen[1].Arg != arg {
+		t.Errorf("Unexpected request: %+v\n", seen[1])
+	}
+}
+
+func TestResponseHook(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Dropbox-Request-Id", "req123")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+		}))
+	defer ts.Close()
+
+	var seen []dropbox.Response
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		},
+		ResponseHook: func(ctx context.Context, req dropbox.Request, resp dropbox.Response) {
+			seen = append(seen, resp)
+		}}
+	client := files.New(config)
+	if _, err := client.DeleteV2(files.NewDeleteArg("/a")); err == nil {
+		t.Fatal("Expected error")
+	}
+	if len(seen) != 1 {
+		t.Fatalf("Want 1 hook call got %d\n", len(seen))
+	}
+	if seen[0].StatusCode != http.StatusConflict || seen[0].RequestID != "req123" || seen[0].Err != nil {
+		t.Errorf("Unexpected response: %+v\n", seen[0])
+	}
+}
+
+func TestCancellationError(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+	defer ts.Close()
+	defer close(release)
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetMetadataContext(ctx, files.NewGetMetadataArg("/a"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	ce, ok := err.(dropbox.CancellationError)
+	if !ok {
+		t.Fatalf("Unexpected error type: %T\n", err)
+	}
+	if ce.Namespace != "files" || ce.Route != "get_metadata" || ce.Elapsed < 50*time.Millisecond {
+		t.Errorf("Unexpected error: %v\n", ce)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 4096)
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			switch r.URL.Path {
+			case "/files/upload":
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"name": "a", "rev": "0123456789a", "size": 65536}`))
+			case "/files/download":
+				w.Header().Set("Dropbox-API-Result", `{"name": "a", "rev": "0123456789a", "size": 65536}`)
+				w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+				_, _ = w.Write(content)
+			default:
+				t.Errorf("Unexpected route: %v\n", r.URL.Path)
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	var last dropbox.Progress
+	calls := 0
+	ctx := dropbox.WithProgress(context.Background(), func(p dropbox.Progress) {
+		last = p
+		calls++
+	})
+
+	if _, err := client.UploadContext(ctx, files.NewUploadArg("/a"), bytes.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	if calls == 0 || last.Bytes != int64(len(content)) || last.Total != int64(len(content)) {
+		t.Errorf("Unexpected upload progress: %d %+v\n", calls, last)
+	}
+
+	calls = 0
+	_, body, err := client.DownloadContext(ctx, files.NewDownloadArg("/a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = io.Copy(io.Discard, body)
+	body.Close()
+	if calls == 0 || last.Bytes != int64(len(content)) || last.Total != int64(len(content)) {
+		t.Errorf("Unexpected download progress: %d %+v\n", calls, last)
+	}
+
+	calls = 0
+	name := filepath.Join(t.TempDir(), "a")
+	if _, _, err = files.NewDownloader(client).DownloadFile(ctx, "/a", name); err != nil {
+		t.Fatal(err)
+	}
+	if calls == 0 || last.Bytes != int64(len(content)) || last.Total != int64(len(content)) {
+		t.Errorf("Unexpected downloader progress: %d %+v\n", calls, last)
+	}
+}
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func TestMock(t *testing.T) {
+	dbx := &users.Mock{
+		GetCurrentAccountFunc: func(ctx context.Context) (*users.FullAccount, error) {
+			return &users.FullAccount{Account: users.Account{AccountId: "dbid:mock"}}, nil
+		},
+	}
+	acct, err := dbx.GetCurrentAccount()
+	if err != nil || acct.AccountId != "dbid:mock" {
+		t.Errorf("Unexpected account: %+v %v\n", acct, err)
+	}
+	if _, err = dbx.GetSpaceUsage(); err != dropbox.ErrNotMocked {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
+
+func TestErrorCategories(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/files/get_metadata":
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+			case "/files/create_folder_v2":
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "path/conflict/folder/..", "error": {".tag": "path", "path": {".tag": "conflict", "conflict": {".tag": "folder"}}}}`))
+			default:
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error_summary": "too_many_requests/..", "error": {"reason": {".tag": "too_many_requests"}, "retry_after": 1}}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	_, err := dbx.GetMetadata(files.NewGetMetadataArg("/missing"))
+	err = fmt.Errorf("lookup: %w", err)
+	if !errors.Is(err, dropbox.ErrNotFound) || errors.Is(err, dropbox.ErrConflict) {
+		t.Errorf("Unexpected category: %v\n", err)
+	}
+	var mdErr files.GetMetadataAPIError
+	if !errors.As(err, &mdErr) || mdErr.EndpointError.Tag != files.GetMetadataErrorPath {
+		t.Errorf("Unexpected endpoint error: %v\n", err)
+	}
+	var apiErr dropbox.APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorSummary != "path/not_found/.." {
+		t.Errorf("Unexpected API error: %v\n", err)
+	}
+
+	_, err = dbx.CreateFolderV2(files.NewCreateFolderArg("/existing"))
+	if !errors.Is(err, dropbox.ErrConflict) || errors.Is(err, dropbox.ErrNotFound) {
+		t.Errorf("Unexpected category: %v\n", err)
+	}
+	_, err = dbx.ListFolder(files.NewListFolderArg(""))
+	if !errors.Is(err, dropbox.ErrRateLimited) || !errors.As(err, &apiErr) {
+		t.Errorf("Unexpected category: %v\n", err)
+	}
+}
+
+func TestTypedAuthErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/oauth2/token":
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "refresh token is invalid or revoked"}`))
+			case "/users/get_current_account":
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`Error in call to API function "users/get_current_account": Invalid authorization value`))
+			case "/paper/docs/list":
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error_summary": "paper_access_denied/..", "error": {".tag": "paper_access_denied"}}`))
+			}
+		}))
+	defer ts.Close()
+
+	urls := func(hostType string, namespace string, route string) string {
+		return generateURL(ts.URL, namespace, route)
+	}
+	_, err := users.New(dropbox.Config{Token: "bad", URLGenerator: urls}).GetCurrentAccount()
+	if authErr, ok := err.(auth.AuthAPIError); !ok || !strings.Contains(authErr.ErrorSummary, "Invalid authorization value") {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+	_, err = paper.New(dropbox.Config{Token: "token", URLGenerator: urls}).DocsList(paper.NewListPaperDocsArgs())
+	if accessErr, ok := err.(auth.AccessAPIError); !ok || accessErr.AccessError.Tag != auth.AccessErrorPaperAccessDenied {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+	_, err = users.New(dropbox.Config{RefreshToken: "revoked", AppKey: "key", URLGenerator: urls}).GetCurrentAccount()
+	if authErr, ok := err.(auth.AuthAPIError); !ok || authErr.AuthError.Tag != auth.AuthErrorInvalidAccessToken ||
+		authErr.ErrorSummary != "invalid_access_token/invalid_grant" {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	rateLimit := auth.RateLimitAPIError{APIError: dropbox.APIError{ErrorSummary: "too_many_requests/.."},
+		RateLimitError: &auth.RateLimitError{RetryAfter: 7}}
+	writeLimit := files.UploadAPIError{APIError: dropbox.APIError{ErrorSummary: "path/too_many_write_operations/.."}}
+	notFound := files.GetMetadataAPIError{APIError: dropbox.APIError{ErrorSummary: "path/not_found/.."}}
+	transient := account.SetProfilePhotoAPIError{APIError: dropbox.APIError{ErrorSummary: "transient_error/"}}
+	expired := auth.AuthAPIError{APIError: dropbox.APIError{ErrorSummary: "expired_access_token/"}}
+	paper := auth.AccessAPIError{APIError: dropbox.APIError{ErrorSummary: "paper_access_denied/"}}
+
+	for _, tc := range []struct {
+		err        error
+		retryable  bool
+		retryAfter time.Duration
+		fatal      bool
+	}{
+		{rateLimit, true, 7 * time.Second, false},
+		{fmt.Errorf("upload: %w", writeLimit), true, 0, false},
+		{notFound, false, 0, false},
+		{transient, true, 0, false},
+		{auth.ServerError{StatusCode: 503}, true, 0, false},
+		{auth.BadRequest{}, false, 0, false},
+		{expired, false, 0, true},
+		{auth.MissingScopeError{AuthAPIError: expired}, false, 0, true},
+		{paper, false, 0, true},
+		{context.DeadlineExceeded, false, 0, false},
+	} {
+		if dropbox.IsRetryable(tc.err) != tc.retryable || dropbox.RetryAfter(tc.err) != tc.retryAfter || dropbox.IsFatalAuth(tc.err) != tc.fatal {
+			t.Errorf("Unexpected classification of %#v\n", tc.err)
+		}
+	}
+}
+
+func TestAPIErrorMetadata(t *testing.T) {
+	body := `{"error_summary": "path/new_reason/..", "error": {".tag": "path", "path": {".tag": "new_reason"}}}`
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Dropbox-Request-Id", "req-123")
+			switch r.URL.Path {
+			case "/files/get_metadata":
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(body))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`Error in call to API function "files/list_folder": bad arg`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+	_, err := dbx.GetMetadata(files.NewGetMetadataArg("/a"))
+	mdErr, ok := err.(files.GetMetadataAPIError)
+	if !ok || mdErr.ErrorSummary != "path/new_reason/.." || mdErr.Body != body || mdErr.StatusCode != http.StatusConflict ||
+		mdErr.Route != "files/get_metadata" || mdErr.RequestID != "req-123" {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+	_, err = dbx.ListFolder(files.NewListFolderArg(""))
+	var apiErr dropbox.APIError
+	if _, ok := err.(auth.BadRequest); !ok || !errors.As(err, &apiErr) || apiErr.Route != "files/list_folder" ||
+		apiErr.StatusCode != http.StatusBadRequest || apiErr.RequestID != "req-123" {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+}
+
+func TestUserMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Dropbox-API-User-Locale") != "fr-FR" {
+				t.Errorf("Unexpected locale: %v\n", r.Header.Get("Dropbox-API-User-Locale"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_summary": "no_permission/..", "error": {".tag": "no_permission"},
+				"user_message": {"text": "Vous n'avez pas l'autorisation de partager ce dossier.", "locale": "fr-FR"}}`))
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug, UserLocale: "fr-FR",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	_, err := sharing.New(config).ShareFolder(sharing.NewShareFolderArg("/a"))
+	var apiErr dropbox.APIError
+	if !errors.As(err, &apiErr) || apiErr.UserMessage == nil || apiErr.UserMessage.Locale != "fr-FR" ||
+		!strings.HasPrefix(apiErr.UserMessage.Text, "Vous n'avez pas") {
+		t.Errorf("Unexpected error: %#v\n", err)
+	}
+}
+
+func TestUnionSwitch(t *testing.T) {
+	var status files.RelocationBatchV2JobStatus
+	if err := json.Unmarshal([]byte(`{".tag": "complete", "entries": []}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	var complete bool
+	cases := dropbox.Cases[*files.RelocationBatchV2JobStatus]{
+		files.RelocationBatchV2JobStatusInProgress: func(s *files.RelocationBatchV2JobStatus) error { return nil },
+		files.RelocationBatchV2JobStatusComplete: func(s *files.RelocationBatchV2JobStatus) error {
+			complete = s.Complete != nil
+			return nil
+		},
+	}
+	if err := dropbox.Switch(&status, cases); err != nil || !complete {
+		t.Errorf("Unexpected switch: %v %v\n", complete, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{".tag": "paused", "paused": {"reason": "quota"}}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	err := dropbox.Switch(&status, cases)
+	if tagErr, ok := err.(dropbox.UnhandledTagError); !ok || tagErr.Tag != "paused" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	label := dropbox.Visit(&status, map[string]func(*files.RelocationBatchV2JobStatus) string{
+		files.RelocationBatchV2JobStatusComplete: func(*files.RelocationBatchV2JobStatus) string { return "done" },
+	}, func(s *files.RelocationBatchV2JobStatus) string { return "unknown " + s.Tag })
+	if label != "unknown paused" {
+		t.Errorf("Unexpected label: %v\n", label)
+	}
+}
+
+func TestDecodeTag(t *testing.T) {
+	bodies := []string{
+		`{".tag": "file"}`,
+		` { "name" : "a, b}" , "media_info": {"x": [1, {"y": "]"}]}, ".tag":"folder" } `,
+		`{"a": "\"", "b": null, "c": -1.5e3, ".tag": "deleted", "d": true}`,
+		`{".tag": "file", ".tag": "folder"}`,
+		`{".tag": null}`,
+		`{".TAG": "file"}`,
+		`{}`,
+		`{".tag": 1}`,
+		`[]`,
+		`{".tag": "file"`,
+	}
+	for _, body := range bodies {
+		var want dropbox.Tagged
+		wantErr := json.Unmarshal([]byte(body), &want)
+		tag, err := dropbox.DecodeTag([]byte(body))
+		if tag != want.Tag || (err == nil) != (wantErr == nil) {
+			t.Errorf("Unexpected tag of %s: %q %v, want %q %v\n", body, tag, err, want.Tag, wantErr)
+		}
+	}
+
+	entry, err := files.IsMetadataFromJSON([]byte(`{".tag": "file", "name": "a.txt", "id": "id:a", "size": 3, "media_info": {".tag": "pending"}}`))
+	if f, ok := entry.(*files.FileMetadata); err != nil || !ok || f.Name != "a.txt" || f.Size != 3 {
+		t.Errorf("Unexpected entry: %+v %v\n", entry, err)
+	}
+	var details team_log.EventDetails
+	if err = json.Unmarshal([]byte(`{".tag": "shared_content_download_details", "shared_content_link": "https://x", "shared_content_access_level": {".tag": "viewer"}, "shared_content_owner": {".tag": "user", "email": "a@example.com"}}`), &details); err != nil {
+		t.Fatal(err)
+	}
+	if details.Tag != team_log.EventDetailsSharedContentDownloadDetails || details.SharedContentDownloadDetails == nil || details.SharedContentDownloadDetails.SharedContentLink != "https://x" {
+		t.Errorf("Unexpected details: %+v\n", details)
+	}
+}
+
+func TestUnknownSubtype(t *testing.T) {
+	body := []byte(`{"entries": [{".tag": "file", "name": "a.txt", "id": "id:a"}, {".tag": "symlink", "name": "b", "path_lower": "/b", "target": "/a.txt"}], "cursor": "c", "has_more": false}`)
+	var res files.ListFolderResult
+	if err := json.Unmarshal(body, &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Entries) != 2 {
+		t.Fatalf("Unexpected entries: %v\n", res.Entries)
+	}
+	other, ok := res.Entries[1].(*files.UnknownMetadata)
+	if !ok || other.Tag != "symlink" || other.Name != "b" || other.PathLower != "/b" {
+		t.Errorf("Unexpected entry: %+v\n", res.Entries[1])
+	}
+	b, err := json.Marshal(res.Entries[1])
+	if err != nil || !strings.Contains(string(b), `"target":"/a.txt"`) {
+		t.Errorf("Unexpected encoding: %s %v\n", b, err)
+	}
+
+	link, err := sharing.IsSharedLinkMetadataFromJSON([]byte(`{".tag": "collection", "url": "https://x", "name": "c", "link_permissions": {"can_revoke": false}}`))
+	if other, ok := link.(*sharing.UnknownSharedLinkMetadata); err != nil || !ok || other.Url != "https://x" {
+		t.Errorf("Unexpected link: %+v %v\n", link, err)
+	}
+	link, err = sharing.IsSharedLinkMetadataFromJSON([]byte(`null`))
+	if link != nil || err != nil {
+		t.Errorf("Unexpected link: %v %v\n", link, err)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"entries": [], "cursor": "c", "has_more": false}`))
+	}))
+	defer srv.Close()
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug, URLGenerator: func(hostType, namespace, route string) string {
+		return generateURL(srv.URL, namespace, route)
+	}}
+	client := files.New(config)
+
+	arg := files.NewListFolderArg("/photos").WithRecursive(true).WithLimit(100)
+	if !arg.Recursive || arg.Limit != 100 {
+		t.Errorf("Unexpected arg: %+v\n", arg)
+	}
+	if _, err := client.ListFolder(arg); err != nil {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	var vErr dropbox.ValidationError
+	_, err := client.ListFolder(files.NewListFolderArg("photos"))
+	if !errors.As(err, &vErr) || vErr.Field != "path" || vErr.Route != "files/list_folder" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	_, err = client.ListFolder(files.NewListFolderArg("").WithLimit(5000))
+	if !errors.As(err, &vErr) || vErr.Field != "limit" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	_, err = client.Delete(files.NewDeleteArg("/a").WithParentRev("abc"))
+	if !errors.As(err, &vErr) || vErr.Field != "parent_rev" {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 1 {
+		t.Errorf("Unexpected requests: %d\n", requests)
+	}
+
+	// A pattern RE2 can't compile fails rather than being skipped.
+	err = dropbox.ValidateString("path", "/a", -1, -1, `(?!/).*`)
+	if err == nil || errors.As(err, &vErr) {
+		t.Errorf("Unexpected error for an invalid pattern: %v\n", err)
+	}
+}
+
+type lineLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *lineLogger) Printf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("null"))
+	}))
+	defer ts.Close()
+
+	for _, suppress := range []bool{false, true} {
+		logger := &lineLogger{}
+		config := dropbox.Config{
+			Client:                      ts.Client(),
+			Logger:                      logger,
+			SuppressDeprecationWarnings: suppress,
+			URLGenerator: func(hostType string, namespace string, route string) string {
+				return generateURL(ts.URL, namespace, route)
+			},
+		}
+		client := paper.New(config)
+		for i := 0; i < 2; i++ {
+			if err := client.DocsArchive(paper.NewRefPaperDoc("doc")); err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+		}
+		want := 1
+		if suppress {
+			want = 0
+		}
+		if len(logger.lines) != want {
+			t.Errorf("Unexpected warnings with suppress=%v: %v\n", suppress, logger.lines)
+		}
+		if want == 1 && !strings.Contains(logger.lines[0], "DocsArchive") {
+			t.Errorf("Unexpected warning: %v\n", logger.lines[0])
+		}
+	}
+}
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) { return f() }
+
+func TestTokenSource(t *testing.T) {
+	var auths []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			auths = append(auths, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"account_id": "dbid:123", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	n := 0
+	config := dropbox.Config{Token: "unused", LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		},
+		TokenSource: tokenSourceFunc(func() (*oauth2.Token, error) {
+			n++
+			return &oauth2.Token{AccessToken: fmt.Sprintf("token%d", n)}, nil
+		})}
+	client := users.New(config)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetCurrentAccount(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if strings.Join(auths, ",") != "Bearer token1,Bearer token2" {
+		t.Errorf("Unexpected Authorization headers: %v\n", auths)
+	}
+}
+
+func TestExpiredAccessTokenIsRefreshed(t *testing.T) {
+	var auths []string
+	refreshes := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/oauth2/token" {
+				refreshes++
+				_, _ = w.Write([]byte(`{"access_token": "fresh", "token_type": "bearer", "expires_in": 14400}`))
+				return
+			}
+			auths = append(auths, r.Header.Get("Authorization"))
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"name": "a", "id": "id:a", "rev": "0123456789a", "size": 5}`))
+		}))
+	defer ts.Close()
+
+	var refreshed []string
+	config := dropbox.Config{LogLevel: dropbox.LogDebug,
+		Token: "stale", RefreshToken: "refresh", AppKey: "key",
+		OnTokenRefresh: func(tok *oauth2.Token) {
+			refreshed = append(refreshed, tok.AccessToken)
+		},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	if _, err := client.Upload(files.NewUploadArg("/a"), strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(auths, ",") != "Bearer stale,Bearer fresh" {
+		t.Errorf("Unexpected Authorization headers: %v\n", auths)
+	}
+	if refreshes != 1 || strings.Join(refreshed, ",") != "fresh" {
+		t.Errorf("Want 1 refresh got %d %v\n", refreshes, refreshed)
+	}
+}
+
+func TestPoolConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	refreshes, calls := 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mu.Lock()
+			defer mu.Unlock()
+			if r.URL.Path == "/oauth2/token" {
+				refreshes++
+				_, _ = w.Write([]byte(`{"access_token": "fresh", "token_type": "bearer", "expires_in": 14400}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`))
+				return
+			}
+			calls++
+			_, _ = w.Write([]byte(`{"account_id": "dbid:123", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Token: "stale", RefreshToken: "refresh", AppKey: "key",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	pool := dropbox.NewPool(config, 4, func(c dropbox.Config) interface{} {
+		return users.New(c)
+	})
+	if pool.Len() != 4 {
+		t.Fatalf("Want 4 clients got %d\n", pool.Len())
+	}
+
+	const workers, perWorker = 16, 5
+	errs := make(chan error, workers*perWorker)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				_, err := pool.Get().(users.Client).GetCurrentAccount()
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if refreshes != 1 || calls != workers*perWorker {
+		t.Errorf("Want 1 refresh and %d calls got %d and %d\n", workers*perWorker, refreshes, calls)
+	}
+}
+
+func TestAppAuth(t *testing.T) {
+	var auths []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			key, secret, _ := r.BasicAuth()
+			auths = append(auths, key+":"+secret)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"result": "ping"}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{LogLevel: dropbox.LogDebug,
+		AuthType: dropbox.AuthTypeApp, AppKey: "key", AppSecret: "secret",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	res, err := check.New(config).App(check.NewEchoArg())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result != "ping" {
+		t.Errorf("Unexpected result: %v\n", res.Result)
+	}
+	if _, err = check.New(config).User(check.NewEchoArg()); err == nil {
+		t.Error("Expected error for route without app authentication")
+	}
+	if strings.Join(auths, ",") != "key:secret" {
+		t.Errorf("Unexpected credentials: %v\n", auths)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != "hello" {
+				t.Errorf("Unexpected body: %q\n", body)
+			}
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name": "a", "id": "id:a", "rev": "0123456789a", "size": 5}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		RetryPolicy: &dropbox.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, Jitter: dropbox.JitterFull},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+	if _, err := client.Upload(files.NewUploadArg("/a"), strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("Want 3 calls got %d\n", calls)
+	}
+
+	calls = 0
+	config.RetryPolicy.MaxAttempts = 2
+	if _, err := files.New(config).Upload(files.NewUploadArg("/a"), strings.NewReader("hello")); err == nil {
+		t.Error("Expected error after exhausting attempts")
+	}
+	if calls != 2 {
+		t.Errorf("Want 2 calls got %d\n", calls)
+	}
+}
+
+func TestRetryJitter(t *testing.T) {
+	const base, max, n = 100 * time.Millisecond, 10 * time.Second, 2000
+	for _, jitter := range []dropbox.Jitter{dropbox.JitterNone, dropbox.JitterFull, dropbox.JitterEqual, dropbox.JitterDecorrelated} {
+		p := &dropbox.RetryPolicy{BaseDelay: base, MaxDelay: max, Jitter: jitter, Seed: 1}
+		for attempt := 1; attempt <= 10; attempt++ {
+			exp := base << uint(attempt-1)
+			if exp > max {
+				exp = max
+			}
+			var sum time.Duration
+			for i := 0; i < n; i++ {
+				prev := exp / 2
+				d := p.Delay(attempt, prev)
+				sum += d
+				var lo, hi time.Duration
+				switch jitter {
+				case dropbox.JitterNone:
+					lo, hi = exp, exp+1
+				case dropbox.JitterFull:
+					lo, hi = 0, exp
+				case dropbox.JitterEqual:
+					lo, hi = exp/2, exp
+				case dropbox.JitterDecorrelated:
+					lo, hi = base, 3*prev
+					if prev < base {
+						hi = 3 * base
+					}
+					if hi > max {
+						hi = max
+					}
+				}
+				if d < lo || d >= hi {
+					t.Fatalf("Jitter %d attempt %d: delay %v not in [%v, %v)\n", jitter, attempt, d, lo, hi)
+				}
+			}
+			// Full and equal jitter are uniform, their mean is the middle of
+			// their range.
+			mean := sum / n
+			var want time.Duration
+			switch jitter {
+			case dropbox.JitterFull:
+				want = exp / 2
+			case dropbox.JitterEqual:
+				want = exp * 3 / 4
+			default:
+				continue
+			}
+			if mean < want*9/10 || mean > want*11/10 {
+				t.Errorf("Jitter %d attempt %d: mean %v, want about %v\n", jitter, attempt, mean, want)
+			}
+		}
+	}
+
+	a := &dropbox.RetryPolicy{Jitter: dropbox.JitterFull, Seed: 42}
+	b := &dropbox.RetryPolicy{Jitter: dropbox.JitterFull, Seed: 42}
+	for attempt := 1; attempt <= 5; attempt++ {
+		if da, db := a.Delay(attempt, 0), b.Delay(attempt, 0); da != db {
+			t.Errorf("Same seed gave different delays: %v %v\n", da, db)
+		}
+	}
+}
+
+type memoryTokenStore struct {
+	tok   *oauth2.Token
+	loads int
+}
+
+func (s *memoryTokenStore) Load() (*oauth2.Token, error) {
+	s.loads++
+	return s.tok, nil
+}
+
+func (s *memoryTokenStore) Save(tok *oauth2.Token) error {
+	s.tok = tok
+	return nil
+}
+
+func (s *memoryTokenStore) Clear() error {
+	s.tok = nil
+	return nil
+}
+
+func TestTokenStore(t *testing.T) {
+	var auths []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			auths = append(auths, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"account_id": "dbid:123", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	store := &memoryTokenStore{tok: &oauth2.Token{AccessToken: "stored"}}
+	config := dropbox.Config{LogLevel: dropbox.LogDebug, TokenStore: store,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetCurrentAccount(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if strings.Join(auths, ",") != "Bearer stored,Bearer stored" {
+		t.Errorf("Unexpected Authorization headers: %v\n", auths)
+	}
+	if store.loads != 1 {
+		t.Errorf("Want 1 load got %d\n", store.loads)
+	}
+}
+
 func TestHTTPHeaderSafeJSON(t *testing.T) {
 	for _, test := range []struct {
 		name string
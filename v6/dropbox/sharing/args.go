@@ -0,0 +1,282 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package sharing
+
+// WithCustomMessage sets CustomMessage and returns u
+func (u *AddFileMemberArgs) WithCustomMessage(CustomMessage string) *AddFileMemberArgs {
+	u.CustomMessage = CustomMessage
+	return u
+}
+
+// WithQuiet sets Quiet and returns u
+func (u *AddFileMemberArgs) WithQuiet(Quiet bool) *AddFileMemberArgs {
+	u.Quiet = Quiet
+	return u
+}
+
+// WithAccessLevel sets AccessLevel and returns u
+func (u *AddFileMemberArgs) WithAccessLevel(AccessLevel *AccessLevel) *AddFileMemberArgs {
+	u.AccessLevel = AccessLevel
+	return u
+}
+
+// WithAddMessageAsComment sets AddMessageAsComment and returns u
+func (u *AddFileMemberArgs) WithAddMessageAsComment(AddMessageAsComment bool) *AddFileMemberArgs {
+	u.AddMessageAsComment = AddMessageAsComment
+	return u
+}
+
+// WithQuiet sets Quiet and returns u
+func (u *AddFolderMemberArg) WithQuiet(Quiet bool) *AddFolderMemberArg {
+	u.Quiet = Quiet
+	return u
+}
+
+// WithCustomMessage sets CustomMessage and returns u
+func (u *AddFolderMemberArg) WithCustomMessage(CustomMessage string) *AddFolderMemberArg {
+	u.CustomMessage = CustomMessage
+	return u
+}
+
+// WithShortUrl sets ShortUrl and returns u
+func (u *CreateSharedLinkArg) WithShortUrl(ShortUrl bool) *CreateSharedLinkArg {
+	u.ShortUrl = ShortUrl
+	return u
+}
+
+// WithPendingUpload sets PendingUpload and returns u
+func (u *CreateSharedLinkArg) WithPendingUpload(PendingUpload *PendingUploadMode) *CreateSharedLinkArg {
+	u.PendingUpload = PendingUpload
+	return u
+}
+
+// WithSettings sets Settings and returns u
+func (u *CreateSharedLinkWithSettingsArg) WithSettings(Settings *SharedLinkSettings) *CreateSharedLinkWithSettingsArg {
+	u.Settings = Settings
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *GetFileMetadataArg) WithActions(Actions []*FileAction) *GetFileMetadataArg {
+	u.Actions = Actions
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *GetFileMetadataBatchArg) WithActions(Actions []*FileAction) *GetFileMetadataBatchArg {
+	u.Actions = Actions
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *GetMetadataArgs) WithActions(Actions []*FolderAction) *GetMetadataArgs {
+	u.Actions = Actions
+	return u
+}
+
+// WithPath sets Path and returns u
+func (u *GetSharedLinkMetadataArg) WithPath(Path string) *GetSharedLinkMetadataArg {
+	u.Path = Path
+	return u
+}
+
+// WithLinkPassword sets LinkPassword and returns u
+func (u *GetSharedLinkMetadataArg) WithLinkPassword(LinkPassword string) *GetSharedLinkMetadataArg {
+	u.LinkPassword = LinkPassword
+	return u
+}
+
+// WithPath sets Path and returns u
+func (u *GetSharedLinksArg) WithPath(Path string) *GetSharedLinksArg {
+	u.Path = Path
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *ListFileMembersArg) WithActions(Actions []*MemberAction) *ListFileMembersArg {
+	u.Actions = Actions
+	return u
+}
+
+// WithIncludeInherited sets IncludeInherited and returns u
+func (u *ListFileMembersArg) WithIncludeInherited(IncludeInherited bool) *ListFileMembersArg {
+	u.IncludeInherited = IncludeInherited
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFileMembersArg) WithLimit(Limit uint32) *ListFileMembersArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFileMembersBatchArg) WithLimit(Limit uint32) *ListFileMembersBatchArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFilesArg) WithLimit(Limit uint32) *ListFilesArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *ListFilesArg) WithActions(Actions []*FileAction) *ListFilesArg {
+	u.Actions = Actions
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *ListFolderMembersArgs) WithActions(Actions []*MemberAction) *ListFolderMembersArgs {
+	u.Actions = Actions
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFolderMembersArgs) WithLimit(Limit uint32) *ListFolderMembersArgs {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ListFoldersArgs) WithLimit(Limit uint32) *ListFoldersArgs {
+	u.Limit = Limit
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *ListFoldersArgs) WithActions(Actions []*FolderAction) *ListFoldersArgs {
+	u.Actions = Actions
+	return u
+}
+
+// WithPath sets Path and returns u
+func (u *ListSharedLinksArg) WithPath(Path string) *ListSharedLinksArg {
+	u.Path = Path
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *ListSharedLinksArg) WithCursor(Cursor string) *ListSharedLinksArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithDirectOnly sets DirectOnly and returns u
+func (u *ListSharedLinksArg) WithDirectOnly(DirectOnly bool) *ListSharedLinksArg {
+	u.DirectOnly = DirectOnly
+	return u
+}
+
+// WithRemoveExpiration sets RemoveExpiration and returns u
+func (u *ModifySharedLinkSettingsArgs) WithRemoveExpiration(RemoveExpiration bool) *ModifySharedLinkSettingsArgs {
+	u.RemoveExpiration = RemoveExpiration
+	return u
+}
+
+// WithLeaveACopy sets LeaveACopy and returns u
+func (u *RelinquishFolderMembershipArg) WithLeaveACopy(LeaveACopy bool) *RelinquishFolderMembershipArg {
+	u.LeaveACopy = LeaveACopy
+	return u
+}
+
+// WithAccessInheritance sets AccessInheritance and returns u
+func (u *SetAccessInheritanceArg) WithAccessInheritance(AccessInheritance *AccessInheritance) *SetAccessInheritanceArg {
+	u.AccessInheritance = AccessInheritance
+	return u
+}
+
+// WithAclUpdatePolicy sets AclUpdatePolicy and returns u
+func (u *ShareFolderArg) WithAclUpdatePolicy(AclUpdatePolicy *AclUpdatePolicy) *ShareFolderArg {
+	u.AclUpdatePolicy = AclUpdatePolicy
+	return u
+}
+
+// WithForceAsync sets ForceAsync and returns u
+func (u *ShareFolderArg) WithForceAsync(ForceAsync bool) *ShareFolderArg {
+	u.ForceAsync = ForceAsync
+	return u
+}
+
+// WithMemberPolicy sets MemberPolicy and returns u
+func (u *ShareFolderArg) WithMemberPolicy(MemberPolicy *MemberPolicy) *ShareFolderArg {
+	u.MemberPolicy = MemberPolicy
+	return u
+}
+
+// WithSharedLinkPolicy sets SharedLinkPolicy and returns u
+func (u *ShareFolderArg) WithSharedLinkPolicy(SharedLinkPolicy *SharedLinkPolicy) *ShareFolderArg {
+	u.SharedLinkPolicy = SharedLinkPolicy
+	return u
+}
+
+// WithViewerInfoPolicy sets ViewerInfoPolicy and returns u
+func (u *ShareFolderArg) WithViewerInfoPolicy(ViewerInfoPolicy *ViewerInfoPolicy) *ShareFolderArg {
+	u.ViewerInfoPolicy = ViewerInfoPolicy
+	return u
+}
+
+// WithAccessInheritance sets AccessInheritance and returns u
+func (u *ShareFolderArg) WithAccessInheritance(AccessInheritance *AccessInheritance) *ShareFolderArg {
+	u.AccessInheritance = AccessInheritance
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *ShareFolderArg) WithActions(Actions []*FolderAction) *ShareFolderArg {
+	u.Actions = Actions
+	return u
+}
+
+// WithLinkSettings sets LinkSettings and returns u
+func (u *ShareFolderArg) WithLinkSettings(LinkSettings *LinkSettings) *ShareFolderArg {
+	u.LinkSettings = LinkSettings
+	return u
+}
+
+// WithLeaveACopy sets LeaveACopy and returns u
+func (u *UnshareFolderArg) WithLeaveACopy(LeaveACopy bool) *UnshareFolderArg {
+	u.LeaveACopy = LeaveACopy
+	return u
+}
+
+// WithMemberPolicy sets MemberPolicy and returns u
+func (u *UpdateFolderPolicyArg) WithMemberPolicy(MemberPolicy *MemberPolicy) *UpdateFolderPolicyArg {
+	u.MemberPolicy = MemberPolicy
+	return u
+}
+
+// WithAclUpdatePolicy sets AclUpdatePolicy and returns u
+func (u *UpdateFolderPolicyArg) WithAclUpdatePolicy(AclUpdatePolicy *AclUpdatePolicy) *UpdateFolderPolicyArg {
+	u.AclUpdatePolicy = AclUpdatePolicy
+	return u
+}
+
+// WithViewerInfoPolicy sets ViewerInfoPolicy and returns u
+func (u *UpdateFolderPolicyArg) WithViewerInfoPolicy(ViewerInfoPolicy *ViewerInfoPolicy) *UpdateFolderPolicyArg {
+	u.ViewerInfoPolicy = ViewerInfoPolicy
+	return u
+}
+
+// WithSharedLinkPolicy sets SharedLinkPolicy and returns u
+func (u *UpdateFolderPolicyArg) WithSharedLinkPolicy(SharedLinkPolicy *SharedLinkPolicy) *UpdateFolderPolicyArg {
+	u.SharedLinkPolicy = SharedLinkPolicy
+	return u
+}
+
+// WithLinkSettings sets LinkSettings and returns u
+func (u *UpdateFolderPolicyArg) WithLinkSettings(LinkSettings *LinkSettings) *UpdateFolderPolicyArg {
+	u.LinkSettings = LinkSettings
+	return u
+}
+
+// WithActions sets Actions and returns u
+func (u *UpdateFolderPolicyArg) WithActions(Actions []*FolderAction) *UpdateFolderPolicyArg {
+	u.Actions = Actions
+	return u
+}
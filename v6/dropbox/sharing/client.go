@@ -25,7 +25,6 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"log"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
@@ -260,6 +259,11 @@ type AddFileMemberAPIError struct {
 	EndpointError *AddFileMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AddFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -302,6 +306,11 @@ type AddFolderMemberAPIError struct {
 	EndpointError *AddFolderMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e AddFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -340,6 +349,11 @@ type CheckJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CheckJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -382,6 +396,11 @@ type CheckRemoveMemberJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CheckRemoveMemberJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -424,6 +443,11 @@ type CheckShareJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CheckShareJobStatusAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -466,9 +490,13 @@ type CreateSharedLinkAPIError struct {
 	EndpointError *CreateSharedLinkError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateSharedLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
-	log.Printf("WARNING: API `CreateSharedLink` is deprecated")
-	log.Printf("Use API `CreateSharedLinkWithSettings` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("CreateSharedLink", "CreateSharedLinkWithSettings")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -511,6 +539,11 @@ type CreateSharedLinkWithSettingsAPIError struct {
 	EndpointError *CreateSharedLinkWithSettingsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e CreateSharedLinkWithSettingsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -561,6 +594,11 @@ type GetFileMetadataAPIError struct {
 	EndpointError *GetFileMetadataError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetFileMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -603,6 +641,11 @@ type GetFileMetadataBatchAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetFileMetadataBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -645,6 +688,11 @@ type GetFolderMetadataAPIError struct {
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetFolderMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -687,6 +735,11 @@ type GetSharedLinkFileAPIError struct {
 	EndpointError *GetSharedLinkFileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetSharedLinkFileAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
@@ -695,7 +748,7 @@ func (dbx *apiImpl) GetSharedLinkFileContext(ctx context.Context, arg *GetShared
 		Auth:         "user",
 		Style:        "download",
 		Arg:          arg,
-		ExtraHeaders: nil,
+		ExtraHeaders: arg.ExtraHeaders,
 	}
 
 	var resp []byte
@@ -737,6 +790,11 @@ type GetSharedLinkMetadataAPIError struct {
 	EndpointError *SharedLinkError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetSharedLinkMetadataAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -787,9 +845,13 @@ type GetSharedLinksAPIError struct {
 	EndpointError *GetSharedLinksError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetSharedLinksAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
-	log.Printf("WARNING: API `GetSharedLinks` is deprecated")
-	log.Printf("Use API `ListSharedLinks` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("GetSharedLinks", "ListSharedLinks")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -832,6 +894,11 @@ type ListFileMembersAPIError struct {
 	EndpointError *ListFileMembersError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFileMembersAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -874,6 +941,11 @@ type ListFileMembersBatchAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFileMembersBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -916,6 +988,11 @@ type ListFileMembersContinueAPIError struct {
 	EndpointError *ListFileMembersContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFileMembersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -958,6 +1035,11 @@ type ListFolderMembersAPIError struct {
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderMembersAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1000,6 +1082,11 @@ type ListFolderMembersContinueAPIError struct {
 	EndpointError *ListFolderMembersContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFolderMembersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1042,6 +1129,11 @@ type ListFoldersAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFoldersAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1084,6 +1176,11 @@ type ListFoldersContinueAPIError struct {
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListFoldersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1126,6 +1223,11 @@ type ListMountableFoldersAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListMountableFoldersAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1168,6 +1270,11 @@ type ListMountableFoldersContinueAPIError struct {
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListMountableFoldersContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1210,6 +1317,11 @@ type ListReceivedFilesAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListReceivedFilesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg) (res *ListFilesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1252,6 +1364,11 @@ type ListReceivedFilesContinueAPIError struct {
 	EndpointError *ListFilesContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListReceivedFilesContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1294,6 +1411,11 @@ type ListSharedLinksAPIError struct {
 	EndpointError *ListSharedLinksError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ListSharedLinksAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1336,6 +1458,11 @@ type ModifySharedLinkSettingsAPIError struct {
 	EndpointError *ModifySharedLinkSettingsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ModifySharedLinkSettingsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1386,6 +1513,11 @@ type MountFolderAPIError struct {
 	EndpointError *MountFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MountFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MountFolderContext(ctx context.Context, arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1428,6 +1560,11 @@ type RelinquishFileMembershipAPIError struct {
 	EndpointError *RelinquishFileMembershipError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RelinquishFileMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1466,6 +1603,11 @@ type RelinquishFolderMembershipAPIError struct {
 	EndpointError *RelinquishFolderMembershipError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RelinquishFolderMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1508,9 +1650,13 @@ type RemoveFileMemberAPIError struct {
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RemoveFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
-	log.Printf("WARNING: API `RemoveFileMember` is deprecated")
-	log.Printf("Use API `RemoveFileMember2` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("RemoveFileMember", "RemoveFileMember2")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -1553,6 +1699,11 @@ type RemoveFileMember2APIError struct {
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RemoveFileMember2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1595,6 +1746,11 @@ type RemoveFolderMemberAPIError struct {
 	EndpointError *RemoveFolderMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RemoveFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1637,6 +1793,11 @@ type RevokeSharedLinkAPIError struct {
 	EndpointError *RevokeSharedLinkError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e RevokeSharedLinkAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1675,6 +1836,11 @@ type SetAccessInheritanceAPIError struct {
 	EndpointError *SetAccessInheritanceError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e SetAccessInheritanceAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) SetAccessInheritanceContext(ctx context.Context, arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1717,6 +1883,11 @@ type ShareFolderAPIError struct {
 	EndpointError *ShareFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ShareFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ShareFolderContext(ctx context.Context, arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1759,6 +1930,11 @@ type TransferFolderAPIError struct {
 	EndpointError *TransferFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TransferFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TransferFolderContext(ctx context.Context, arg *TransferFolderArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1797,6 +1973,11 @@ type UnmountFolderAPIError struct {
 	EndpointError *UnmountFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UnmountFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1835,6 +2016,11 @@ type UnshareFileAPIError struct {
 	EndpointError *UnshareFileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UnshareFileAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UnshareFileContext(ctx context.Context, arg *UnshareFileArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1873,6 +2059,11 @@ type UnshareFolderAPIError struct {
 	EndpointError *UnshareFolderError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UnshareFolderAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1915,6 +2106,11 @@ type UpdateFileMemberAPIError struct {
 	EndpointError *FileMemberActionError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UpdateFileMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1957,6 +2153,11 @@ type UpdateFolderMemberAPIError struct {
 	EndpointError *UpdateFolderMemberError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UpdateFolderMemberAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1999,6 +2200,11 @@ type UpdateFolderPolicyAPIError struct {
 	EndpointError *UpdateFolderPolicyError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e UpdateFolderPolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -0,0 +1,155 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// CloneMembershipsOptions controls how CloneFolderMemberships re-creates
+// shared folders.
+type CloneMembershipsOptions struct {
+	// MapPath returns the path of the destination account's copy of a shared
+	// folder of the source account. Folders for which it returns "" are
+	// skipped. Defaults to the folder's path in the source account; folders
+	// that are not mounted in the source account are then skipped.
+	MapPath func(folder *SharedFolderMetadata) string
+	// ExcludeAccountIds lists accounts that are not added as members,
+	// typically the source and destination accounts themselves.
+	ExcludeAccountIds []string
+	// Quiet suppresses the notification emails sent to added members.
+	Quiet bool
+}
+
+// ClonedFolder is the outcome of cloning a single shared folder.
+type ClonedFolder struct {
+	// Source is the shared folder in the source account.
+	Source *SharedFolderMetadata
+	// Path is the path of the copy in the destination account.
+	Path string
+	// Target is the shared folder created in the destination account, nil if
+	// sharing failed.
+	Target *SharedFolderMetadata
+	// Members are the members added to Target.
+	Members []*AddMember
+	// Err is the first error encountered for this folder, if any.
+	Err error
+}
+
+// CloneFolderMemberships reads every shared folder of the src account with
+// its explicit (non-inherited) members and re-creates an equivalent share in
+// the dst account for the copy of that folder. The owner of a source folder
+// is added as an editor. Errors for individual folders are reported in the
+// corresponding ClonedFolder; the returned error is only set if the shared
+// folders of the source account could not be listed.
+func CloneFolderMemberships(ctx context.Context, src Client, dst Client, opts *CloneMembershipsOptions) (res []*ClonedFolder, err error) {
+	if opts == nil {
+		opts = &CloneMembershipsOptions{}
+	}
+	mapPath := opts.MapPath
+	if mapPath == nil {
+		mapPath = func(folder *SharedFolderMetadata) string {
+			return folder.PathLower
+		}
+	}
+	exclude := make(map[string]bool, len(opts.ExcludeAccountIds))
+	for _, id := range opts.ExcludeAccountIds {
+		exclude[id] = true
+	}
+
+	it := NewListFoldersIterator(src, NewListFoldersArgs())
+	for it.HasMore() {
+		var page *ListFoldersResult
+		if page, err = it.Next(ctx); err != nil {
+			return
+		}
+		for _, folder := range page.Entries {
+			path := mapPath(folder)
+			if path == "" {
+				continue
+			}
+			clone := &ClonedFolder{Source: folder, Path: path}
+			res = append(res, clone)
+			if clone.Members, clone.Err = cloneMembers(ctx, src, folder, exclude); clone.Err != nil {
+				continue
+			}
+			clone.Target, clone.Err = shareClone(ctx, dst, folder, path, clone.Members, opts.Quiet)
+		}
+	}
+	return res, nil
+}
+
+// cloneMembers lists the explicit members of folder as AddMember values.
+func cloneMembers(ctx context.Context, client Client, folder *SharedFolderMetadata, exclude map[string]bool) ([]*AddMember, error) {
+	var members []*AddMember
+	add := func(selector *MemberSelector, info *MembershipInfo) {
+		if info.IsInherited {
+			return
+		}
+		m := NewAddMember(selector)
+		m.AccessLevel = info.AccessType
+		if info.AccessType != nil && info.AccessType.Tag == AccessLevelOwner {
+			m.AccessLevel = &AccessLevel{Tagged: dropbox.Tagged{Tag: AccessLevelEditor}}
+		}
+		members = append(members, m)
+	}
+
+	it := NewListFolderMembersIterator(client, NewListFolderMembersArgs(folder.SharedFolderId))
+	for it.HasMore() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range page.Users {
+			if exclude[u.User.AccountId] {
+				continue
+			}
+			add(&MemberSelector{Tagged: dropbox.Tagged{Tag: MemberSelectorDropboxId}, DropboxId: u.User.AccountId}, &u.MembershipInfo)
+		}
+		for _, g := range page.Groups {
+			add(&MemberSelector{Tagged: dropbox.Tagged{Tag: MemberSelectorDropboxId}, DropboxId: g.Group.GroupId}, &g.MembershipInfo)
+		}
+		for _, i := range page.Invitees {
+			if i.Invitee == nil || i.Invitee.Tag != InviteeInfoEmail {
+				continue
+			}
+			add(&MemberSelector{Tagged: dropbox.Tagged{Tag: MemberSelectorEmail}, Email: i.Invitee.Email}, &i.MembershipInfo)
+		}
+	}
+	return members, nil
+}
+
+// shareClone shares path in the client's account with the policies of
+// folder, unless it is already shared, and adds members to it.
+func shareClone(ctx context.Context, client Client, folder *SharedFolderMetadata, path string, members []*AddMember, quiet bool) (*SharedFolderMetadata, error) {
+	arg := NewShareFolderArg(path)
+	if folder.Policy != nil {
+		arg.MemberPolicy = folder.Policy.MemberPolicy
+		arg.AclUpdatePolicy = folder.Policy.AclUpdatePolicy
+		arg.SharedLinkPolicy = folder.Policy.SharedLinkPolicy
+		arg.ViewerInfoPolicy = folder.Policy.ViewerInfoPolicy
+	}
+	if folder.AccessInheritance != nil {
+		arg.AccessInheritance = folder.AccessInheritance
+	}
+
+	target, err := ShareFolderAndWait(ctx, client, arg, nil)
+	if err != nil {
+		apiErr, ok := err.(ShareFolderAPIError)
+		if !ok || apiErr.EndpointError == nil || apiErr.EndpointError.BadPath == nil ||
+			apiErr.EndpointError.BadPath.Tag != SharePathErrorAlreadyShared {
+			return nil, err
+		}
+		target = apiErr.EndpointError.BadPath.AlreadyShared
+		if target == nil {
+			return nil, errUnexpectedJobResult
+		}
+	}
+
+	if len(members) == 0 {
+		return target, nil
+	}
+	add := NewAddFolderMemberArg(target.SharedFolderId, members)
+	add.Quiet = quiet
+	return target, client.AddFolderMemberContext(ctx, add)
+}
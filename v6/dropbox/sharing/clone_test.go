@@ -0,0 +1,126 @@
+package sharing_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestCloneFolderMemberships(t *testing.T) {
+	access := func(tag string) *sharing.AccessLevel {
+		return &sharing.AccessLevel{Tagged: dropbox.Tagged{Tag: tag}}
+	}
+	folder := func(id string, path string) *sharing.SharedFolderMetadata {
+		f := &sharing.SharedFolderMetadata{SharedFolderId: id, Name: path}
+		f.PathLower = path
+		return f
+	}
+	user := func(id string, level string, inherited bool) *sharing.UserMembershipInfo {
+		u := sharing.NewUserMembershipInfo(access(level), &sharing.UserInfo{AccountId: id})
+		u.IsInherited = inherited
+		return u
+	}
+
+	docs := folder("src1", "/docs")
+	docs.Policy = &sharing.FolderPolicy{MemberPolicy: &sharing.MemberPolicy{Tagged: dropbox.Tagged{Tag: sharing.MemberPolicyTeam}}}
+	group := sharing.NewGroupMembershipInfo(access(sharing.AccessLevelEditor), &sharing.GroupInfo{})
+	group.Group.GroupId = "g1"
+	members := map[string]*sharing.SharedFolderMembers{
+		"src1": {
+			Users: []*sharing.UserMembershipInfo{
+				user("me", sharing.AccessLevelOwner, false),
+				user("u1", sharing.AccessLevelViewer, false),
+				user("u2", sharing.AccessLevelEditor, true),
+			},
+			Groups: []*sharing.GroupMembershipInfo{group},
+			Invitees: []*sharing.InviteeMembershipInfo{
+				sharing.NewInviteeMembershipInfo(access(sharing.AccessLevelViewer),
+					&sharing.InviteeInfo{Tagged: dropbox.Tagged{Tag: sharing.InviteeInfoEmail}, Email: "x@example.com"}),
+				sharing.NewInviteeMembershipInfo(access(sharing.AccessLevelViewer),
+					&sharing.InviteeInfo{Tagged: dropbox.Tagged{Tag: "other"}}),
+			},
+		},
+		"src2": {Users: []*sharing.UserMembershipInfo{user("u3", sharing.AccessLevelOwner, false)}},
+	}
+	src := &sharing.Mock{
+		ListFoldersFunc: func(ctx context.Context, arg *sharing.ListFoldersArgs) (*sharing.ListFoldersResult, error) {
+			// The unmounted folder is skipped.
+			return sharing.NewListFoldersResult([]*sharing.SharedFolderMetadata{
+				docs, folder("src2", "/photos"), folder("src3", ""), folder("src4", "/broken"),
+			}), nil
+		},
+		ListFolderMembersFunc: func(ctx context.Context, arg *sharing.ListFolderMembersArgs) (*sharing.SharedFolderMembers, error) {
+			if res, ok := members[arg.SharedFolderId]; ok {
+				return res, nil
+			}
+			return nil, errors.New("list failed")
+		},
+	}
+
+	var shared []string
+	added := map[string]*sharing.AddFolderMemberArg{}
+	dst := &sharing.Mock{
+		ShareFolderFunc: func(ctx context.Context, arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error) {
+			shared = append(shared, arg.Path)
+			if arg.Path == "/photos" {
+				return nil, sharing.ShareFolderAPIError{EndpointError: &sharing.ShareFolderError{
+					Tagged: dropbox.Tagged{Tag: sharing.ShareFolderErrorBadPath},
+					BadPath: &sharing.SharePathError{
+						Tagged:        dropbox.Tagged{Tag: sharing.SharePathErrorAlreadyShared},
+						AlreadyShared: folder("dst2", "/photos"),
+					},
+				}}
+			}
+			if arg.MemberPolicy == nil || arg.MemberPolicy.Tag != sharing.MemberPolicyTeam {
+				t.Errorf("Unexpected member policy: %+v\n", arg.MemberPolicy)
+			}
+			return &sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderLaunchComplete},
+				Complete: folder("dst1", arg.Path)}, nil
+		},
+		AddFolderMemberFunc: func(ctx context.Context, arg *sharing.AddFolderMemberArg) error {
+			added[arg.SharedFolderId] = arg
+			return nil
+		},
+	}
+
+	res, err := sharing.CloneFolderMemberships(context.Background(), src, dst,
+		&sharing.CloneMembershipsOptions{ExcludeAccountIds: []string{"me"}, Quiet: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 || !reflect.DeepEqual(shared, []string{"/docs", "/photos"}) {
+		t.Fatalf("Unexpected clones: %d %v\n", len(res), shared)
+	}
+
+	// Newly shared target.
+	if res[0].Err != nil || res[0].Target == nil || res[0].Target.SharedFolderId != "dst1" {
+		t.Errorf("Unexpected clone of /docs: %+v\n", res[0])
+	}
+	var got []string
+	for _, m := range added["dst1"].Members {
+		id := m.Member.DropboxId + m.Member.Email
+		got = append(got, id+":"+m.AccessLevel.Tag)
+	}
+	want := []string{"u1:viewer", "g1:editor", "x@example.com:viewer"}
+	if !reflect.DeepEqual(got, want) || !added["dst1"].Quiet {
+		t.Errorf("Unexpected members of /docs: %v\n", got)
+	}
+
+	// Already shared target, the owner being added as an editor.
+	if res[1].Err != nil || res[1].Target == nil || res[1].Target.SharedFolderId != "dst2" {
+		t.Errorf("Unexpected clone of /photos: %+v\n", res[1])
+	}
+	if a := added["dst2"]; a == nil || len(a.Members) != 1 || a.Members[0].Member.DropboxId != "u3" ||
+		a.Members[0].AccessLevel.Tag != sharing.AccessLevelEditor {
+		t.Errorf("Unexpected members of /photos: %+v\n", a)
+	}
+
+	// A folder whose members can't be listed isn't shared.
+	if res[2].Err == nil || res[2].Target != nil {
+		t.Errorf("Unexpected clone of /broken: %+v\n", res[2])
+	}
+}
@@ -0,0 +1,65 @@
+package sharing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestStreamSharedLinkFile(t *testing.T) {
+	const size = 1<<20 + 7
+	content := bytes.Repeat([]byte("0123456789abcdef"), size/16+1)[:size]
+
+	var ranges, passwords []string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var arg sharing.GetSharedLinkMetadataArg
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+			passwords = append(passwords, arg.LinkPassword)
+			ranges = append(ranges, r.Header.Get("Range"))
+			w.Header().Set("Dropbox-API-Result", fmt.Sprintf(`{".tag": "file", "url": %q, "name": "a.bin",
+				"link_permissions": {"can_revoke": false}, "client_modified": "2024-01-01T00:00:00Z",
+				"server_modified": "2024-01-01T00:00:00Z", "rev": "0123456789a", "size": %d}`, arg.Url, size))
+			if len(ranges) == 1 {
+				w.Header().Set("Content-Length", fmt.Sprint(size))
+				_, _ = w.Write(content[:1<<19])
+				return
+			}
+			var from int
+			_, _ = fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &from)
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(content[from:])
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	var buf bytes.Buffer
+	d := files.NewDownloader(files.New(config))
+	_, report, err := sharing.StreamSharedLinkFile(context.Background(), d, sharing.New(config), "https://db.tt/a", "", "secret", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), content) || report.Retries() != 1 {
+		t.Errorf("Unexpected transfer: %d %+v\n", buf.Len(), report)
+	}
+	if strings.Join(ranges, ",") != fmt.Sprintf(",bytes=%d-", 1<<19) || passwords[1] != "secret" {
+		t.Errorf("Unexpected requests: %v %v\n", ranges, passwords)
+	}
+
+	arg := sharing.NewSharedLinkFileArg("https://db.tt/a", &sharing.SharedLinkFileOptions{Path: "/b", Offset: 10, Length: 5})
+	if arg.ExtraHeaders["Range"] != "bytes=10-14" || arg.Path != "/b" {
+		t.Errorf("Unexpected arg: %+v\n", arg)
+	}
+}
@@ -0,0 +1,58 @@
+package sharing
+
+import (
+	"context"
+)
+
+// EnsureLinkOptions controls EnsureSharedLink.
+type EnsureLinkOptions struct {
+	// Settings are the settings of the link if it is created.
+	Settings *SharedLinkSettings
+	// Reconcile applies Settings to the link if it already exists, except
+	// for its access level which can't be changed.
+	Reconcile bool
+}
+
+// EnsureSharedLink returns a shared link to the file or folder at path,
+// creating it with `create_shared_link_with_settings` if there is none.
+// When the link already exists and Dropbox doesn't return it along with the
+// shared_link_already_exists error, it is fetched with `list_shared_links`.
+func EnsureSharedLink(ctx context.Context, client Client, path string, opts *EnsureLinkOptions) (IsSharedLinkMetadata, error) {
+	if opts == nil {
+		opts = &EnsureLinkOptions{}
+	}
+	arg := NewCreateSharedLinkWithSettingsArg(path)
+	arg.Settings = opts.Settings
+	link, err := client.CreateSharedLinkWithSettingsContext(ctx, arg)
+	if err == nil {
+		return link, nil
+	}
+
+	apiErr, ok := err.(CreateSharedLinkWithSettingsAPIError)
+	if !ok || apiErr.EndpointError == nil || apiErr.EndpointError.Tag != CreateSharedLinkWithSettingsErrorSharedLinkAlreadyExists {
+		return nil, err
+	}
+	var existing IsSharedLinkMetadata
+	if apiErr.EndpointError.SharedLinkAlreadyExists != nil {
+		existing = apiErr.EndpointError.SharedLinkAlreadyExists.Metadata
+	}
+	if existing == nil {
+		list := NewListSharedLinksArg()
+		list.Path = path
+		list.DirectOnly = true
+		res, lerr := client.ListSharedLinksContext(ctx, list)
+		if lerr != nil {
+			return nil, lerr
+		}
+		if len(res.Links) == 0 {
+			return nil, err
+		}
+		existing = res.Links[0]
+	}
+	if !opts.Reconcile || opts.Settings == nil {
+		return existing, nil
+	}
+	update := *opts.Settings
+	update.Access = nil
+	return client.ModifySharedLinkSettingsContext(ctx, NewModifySharedLinkSettingsArgs(SharedLinkBase(existing).Url, &update))
+}
@@ -0,0 +1,61 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestEnsureSharedLink(t *testing.T) {
+	var listArg sharing.ListSharedLinksArg
+	var modified map[string]interface{}
+	link := `{".tag": "file", "url": "https://db.tt/a", "name": "a.txt", "path_lower": "/a.txt",
+		"link_permissions": {"can_revoke": true, "resolved_visibility": {".tag": "public"}},
+		"client_modified": "2024-01-01T00:00:00Z", "server_modified": "2024-01-01T00:00:00Z", "rev": "a1b2c3d4e5", "size": 1}`
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/sharing/create_shared_link_with_settings":
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "shared_link_already_exists/..", "error": {".tag": "shared_link_already_exists"}}`))
+			case "/sharing/list_shared_links":
+				_ = json.NewDecoder(r.Body).Decode(&listArg)
+				_, _ = w.Write([]byte(`{"links": [` + link + `], "has_more": false}`))
+			case "/sharing/modify_shared_link_settings":
+				_ = json.NewDecoder(r.Body).Decode(&modified)
+				_, _ = w.Write([]byte(link))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	res, err := sharing.EnsureSharedLink(context.Background(), dbx, "/a.txt", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sharing.SharedLinkBase(res).Url != "https://db.tt/a" || listArg.Path != "/a.txt" || !listArg.DirectOnly || modified != nil {
+		t.Errorf("Unexpected link: %+v %+v\n", res, listArg)
+	}
+
+	settings := sharing.NewSharedLinkSettings()
+	settings.AllowDownload = true
+	settings.Access = &sharing.RequestedLinkAccessLevel{Tagged: dropbox.Tagged{Tag: sharing.RequestedLinkAccessLevelEditor}}
+	_, err = sharing.EnsureSharedLink(context.Background(), dbx, "/a.txt", &sharing.EnsureLinkOptions{Settings: settings, Reconcile: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	update, _ := modified["settings"].(map[string]interface{})
+	if modified["url"] != "https://db.tt/a" || update["allow_download"] != true || update["access"] != nil || settings.Access == nil {
+		t.Errorf("Unexpected update: %v\n", modified)
+	}
+}
@@ -0,0 +1,112 @@
+package sharing
+
+import "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+
+// Constructors of the unions used to build folder membership and policy
+// arguments, so that callers don't have to spell out dropbox.Tagged:
+//
+//	m := sharing.NewAddMember(sharing.NewEmailMemberSelector("ann@example.com"))
+//	m.AccessLevel = sharing.NewAccessLevel(sharing.AccessLevelEditor)
+//
+//	arg := sharing.NewUpdateFolderPolicyArg(id)
+//	arg.MemberPolicy = sharing.NewMemberPolicy(sharing.MemberPolicyTeam)
+
+// NewAccessLevel returns an AccessLevel with tag, one of the AccessLevel
+// constants.
+func NewAccessLevel(tag string) *AccessLevel {
+	return &AccessLevel{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewAclUpdatePolicy returns an AclUpdatePolicy with tag, one of the
+// AclUpdatePolicy constants.
+func NewAclUpdatePolicy(tag string) *AclUpdatePolicy {
+	return &AclUpdatePolicy{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewMemberPolicy returns a MemberPolicy with tag, one of the MemberPolicy
+// constants.
+func NewMemberPolicy(tag string) *MemberPolicy {
+	return &MemberPolicy{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewSharedLinkPolicy returns a SharedLinkPolicy with tag, one of the
+// SharedLinkPolicy constants.
+func NewSharedLinkPolicy(tag string) *SharedLinkPolicy {
+	return &SharedLinkPolicy{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewViewerInfoPolicy returns a ViewerInfoPolicy with tag, one of the
+// ViewerInfoPolicy constants.
+func NewViewerInfoPolicy(tag string) *ViewerInfoPolicy {
+	return &ViewerInfoPolicy{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewAccessInheritance returns an AccessInheritance with tag, one of the
+// AccessInheritance constants.
+func NewAccessInheritance(tag string) *AccessInheritance {
+	return &AccessInheritance{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewFolderAction returns a FolderAction with tag, one of the FolderAction
+// constants.
+func NewFolderAction(tag string) *FolderAction {
+	return &FolderAction{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+// NewEmailMemberSelector returns a MemberSelector of the account with the
+// given email, which may not have a Dropbox account yet.
+func NewEmailMemberSelector(email string) *MemberSelector {
+	return &MemberSelector{Tagged: dropbox.Tagged{Tag: MemberSelectorEmail}, Email: email}
+}
+
+// NewDropboxIdMemberSelector returns a MemberSelector of the account, team
+// member or group with the given ID.
+func NewDropboxIdMemberSelector(id string) *MemberSelector {
+	return &MemberSelector{Tagged: dropbox.Tagged{Tag: MemberSelectorDropboxId}, DropboxId: id}
+}
+
+// accessRanks orders the access levels granting access to the content of
+// a folder.
+var accessRanks = map[string]int{
+	AccessLevelViewerNoComment: 1,
+	AccessLevelViewer:          2,
+	AccessLevelEditor:          3,
+	AccessLevelOwner:           4,
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *AccessLevel) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
+
+// AtLeast reports whether u grants at least the access of the access level
+// tag, e.g. AtLeast(AccessLevelEditor) is true for editors and owners.
+// Traverse and unknown access levels grant no access.
+func (u *AccessLevel) AtLeast(tag string) bool {
+	return u != nil && accessRanks[u.Tag] > 0 && accessRanks[u.Tag] >= accessRanks[tag]
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *AclUpdatePolicy) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *MemberPolicy) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *SharedLinkPolicy) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *ViewerInfoPolicy) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
+
+// Is reports whether u has tag. It is false if u is nil.
+func (u *AccessInheritance) Is(tag string) bool {
+	return u != nil && u.Tag == tag
+}
@@ -0,0 +1,28 @@
+package sharing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestSharingEnums(t *testing.T) {
+	m := sharing.NewAddMember(sharing.NewEmailMemberSelector("ann@example.com"))
+	m.AccessLevel = sharing.NewAccessLevel(sharing.AccessLevelEditor)
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"member":{".tag":"email","email":"ann@example.com"},"access_level":{".tag":"editor"}}` {
+		t.Errorf("Unexpected JSON: %s\n", b)
+	}
+	if !m.AccessLevel.AtLeast(sharing.AccessLevelViewer) || m.AccessLevel.AtLeast(sharing.AccessLevelOwner) ||
+		sharing.NewAccessLevel(sharing.AccessLevelTraverse).AtLeast(sharing.AccessLevelViewerNoComment) {
+		t.Errorf("Unexpected access level comparison\n")
+	}
+	var policy *sharing.MemberPolicy
+	if policy.Is(sharing.MemberPolicyTeam) || !sharing.NewMemberPolicy(sharing.MemberPolicyTeam).Is(sharing.MemberPolicyTeam) {
+		t.Errorf("Unexpected policy comparison\n")
+	}
+}
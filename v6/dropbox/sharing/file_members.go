@@ -0,0 +1,114 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// defaultMembersPerRequest is the number of members added to a file per
+// `add_file_member` request, by default.
+const defaultMembersPerRequest = 20
+
+var errUnexpectedBatchResult = errors.New("unexpected batch result")
+
+// ShareFilesOptions controls ShareFiles.
+type ShareFilesOptions struct {
+	// AccessLevel is the access granted to the members. Defaults to
+	// AccessLevelViewer.
+	AccessLevel *AccessLevel
+	// CustomMessage is included in the invitation emails.
+	CustomMessage string
+	// Quiet suppresses the invitation emails.
+	Quiet bool
+	// MembersPerRequest is the number of members added per request.
+	// Defaults to 20.
+	MembersPerRequest int
+	// Concurrency is the number of requests in flight. Defaults to 4.
+	Concurrency int
+}
+
+// FileMemberOutcome is the result of adding a single member to a single
+// file.
+type FileMemberOutcome struct {
+	File   string
+	Member *MemberSelector
+	// AccessLevel is the access the member was granted.
+	AccessLevel *AccessLevel
+	// Failure tells why Dropbox didn't add the member.
+	Failure *FileMemberActionError
+	// Err is set if the request adding the member failed as a whole.
+	Err error
+}
+
+// ShareFiles adds members to every file of files, a list of paths or file
+// IDs, splitting the members of each file into requests of
+// opts.MembersPerRequest members, with up to opts.Concurrency requests in
+// flight. Failures don't stop the other files or members from being shared;
+// every (file, member) pair gets an outcome, in the order of files then
+// members.
+func ShareFiles(ctx context.Context, client Client, files []string, members []*MemberSelector, opts *ShareFilesOptions) []*FileMemberOutcome {
+	if opts == nil {
+		opts = &ShareFilesOptions{}
+	}
+	perRequest := opts.MembersPerRequest
+	if perRequest <= 0 {
+		perRequest = defaultMembersPerRequest
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+	level := opts.AccessLevel
+	if level == nil {
+		level = NewAccessLevel(AccessLevelViewer)
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	outcomes := make([]*FileMemberOutcome, 0, len(files)*len(members))
+	for _, file := range files {
+		for _, m := range members {
+			outcomes = append(outcomes, &FileMemberOutcome{File: file, Member: m})
+		}
+	}
+
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < len(outcomes); i += len(members) {
+		for start := 0; start < len(members); start += perRequest {
+			end := start + perRequest
+			if end > len(members) {
+				end = len(members)
+			}
+			chunk := outcomes[i+start : i+end]
+			arg := NewAddFileMemberArgs(chunk[0].File, members[start:end])
+			arg.AccessLevel = level
+			arg.CustomMessage = opts.CustomMessage
+			arg.Quiet = opts.Quiet
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer func() { <-sem; wg.Done() }()
+				res, err := client.AddFileMemberContext(ctx, arg)
+				if err == nil && len(res) != len(chunk) {
+					err = errUnexpectedBatchResult
+				}
+				for j, o := range chunk {
+					if err != nil {
+						o.Err = err
+						continue
+					}
+					if r := res[j].Result; r != nil && r.Tag == FileMemberActionIndividualResultMemberError {
+						o.Failure = r.MemberError
+					} else if r != nil {
+						o.AccessLevel = r.Success
+					}
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	return outcomes
+}
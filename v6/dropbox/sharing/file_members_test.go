@@ -0,0 +1,80 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestShareFiles(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg struct {
+				File    string `json:"file"`
+				Members []struct {
+					Email string `json:"email"`
+				} `json:"members"`
+				AccessLevel struct {
+					Tag string `json:".tag"`
+				} `json:"access_level"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			mu.Lock()
+			requests[arg.File]++
+			mu.Unlock()
+			if arg.File == "/locked.txt" {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "access_error/no_permission/", "error": {".tag": "access_error", "access_error": {".tag": "no_permission"}}}`))
+				return
+			}
+			var results []string
+			for _, m := range arg.Members {
+				result := fmt.Sprintf(`{".tag": "success", "success": {".tag": %q}}`, arg.AccessLevel.Tag)
+				if m.Email == "bad@example.com" {
+					result = `{".tag": "member_error", "member_error": {".tag": "invalid_member"}}`
+				}
+				results = append(results, fmt.Sprintf(`{"member": {".tag": "email", "email": %q}, "result": %s}`, m.Email, result))
+			}
+			_, _ = w.Write([]byte(`[` + strings.Join(results, ",") + `]`))
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	var members []*sharing.MemberSelector
+	for _, email := range []string{"a@example.com", "bad@example.com", "c@example.com"} {
+		members = append(members, &sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorEmail}, Email: email})
+	}
+	res := sharing.ShareFiles(context.Background(), sharing.New(config), []string{"/a.txt", "/locked.txt"}, members,
+		&sharing.ShareFilesOptions{MembersPerRequest: 2})
+	if len(res) != 6 || requests["/a.txt"] != 2 || requests["/locked.txt"] != 2 {
+		t.Fatalf("Unexpected outcomes: %d %v\n", len(res), requests)
+	}
+	if res[0].AccessLevel == nil || res[0].AccessLevel.Tag != sharing.AccessLevelViewer || res[0].Err != nil {
+		t.Errorf("Unexpected outcome: %+v\n", res[0])
+	}
+	if res[1].Failure == nil || res[1].Failure.Tag != sharing.FileMemberActionErrorInvalidMember || res[1].Member.Email != "bad@example.com" {
+		t.Errorf("Unexpected outcome: %+v\n", res[1])
+	}
+	if res[2].AccessLevel == nil || res[2].Member.Email != "c@example.com" {
+		t.Errorf("Unexpected outcome: %+v\n", res[2])
+	}
+	for _, o := range res[3:] {
+		if o.File != "/locked.txt" || o.Err == nil {
+			t.Errorf("Unexpected outcome: %+v\n", o)
+		}
+	}
+}
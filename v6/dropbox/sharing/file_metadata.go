@@ -0,0 +1,87 @@
+package sharing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// FileMetadataOptions controls GetFilesMetadata.
+type FileMetadataOptions struct {
+	// Actions are the actions whose permissions are returned in the
+	// Permissions of the metadata.
+	Actions []*FileAction
+	// BatchSize is the number of files per request. Defaults to, and is
+	// capped at, dropbox.MaxFileMetadataBatch.
+	BatchSize int
+	// Concurrency is the number of requests in flight. Defaults to 4.
+	Concurrency int
+}
+
+// GetFilesMetadata returns the shared metadata of files, a list of paths or
+// file IDs of any length, with `get_file_metadata/batch` requests of
+// opts.BatchSize files, up to opts.Concurrency of them in flight. The
+// results are in the order of files, a file the user can't access getting
+// a result with an AccessError. If a request fails, the others are
+// cancelled and the results are returned along with the error, those of
+// the files of the failed and cancelled requests being nil.
+func GetFilesMetadata(ctx context.Context, client Client, files []string, opts *FileMetadataOptions) ([]*GetFileMetadataBatchResult, error) {
+	if opts == nil {
+		opts = &FileMetadataOptions{}
+	}
+	size := opts.BatchSize
+	if size <= 0 || size > dropbox.MaxFileMetadataBatch {
+		size = dropbox.MaxFileMetadataBatch
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make([]*GetFileMetadataBatchResult, len(files))
+	var mu sync.Mutex
+	var firstErr error
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for start := 0; start < len(files); start += size {
+		end := start + size
+		if end > len(files) {
+			end = len(files)
+		}
+		chunk := results[start:end]
+		arg := NewGetFileMetadataBatchArg(files[start:end])
+		arg.Actions = opts.Actions
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			res, err := client.GetFileMetadataBatchContext(ctx, arg)
+			if err == nil && len(res) != len(chunk) {
+				err = errUnexpectedBatchResult
+			}
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			copy(chunk, res)
+		}()
+	}
+	wg.Wait()
+	if firstErr == nil {
+		// Set if ctx was cancelled between two requests.
+		firstErr = ctx.Err()
+	}
+	return results, firstErr
+}
@@ -0,0 +1,78 @@
+package sharing_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestGetFilesMetadata(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("id:%d", i)
+	}
+	var mu sync.Mutex
+	var sizes []int
+	inFlight, maxInFlight := 0, 0
+	dbx := &sharing.Mock{
+		GetFileMetadataBatchFunc: func(ctx context.Context, arg *sharing.GetFileMetadataBatchArg) ([]*sharing.GetFileMetadataBatchResult, error) {
+			mu.Lock()
+			sizes = append(sizes, len(arg.Files))
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			if len(arg.Actions) != 1 {
+				t.Errorf("Unexpected actions: %v\n", arg.Actions)
+			}
+			var res []*sharing.GetFileMetadataBatchResult
+			for _, id := range arg.Files {
+				if id == "id:fail" {
+					return nil, dropbox.APIError{ErrorSummary: "too_many_files/"}
+				}
+				res = append(res, &sharing.GetFileMetadataBatchResult{File: id, Result: &sharing.GetFileMetadataIndividualResult{
+					Tagged:   dropbox.Tagged{Tag: sharing.GetFileMetadataIndividualResultMetadata},
+					Metadata: &sharing.SharedFileMetadata{Id: id},
+				}})
+			}
+			return res, nil
+		},
+	}
+
+	opts := &sharing.FileMetadataOptions{
+		Actions:     []*sharing.FileAction{{Tagged: dropbox.Tagged{Tag: sharing.FileActionEditContents}}},
+		Concurrency: 2,
+	}
+	res, err := sharing.GetFilesMetadata(context.Background(), dbx, ids, opts)
+	if err != nil || len(res) != len(ids) {
+		t.Fatalf("Unexpected result: %d, %v\n", len(res), err)
+	}
+	for i, r := range res {
+		if r.File != ids[i] || r.Result.Metadata.Id != ids[i] {
+			t.Errorf("Unexpected result %d: %s\n", i, r.File)
+		}
+	}
+	sort.Ints(sizes)
+	if !reflect.DeepEqual(sizes, []int{50, 100, 100}) || maxInFlight > 2 {
+		t.Errorf("Unexpected batches: %v, %d in flight\n", sizes, maxInFlight)
+	}
+
+	ids[120] = "id:fail"
+	opts.Concurrency = 1
+	res, err = sharing.GetFilesMetadata(context.Background(), dbx, ids, opts)
+	if err == nil || len(res) != len(ids) || res[0] == nil || res[100] != nil || res[200] != nil {
+		t.Errorf("Unexpected result: %v\n", err)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// ListFileMembersIterator iterates over the pages returned by `ListFileMembers` and
+// `ListFileMembersContinue`.
+type ListFileMembersIterator struct {
+	client  Client
+	arg     *ListFileMembersArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListFileMembersIterator returns a new ListFileMembersIterator instance.
+// arg is required, as `ListFileMembers` has required arguments.
+func NewListFileMembersIterator(client Client, arg *ListFileMembersArg) *ListFileMembersIterator {
+	if arg == nil {
+		panic("sharing: NewListFileMembersIterator called with a nil arg")
+	}
+	return &ListFileMembersIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListFileMembersIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListFileMembersIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListFileMembersIterator) Next(ctx context.Context) (res *SharedFileMembers, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListFileMembersContext(ctx, it.arg)
+	default:
+		arg := NewListFileMembersContinueArg(it.cursor)
+		res, err = it.client.ListFileMembersContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
+
+// ListFolderMembersIterator iterates over the pages returned by `ListFolderMembers` and
+// `ListFolderMembersContinue`.
+type ListFolderMembersIterator struct {
+	client  Client
+	arg     *ListFolderMembersArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListFolderMembersIterator returns a new ListFolderMembersIterator instance.
+// arg is required, as `ListFolderMembers` has required arguments.
+func NewListFolderMembersIterator(client Client, arg *ListFolderMembersArgs) *ListFolderMembersIterator {
+	if arg == nil {
+		panic("sharing: NewListFolderMembersIterator called with a nil arg")
+	}
+	return &ListFolderMembersIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListFolderMembersIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListFolderMembersIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListFolderMembersIterator) Next(ctx context.Context) (res *SharedFolderMembers, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListFolderMembersContext(ctx, it.arg)
+	default:
+		arg := NewListFolderMembersContinueArg(it.cursor)
+		res, err = it.client.ListFolderMembersContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
+
+// ListFoldersIterator iterates over the pages returned by `ListFolders` and
+// `ListFoldersContinue`.
+type ListFoldersIterator struct {
+	client  Client
+	arg     *ListFoldersArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListFoldersIterator returns a new ListFoldersIterator instance.
+// A nil arg lists with the default arguments.
+func NewListFoldersIterator(client Client, arg *ListFoldersArgs) *ListFoldersIterator {
+	if arg == nil {
+		arg = NewListFoldersArgs()
+	}
+	return &ListFoldersIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListFoldersIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListFoldersIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListFoldersIterator) Next(ctx context.Context) (res *ListFoldersResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListFoldersContext(ctx, it.arg)
+	default:
+		arg := NewListFoldersContinueArg(it.cursor)
+		res, err = it.client.ListFoldersContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
+
+// ListMountableFoldersIterator iterates over the pages returned by `ListMountableFolders` and
+// `ListMountableFoldersContinue`.
+type ListMountableFoldersIterator struct {
+	client  Client
+	arg     *ListFoldersArgs
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListMountableFoldersIterator returns a new ListMountableFoldersIterator instance.
+// A nil arg lists with the default arguments.
+func NewListMountableFoldersIterator(client Client, arg *ListFoldersArgs) *ListMountableFoldersIterator {
+	if arg == nil {
+		arg = NewListFoldersArgs()
+	}
+	return &ListMountableFoldersIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListMountableFoldersIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListMountableFoldersIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListMountableFoldersIterator) Next(ctx context.Context) (res *ListFoldersResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListMountableFoldersContext(ctx, it.arg)
+	default:
+		arg := NewListFoldersContinueArg(it.cursor)
+		res, err = it.client.ListMountableFoldersContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
+
+// ListReceivedFilesIterator iterates over the pages returned by `ListReceivedFiles` and
+// `ListReceivedFilesContinue`.
+type ListReceivedFilesIterator struct {
+	client  Client
+	arg     *ListFilesArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListReceivedFilesIterator returns a new ListReceivedFilesIterator instance.
+// A nil arg lists with the default arguments.
+func NewListReceivedFilesIterator(client Client, arg *ListFilesArg) *ListReceivedFilesIterator {
+	if arg == nil {
+		arg = NewListFilesArg()
+	}
+	return &ListReceivedFilesIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListReceivedFilesIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListReceivedFilesIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListReceivedFilesIterator) Next(ctx context.Context) (res *ListFilesResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.ListReceivedFilesContext(ctx, it.arg)
+	default:
+		arg := NewListFilesContinueArg(it.cursor)
+		res, err = it.client.ListReceivedFilesContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = it.cursor != ""
+	return
+}
+
+// ListSharedLinksIterator iterates over the pages returned by `ListSharedLinks`.
+type ListSharedLinksIterator struct {
+	client  Client
+	arg     *ListSharedLinksArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewListSharedLinksIterator returns a new ListSharedLinksIterator instance.
+// A nil arg lists with the default arguments.
+func NewListSharedLinksIterator(client Client, arg *ListSharedLinksArg) *ListSharedLinksIterator {
+	if arg == nil {
+		arg = NewListSharedLinksArg()
+	}
+	return &ListSharedLinksIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *ListSharedLinksIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *ListSharedLinksIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *ListSharedLinksIterator) Next(ctx context.Context) (res *ListSharedLinksResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	arg := *it.arg
+	if it.started {
+		arg.Cursor = it.cursor
+	}
+	res, err = it.client.ListSharedLinksContext(ctx, &arg)
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
@@ -0,0 +1,28 @@
+package sharing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestListSharedLinksIteratorNilArg(t *testing.T) {
+	var cursors []string
+	dbx := &sharing.Mock{
+		ListSharedLinksFunc: func(ctx context.Context, arg *sharing.ListSharedLinksArg) (*sharing.ListSharedLinksResult, error) {
+			cursors = append(cursors, arg.Cursor)
+			return &sharing.ListSharedLinksResult{Cursor: fmt.Sprintf("c%d", len(cursors)), HasMore: len(cursors) < 2}, nil
+		},
+	}
+	it := sharing.NewListSharedLinksIterator(dbx, nil)
+	for it.HasMore() {
+		if _, err := it.Next(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(cursors) != 2 || cursors[0] != "" || cursors[1] != "c1" {
+		t.Errorf("Unexpected cursors: %v\n", cursors)
+	}
+}
@@ -0,0 +1,79 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// errUnexpectedJobResult is returned for jobs ending without the result of
+// their route, e.g. with a tag unknown to the SDK.
+var errUnexpectedJobResult = errors.New("unexpected job result")
+
+// ShareFolderAndWait shares a folder and, if Dropbox shares it
+// asynchronously, polls `check_share_job_status` until the job completes. A
+// failed job is reported with a ShareFolderAPIError, as if `share_folder`
+// had failed, and a job ending without the metadata of the folder with an
+// error.
+func ShareFolderAndWait(ctx context.Context, client Client, arg *ShareFolderArg, opts *async.JobOptions) (*SharedFolderMetadata, error) {
+	launch, err := client.ShareFolderContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case launch.Tag == ShareFolderLaunchComplete && launch.Complete != nil:
+		return launch.Complete, nil
+	case launch.Tag != ShareFolderLaunchAsyncJobId:
+		return nil, errUnexpectedJobResult
+	}
+	v, err := async.Await(ctx, launch.AsyncJobId, opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		status, err := client.CheckShareJobStatusContext(ctx, arg)
+		if err != nil {
+			return nil, true, err
+		}
+		if status.Tag == ShareFolderJobStatusFailed && status.Failed != nil {
+			return nil, true, ShareFolderAPIError{
+				APIError:      dropbox.APIError{ErrorSummary: status.Failed.Tag},
+				EndpointError: status.Failed,
+			}
+		}
+		return status.Complete, status.Tag != ShareFolderJobStatusInProgress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	folder, _ := v.(*SharedFolderMetadata)
+	if folder == nil {
+		return nil, errUnexpectedJobResult
+	}
+	return folder, nil
+}
+
+// UnshareFolderAndWait unshares a folder and, if Dropbox unshares it
+// asynchronously, polls `check_job_status` until the job completes. A failed
+// job is reported with an UnshareFolderAPIError, as if `unshare_folder` had
+// failed.
+func UnshareFolderAndWait(ctx context.Context, client Client, arg *UnshareFolderArg, opts *async.JobOptions) error {
+	launch, err := client.UnshareFolderContext(ctx, arg)
+	if err != nil || launch.Tag != async.LaunchEmptyResultAsyncJobId {
+		return err
+	}
+	_, err = async.Await(ctx, launch.AsyncJobId, opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		status, err := client.CheckJobStatusContext(ctx, arg)
+		if err != nil {
+			return nil, true, err
+		}
+		if status.Tag == JobStatusFailed && status.Failed != nil {
+			apiErr := UnshareFolderAPIError{APIError: dropbox.APIError{ErrorSummary: status.Failed.Tag}}
+			if status.Failed.UnshareFolderError != nil {
+				apiErr.ErrorSummary += "/" + status.Failed.UnshareFolderError.Tag
+				apiErr.EndpointError = status.Failed.UnshareFolderError
+			}
+			return nil, true, apiErr
+		}
+		return nil, status.Tag != JobStatusInProgress, nil
+	})
+	return err
+}
@@ -0,0 +1,86 @@
+package sharing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestShareFolderAndWait(t *testing.T) {
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/sharing/share_folder", "/sharing/unshare_folder":
+				_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "job1"}`))
+			case "/sharing/check_share_job_status":
+				polls++
+				if polls == 1 {
+					_, _ = w.Write([]byte(`{".tag": "in_progress"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{".tag": "complete", "access_type": {".tag": "owner"}, "is_inside_team_folder": false,
+					"is_team_folder": false, "name": "Docs", "policy": {"acl_update_policy": {".tag": "owner"}, "shared_link_policy": {".tag": "anyone"}},
+					"preview_url": "https://www.dropbox.com/scl/fo/x", "shared_folder_id": "sf1", "time_invited": "2024-01-01T00:00:00Z",
+					"access_inheritance": {".tag": "inherit"}}`))
+			case "/sharing/check_job_status":
+				_, _ = w.Write([]byte(`{".tag": "failed", "failed": {".tag": "unshare_folder_error", "unshare_folder_error": {".tag": "team_folder"}}}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	opts := &async.JobOptions{PollInterval: time.Millisecond, MaxPollInterval: 2 * time.Millisecond}
+	folder, err := sharing.ShareFolderAndWait(context.Background(), dbx, sharing.NewShareFolderArg("/Docs"), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder.SharedFolderId != "sf1" || polls != 2 {
+		t.Errorf("Unexpected folder: %+v %d\n", folder, polls)
+	}
+
+	err = sharing.UnshareFolderAndWait(context.Background(), dbx, sharing.NewUnshareFolderArg("sf1"), opts)
+	apiErr, ok := err.(sharing.UnshareFolderAPIError)
+	if !ok || apiErr.EndpointError == nil || apiErr.EndpointError.Tag != sharing.UnshareFolderErrorTeamFolder {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	// Jobs ending without the folder fail instead of returning nil.
+	var launch *sharing.ShareFolderLaunch
+	var status *sharing.ShareFolderJobStatus
+	mock := &sharing.Mock{
+		ShareFolderFunc: func(ctx context.Context, arg *sharing.ShareFolderArg) (*sharing.ShareFolderLaunch, error) {
+			return launch, nil
+		},
+		CheckShareJobStatusFunc: func(ctx context.Context, arg *async.PollArg) (*sharing.ShareFolderJobStatus, error) {
+			return status, nil
+		},
+	}
+	asyncLaunch := &sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderLaunchAsyncJobId}, AsyncJobId: "job1"}
+	for _, c := range []struct {
+		launch *sharing.ShareFolderLaunch
+		status *sharing.ShareFolderJobStatus
+	}{
+		{asyncLaunch, &sharing.ShareFolderJobStatus{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderJobStatusFailed}}},
+		{asyncLaunch, &sharing.ShareFolderJobStatus{Tagged: dropbox.Tagged{Tag: "other"}}},
+		{&sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: "other"}}, nil},
+		{&sharing.ShareFolderLaunch{Tagged: dropbox.Tagged{Tag: sharing.ShareFolderLaunchComplete}}, nil},
+	} {
+		launch, status = c.launch, c.status
+		folder, err = sharing.ShareFolderAndWait(context.Background(), mock, sharing.NewShareFolderArg("/Docs"), opts)
+		if err == nil || folder != nil {
+			t.Errorf("Unexpected result for %s/%v: %v, %v\n", c.launch.Tag, c.status, folder, err)
+		}
+	}
+}
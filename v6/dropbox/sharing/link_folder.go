@@ -0,0 +1,111 @@
+package sharing
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// SharedLinkFolder gives access to the content of a folder shared link, so
+// that content only reachable via a link can be enumerated and downloaded.
+// Paths are relative to the root of the link: "" is the shared folder
+// itself, "/a/b.txt" a file in its sub-folder "a".
+type SharedLinkFolder struct {
+	files   files.Client
+	sharing Client
+	link    *files.SharedLink
+}
+
+// NewSharedLinkFolder returns a SharedLinkFolder for the link with the given
+// url. password may be empty if the link isn't password protected.
+func NewSharedLinkFolder(filesClient files.Client, client Client, url string, password string) *SharedLinkFolder {
+	link := files.NewSharedLink(url)
+	link.Password = password
+	return &SharedLinkFolder{files: filesClient, sharing: client, link: link}
+}
+
+func (f *SharedLinkFolder) metadataArg(path string) *GetSharedLinkMetadataArg {
+	arg := NewGetSharedLinkMetadataArg(f.link.Url)
+	arg.Path = path
+	arg.LinkPassword = f.link.Password
+	return arg
+}
+
+// Metadata returns the metadata of the entry at path.
+func (f *SharedLinkFolder) Metadata(ctx context.Context, path string) (IsSharedLinkMetadata, error) {
+	return f.sharing.GetSharedLinkMetadataContext(ctx, f.metadataArg(path))
+}
+
+// List returns the entries directly inside the folder at path, fetching all
+// pages of the listing.
+func (f *SharedLinkFolder) List(ctx context.Context, path string) ([]files.IsMetadata, error) {
+	arg := files.NewListFolderArg(path)
+	arg.SharedLink = f.link
+	it := files.NewListFolderIterator(f.files, arg)
+	var entries []files.IsMetadata
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.Entries...)
+	}
+	return entries, nil
+}
+
+// Walk calls fn for every entry under the folder at path, recursively. The
+// path passed to fn is relative to the root of the link, as entries listed
+// through a link carry no path of their own. As with fs.WalkDir, if fn
+// returns fs.SkipDir for a folder, its content is skipped, and for a file,
+// the remaining entries of the file's folder are skipped; any other error
+// stops the walk and is returned.
+func (f *SharedLinkFolder) Walk(ctx context.Context, path string, fn func(path string, entry files.IsMetadata) error) error {
+	err := f.walk(ctx, path, fn)
+	if err == fs.SkipDir {
+		return nil
+	}
+	return err
+}
+
+// walk walks the folder at path, returning fs.SkipDir if fn returned it
+// for one of its files.
+func (f *SharedLinkFolder) walk(ctx context.Context, path string, fn func(path string, entry files.IsMetadata) error) error {
+	entries, err := f.List(ctx, path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		var name string
+		var isFolder bool
+		switch e := entry.(type) {
+		case *files.FileMetadata:
+			name = e.Name
+		case *files.FolderMetadata:
+			name, isFolder = e.Name, true
+		default:
+			continue
+		}
+		entryPath := path + "/" + name
+		err = fn(entryPath, entry)
+		if err == fs.SkipDir && isFolder {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if isFolder {
+			if err = f.walk(ctx, entryPath, fn); err != nil && err != fs.SkipDir {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Download returns the metadata and the content of the file at path. The
+// caller must close the content.
+func (f *SharedLinkFolder) Download(ctx context.Context, path string) (IsSharedLinkMetadata, io.ReadCloser, error) {
+	return f.sharing.GetSharedLinkFileContext(ctx, f.metadataArg(path))
+}
@@ -0,0 +1,71 @@
+package sharing_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestSharedLinkFolderWalk(t *testing.T) {
+	entry := func(name string, folder bool) files.IsMetadata {
+		if folder {
+			f := &files.FolderMetadata{}
+			f.Name = name
+			return f
+		}
+		f := &files.FileMetadata{}
+		f.Name = name
+		return f
+	}
+	tree := map[string][]files.IsMetadata{
+		"":          {entry("a.txt", false), entry("skip", true), entry("sub", true), entry("z.txt", false)},
+		"/skip":     {entry("x.txt", false)},
+		"/sub":      {entry("b.txt", false), entry("c.txt", false), entry("deep", true)},
+		"/sub/deep": {entry("d.txt", false)},
+	}
+	client := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if arg.SharedLink == nil || arg.SharedLink.Url != "https://www.dropbox.com/sh/x" || arg.SharedLink.Password != "pw" {
+				t.Errorf("Unexpected shared link: %+v\n", arg.SharedLink)
+			}
+			return &files.ListFolderResult{Entries: tree[arg.Path]}, nil
+		},
+	}
+	folder := sharing.NewSharedLinkFolder(client, &sharing.Mock{}, "https://www.dropbox.com/sh/x", "pw")
+
+	walk := func(errs map[string]error) ([]string, error) {
+		var visited []string
+		err := folder.Walk(context.Background(), "", func(path string, entry files.IsMetadata) error {
+			visited = append(visited, path)
+			return errs[path]
+		})
+		return visited, err
+	}
+	errStop := errors.New("stop")
+	for _, c := range []struct {
+		errs    map[string]error
+		visited []string
+		err     error
+	}{
+		// fs.SkipDir skips the content of a folder, and the rest of the
+		// folder of a file.
+		{map[string]error{"/skip": fs.SkipDir, "/sub/b.txt": fs.SkipDir},
+			[]string{"/a.txt", "/skip", "/sub", "/sub/b.txt", "/z.txt"}, nil},
+		{map[string]error{"/a.txt": fs.SkipDir}, []string{"/a.txt"}, nil},
+		{map[string]error{"/sub/deep/d.txt": fs.SkipDir},
+			[]string{"/a.txt", "/skip", "/skip/x.txt", "/sub", "/sub/b.txt", "/sub/c.txt", "/sub/deep", "/sub/deep/d.txt", "/z.txt"}, nil},
+		// Any other error stops the walk.
+		{map[string]error{"/sub/c.txt": errStop},
+			[]string{"/a.txt", "/skip", "/skip/x.txt", "/sub", "/sub/b.txt", "/sub/c.txt"}, errStop},
+	} {
+		visited, err := walk(c.errs)
+		if err != c.err || !reflect.DeepEqual(visited, c.visited) {
+			t.Errorf("Unexpected walk for %v: %v %v\n", c.errs, visited, err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package sharing
+
+// DecodeSharedLinkMetadata decodes the JSON of a shared link, e.g. a stored
+// API response or a webhook body, into a *FileLinkMetadata or a
+// *FolderLinkMetadata. Links with an unknown tag are decoded into an
+// *UnknownSharedLinkMetadata instead of being dropped, as by
+// IsSharedLinkMetadataFromJSON.
+func DecodeSharedLinkMetadata(data []byte) (IsSharedLinkMetadata, error) {
+	return IsSharedLinkMetadataFromJSON(data)
+}
+
+// SharedLinkBase returns the metadata common to file and folder links.
+func SharedLinkBase(link IsSharedLinkMetadata) *SharedLinkMetadata {
+	switch l := link.(type) {
+	case *FileLinkMetadata:
+		return &l.SharedLinkMetadata
+	case *FolderLinkMetadata:
+		return &l.SharedLinkMetadata
+	case *UnknownSharedLinkMetadata:
+		return &l.SharedLinkMetadata
+	case *SharedLinkMetadata:
+		return l
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package sharing_test
+
+import (
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestDecodeSharedLinkMetadata(t *testing.T) {
+	link, err := sharing.DecodeSharedLinkMetadata([]byte(`{".tag": "folder", "url": "https://db.tt/f", "name": "Docs",
+		"link_permissions": {"can_revoke": true}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := link.(*sharing.FolderLinkMetadata); !ok || f.Url != "https://db.tt/f" {
+		t.Errorf("Unexpected link: %+v\n", link)
+	}
+
+	raw := `{".tag": "collection", "url": "https://db.tt/c", "name": "Album", "link_permissions": {"can_revoke": false}, "items": 3}`
+	link, err = sharing.DecodeSharedLinkMetadata([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, ok := link.(*sharing.UnknownSharedLinkMetadata)
+	if !ok || unknown.Tag != "collection" || string(unknown.Raw) != raw || sharing.SharedLinkBase(link).Url != "https://db.tt/c" {
+		t.Errorf("Unexpected link: %+v\n", link)
+	}
+}
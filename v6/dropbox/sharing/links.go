@@ -0,0 +1,147 @@
+package sharing
+
+import (
+	"context"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+)
+
+// LinkFilter selects shared links. The zero value matches all links; set
+// fields all have to match.
+type LinkFilter struct {
+	// Visibility matches the links whose resolved visibility is one of the
+	// ResolvedVisibility tags, e.g. ResolvedVisibilityPublic.
+	Visibility []string
+	// ExpiresBefore matches the links expiring before ExpiresBefore; use
+	// time.Now() to match expired links. Links that don't expire don't
+	// match.
+	ExpiresBefore time.Time
+	// NoExpiry matches the links that don't expire.
+	NoExpiry bool
+	// ModifiedBefore matches the links to files last modified before
+	// ModifiedBefore. As Dropbox doesn't tell when a link was created, this
+	// is the only age available; folder links don't match.
+	ModifiedBefore time.Time
+}
+
+// Match reports whether link is selected by f.
+func (f *LinkFilter) Match(link IsSharedLinkMetadata) bool {
+	md := SharedLinkBase(link)
+	if md == nil {
+		return false
+	}
+	if len(f.Visibility) > 0 {
+		if md.LinkPermissions == nil || md.LinkPermissions.ResolvedVisibility == nil {
+			return false
+		}
+		found := false
+		for _, v := range f.Visibility {
+			if v == md.LinkPermissions.ResolvedVisibility.Tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !f.ExpiresBefore.IsZero() && (md.Expires == nil || !md.Expires.Before(f.ExpiresBefore)) {
+		return false
+	}
+	if f.NoExpiry && md.Expires != nil {
+		return false
+	}
+	if !f.ModifiedBefore.IsZero() {
+		file, ok := link.(*FileLinkMetadata)
+		if !ok || !file.ServerModified.Before(f.ModifiedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// ListAllSharedLinks returns all the shared links of the account matched by
+// filter, or all of them if filter is nil. For a team member's links, pass
+// a client acting as the member, e.g. built with team.AsMember. If a request
+// fails, the links listed so far are returned along with the error.
+func ListAllSharedLinks(ctx context.Context, client Client, filter *LinkFilter) ([]IsSharedLinkMetadata, error) {
+	var links []IsSharedLinkMetadata
+	it := NewListSharedLinksIterator(client, NewListSharedLinksArg())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return links, err
+		}
+		for _, l := range res.Links {
+			if filter == nil || filter.Match(l) {
+				links = append(links, l)
+			}
+		}
+	}
+	return links, nil
+}
+
+// RevokeLinksOptions controls RevokeSharedLinks.
+type RevokeLinksOptions struct {
+	// DryRun reports the links that would be revoked without revoking
+	// them.
+	DryRun bool
+	// Interval is the minimum delay between two revocations.
+	Interval time.Duration
+}
+
+// LinkRevocation is the outcome of revoking a single shared link.
+type LinkRevocation struct {
+	Link IsSharedLinkMetadata
+	// Revoked is false in dry-run mode or if revoking failed.
+	Revoked bool
+	Err     error
+}
+
+// RevokeSharedLinks revokes links one at a time, at most one per
+// opts.Interval, waiting and retrying once when rate limited. Errors are
+// reported per link; revocation stops early only if ctx is done.
+//
+//	links, err := sharing.ListAllSharedLinks(ctx, dbx, &sharing.LinkFilter{ExpiresBefore: time.Now()})
+//	...
+//	for _, r := range sharing.RevokeSharedLinks(ctx, dbx, links, nil) {
+//		...
+//	}
+func RevokeSharedLinks(ctx context.Context, client Client, links []IsSharedLinkMetadata, opts *RevokeLinksOptions) []*LinkRevocation {
+	if opts == nil {
+		opts = &RevokeLinksOptions{}
+	}
+	res := make([]*LinkRevocation, 0, len(links))
+	var last time.Time
+	for _, l := range links {
+		r := &LinkRevocation{Link: l}
+		res = append(res, r)
+		md := SharedLinkBase(l)
+		if md == nil || opts.DryRun {
+			continue
+		}
+		if wait := opts.Interval - time.Since(last); !last.IsZero() && wait > 0 {
+			select {
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+				return res
+			case <-time.After(wait):
+			}
+		}
+		last = time.Now()
+		r.Err = client.RevokeSharedLinkContext(ctx, NewRevokeSharedLinkArg(md.Url))
+		if rl, ok := r.Err.(auth.RateLimitAPIError); ok && rl.RateLimitError != nil {
+			select {
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+				return res
+			case <-time.After(time.Duration(rl.RateLimitError.RetryAfter) * time.Second):
+			}
+			last = time.Now()
+			r.Err = client.RevokeSharedLinkContext(ctx, NewRevokeSharedLinkArg(md.Url))
+		}
+		r.Revoked = r.Err == nil
+	}
+	return res
+}
@@ -0,0 +1,83 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestSharedLinkCleanup(t *testing.T) {
+	var revoked []string
+	rateLimited := false
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/sharing/list_shared_links":
+				if arg["cursor"] == nil {
+					_, _ = w.Write([]byte(`{"links": [
+						{".tag": "file", "url": "https://db.tt/old", "name": "a.txt", "expires": "2020-01-01T00:00:00Z",
+						"link_permissions": {"can_revoke": true, "resolved_visibility": {".tag": "public"}},
+						"client_modified": "2019-01-01T00:00:00Z", "server_modified": "2019-01-01T00:00:00Z", "rev": "a1b2c3d4e5", "size": 1},
+						{".tag": "folder", "url": "https://db.tt/team", "name": "Docs",
+						"link_permissions": {"can_revoke": true, "resolved_visibility": {".tag": "team_only"}}}],
+						"has_more": true, "cursor": "c1"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"links": [{".tag": "folder", "url": "https://db.tt/public", "name": "Pub",
+					"link_permissions": {"can_revoke": true, "resolved_visibility": {".tag": "public"}}}], "has_more": false}`))
+			case "/sharing/revoke_shared_link":
+				if !rateLimited {
+					rateLimited = true
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"error_summary": "too_many_requests/", "error": {"reason": {".tag": "too_many_requests"}, "retry_after": 0}}`))
+					return
+				}
+				revoked = append(revoked, arg["url"].(string))
+				_, _ = w.Write([]byte(`null`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	ctx := context.Background()
+	links, err := sharing.ListAllSharedLinks(ctx, dbx, &sharing.LinkFilter{Visibility: []string{sharing.ResolvedVisibilityPublic}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != 2 {
+		t.Fatalf("Unexpected links: %d\n", len(links))
+	}
+	expired := &sharing.LinkFilter{ExpiresBefore: time.Now()}
+	old := &sharing.LinkFilter{ModifiedBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if !expired.Match(links[0]) || expired.Match(links[1]) || !old.Match(links[0]) || old.Match(links[1]) {
+		t.Errorf("Unexpected filter matches\n")
+	}
+
+	res := sharing.RevokeSharedLinks(ctx, dbx, links, &sharing.RevokeLinksOptions{DryRun: true})
+	if len(res) != 2 || res[0].Revoked || len(revoked) != 0 {
+		t.Errorf("Unexpected dry run: %v\n", revoked)
+	}
+	res = sharing.RevokeSharedLinks(ctx, dbx, links, &sharing.RevokeLinksOptions{Interval: time.Millisecond})
+	for _, r := range res {
+		if !r.Revoked || r.Err != nil {
+			t.Errorf("Unexpected revocation: %+v\n", r)
+		}
+	}
+	if len(revoked) != 2 || revoked[0] != "https://db.tt/old" || revoked[1] != "https://db.tt/public" {
+		t.Errorf("Unexpected revoked links: %v\n", revoked)
+	}
+}
@@ -0,0 +1,76 @@
+package sharing
+
+import (
+	"context"
+)
+
+// WalkFolderMembers calls fn with every page of members of the shared
+// folder sharedFolderID, including the members inherited from parent
+// folders, fetching them with `list_folder_members` and
+// `list_folder_members/continue`. It stops at the first error, returned by a
+// request or by fn.
+func WalkFolderMembers(ctx context.Context, client Client, sharedFolderID string, fn func(members *SharedFolderMembers) error) error {
+	it := NewListFolderMembersIterator(client, NewListFolderMembersArgs(sharedFolderID))
+	for it.HasMore() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err = fn(page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListAllFolderMembers returns all the members of the shared folder
+// sharedFolderID in a single SharedFolderMembers; see WalkFolderMembers.
+func ListAllFolderMembers(ctx context.Context, client Client, sharedFolderID string) (*SharedFolderMembers, error) {
+	all := NewSharedFolderMembers(nil, nil, nil)
+	err := WalkFolderMembers(ctx, client, sharedFolderID, func(members *SharedFolderMembers) error {
+		all.Users = append(all.Users, members.Users...)
+		all.Groups = append(all.Groups, members.Groups...)
+		all.Invitees = append(all.Invitees, members.Invitees...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// WalkFileMembers calls fn with every page of members of file, a path or
+// file ID, including the members inherited from its shared folder, fetching
+// them with `list_file_members` and `list_file_members/continue`. It stops
+// at the first error, returned by a request or by fn.
+func WalkFileMembers(ctx context.Context, client Client, file string, fn func(members *SharedFileMembers) error) error {
+	arg := NewListFileMembersArg(file)
+	arg.IncludeInherited = true
+	it := NewListFileMembersIterator(client, arg)
+	for it.HasMore() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err = fn(page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListAllFileMembers returns all the members of file in a single
+// SharedFileMembers; see WalkFileMembers.
+func ListAllFileMembers(ctx context.Context, client Client, file string) (*SharedFileMembers, error) {
+	all := NewSharedFileMembers(nil, nil, nil)
+	err := WalkFileMembers(ctx, client, file, func(members *SharedFileMembers) error {
+		all.Users = append(all.Users, members.Users...)
+		all.Groups = append(all.Groups, members.Groups...)
+		all.Invitees = append(all.Invitees, members.Invitees...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
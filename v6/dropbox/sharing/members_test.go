@@ -0,0 +1,69 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestListAllMembers(t *testing.T) {
+	var fileArg sharing.ListFileMembersArg
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			user := func(id string, inherited bool) string {
+				return fmt.Sprintf(`{"access_type": {".tag": "editor"}, "is_inherited": %v,
+					"user": {"account_id": %q, "email": "u@example.com", "display_name": "U", "same_team": true}}`, inherited, id)
+			}
+			switch r.URL.Path {
+			case "/sharing/list_folder_members":
+				_, _ = w.Write([]byte(`{"users": [` + user("a1", false) + `], "groups": [], "invitees": [], "cursor": "c1"}`))
+			case "/sharing/list_folder_members/continue":
+				_, _ = w.Write([]byte(`{"users": [` + user("a2", true) + `], "groups": [], "invitees": []}`))
+			case "/sharing/list_file_members":
+				_ = json.NewDecoder(r.Body).Decode(&fileArg)
+				_, _ = w.Write([]byte(`{"users": [` + user("a3", true) + `], "groups": [], "invitees": [], "cursor": "c2"}`))
+			case "/sharing/list_file_members/continue":
+				_, _ = w.Write([]byte(`{"users": [], "groups": [], "invitees": [{"access_type": {".tag": "viewer"}, "is_inherited": false,
+					"invitee": {".tag": "email", "email": "i@example.com"}}]}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	folder, err := sharing.ListAllFolderMembers(context.Background(), dbx, "sf1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folder.Users) != 2 || folder.Users[1].User.AccountId != "a2" || !folder.Users[1].IsInherited {
+		t.Errorf("Unexpected folder members: %+v\n", folder.Users)
+	}
+	file, err := sharing.ListAllFileMembers(context.Background(), dbx, "id:f1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Users) != 1 || len(file.Invitees) != 1 || !fileArg.IncludeInherited || fileArg.File != "id:f1" {
+		t.Errorf("Unexpected file members: %+v %+v\n", file, fileArg)
+	}
+
+	stop := errors.New("stop")
+	pages := 0
+	err = sharing.WalkFolderMembers(context.Background(), dbx, "sf1", func(*sharing.SharedFolderMembers) error {
+		pages++
+		return stop
+	})
+	if err != stop || pages != 1 {
+		t.Errorf("Unexpected walk: %v %d\n", err, pages)
+	}
+}
@@ -0,0 +1,583 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	AddFileMemberFunc                func(ctx context.Context, arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error)
+	AddFolderMemberFunc              func(ctx context.Context, arg *AddFolderMemberArg) (err error)
+	CheckJobStatusFunc               func(ctx context.Context, arg *async.PollArg) (res *JobStatus, err error)
+	CheckRemoveMemberJobStatusFunc   func(ctx context.Context, arg *async.PollArg) (res *RemoveMemberJobStatus, err error)
+	CheckShareJobStatusFunc          func(ctx context.Context, arg *async.PollArg) (res *ShareFolderJobStatus, err error)
+	CreateSharedLinkFunc             func(ctx context.Context, arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error)
+	CreateSharedLinkWithSettingsFunc func(ctx context.Context, arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error)
+	GetFileMetadataFunc              func(ctx context.Context, arg *GetFileMetadataArg) (res *SharedFileMetadata, err error)
+	GetFileMetadataBatchFunc         func(ctx context.Context, arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error)
+	GetFolderMetadataFunc            func(ctx context.Context, arg *GetMetadataArgs) (res *SharedFolderMetadata, err error)
+	GetSharedLinkFileFunc            func(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
+	GetSharedLinkMetadataFunc        func(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error)
+	GetSharedLinksFunc               func(ctx context.Context, arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error)
+	ListFileMembersFunc              func(ctx context.Context, arg *ListFileMembersArg) (res *SharedFileMembers, err error)
+	ListFileMembersBatchFunc         func(ctx context.Context, arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error)
+	ListFileMembersContinueFunc      func(ctx context.Context, arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error)
+	ListFolderMembersFunc            func(ctx context.Context, arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error)
+	ListFolderMembersContinueFunc    func(ctx context.Context, arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error)
+	ListFoldersFunc                  func(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	ListFoldersContinueFunc          func(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	ListMountableFoldersFunc         func(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	ListMountableFoldersContinueFunc func(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	ListReceivedFilesFunc            func(ctx context.Context, arg *ListFilesArg) (res *ListFilesResult, err error)
+	ListReceivedFilesContinueFunc    func(ctx context.Context, arg *ListFilesContinueArg) (res *ListFilesResult, err error)
+	ListSharedLinksFunc              func(ctx context.Context, arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error)
+	ModifySharedLinkSettingsFunc     func(ctx context.Context, arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error)
+	MountFolderFunc                  func(ctx context.Context, arg *MountFolderArg) (res *SharedFolderMetadata, err error)
+	RelinquishFileMembershipFunc     func(ctx context.Context, arg *RelinquishFileMembershipArg) (err error)
+	RelinquishFolderMembershipFunc   func(ctx context.Context, arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error)
+	RemoveFileMemberFunc             func(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error)
+	RemoveFileMember2Func            func(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error)
+	RemoveFolderMemberFunc           func(ctx context.Context, arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error)
+	RevokeSharedLinkFunc             func(ctx context.Context, arg *RevokeSharedLinkArg) (err error)
+	SetAccessInheritanceFunc         func(ctx context.Context, arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error)
+	ShareFolderFunc                  func(ctx context.Context, arg *ShareFolderArg) (res *ShareFolderLaunch, err error)
+	TransferFolderFunc               func(ctx context.Context, arg *TransferFolderArg) (err error)
+	UnmountFolderFunc                func(ctx context.Context, arg *UnmountFolderArg) (err error)
+	UnshareFileFunc                  func(ctx context.Context, arg *UnshareFileArg) (err error)
+	UnshareFolderFunc                func(ctx context.Context, arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error)
+	UpdateFileMemberFunc             func(ctx context.Context, arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error)
+	UpdateFolderMemberFunc           func(ctx context.Context, arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error)
+	UpdateFolderPolicyFunc           func(ctx context.Context, arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
+	if m.AddFileMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.AddFileMemberFunc(ctx, arg)
+}
+
+func (m *Mock) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
+	return m.AddFileMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg) (err error) {
+	if m.AddFolderMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.AddFolderMemberFunc(ctx, arg)
+}
+
+func (m *Mock) AddFolderMember(arg *AddFolderMemberArg) (err error) {
+	return m.AddFolderMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) CheckJobStatusContext(ctx context.Context, arg *async.PollArg) (res *JobStatus, err error) {
+	if m.CheckJobStatusFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CheckJobStatusFunc(ctx, arg)
+}
+
+func (m *Mock) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error) {
+	return m.CheckJobStatusContext(context.Background(), arg)
+}
+
+func (m *Mock) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
+	if m.CheckRemoveMemberJobStatusFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CheckRemoveMemberJobStatusFunc(ctx, arg)
+}
+
+func (m *Mock) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
+	return m.CheckRemoveMemberJobStatusContext(context.Background(), arg)
+}
+
+func (m *Mock) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
+	if m.CheckShareJobStatusFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CheckShareJobStatusFunc(ctx, arg)
+}
+
+func (m *Mock) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
+	return m.CheckShareJobStatusContext(context.Background(), arg)
+}
+
+func (m *Mock) CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
+	if m.CreateSharedLinkFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateSharedLinkFunc(ctx, arg)
+}
+
+func (m *Mock) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
+	return m.CreateSharedLinkContext(context.Background(), arg)
+}
+
+func (m *Mock) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
+	if m.CreateSharedLinkWithSettingsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.CreateSharedLinkWithSettingsFunc(ctx, arg)
+}
+
+func (m *Mock) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
+	return m.CreateSharedLinkWithSettingsContext(context.Background(), arg)
+}
+
+func (m *Mock) GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
+	if m.GetFileMetadataFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetFileMetadataFunc(ctx, arg)
+}
+
+func (m *Mock) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
+	return m.GetFileMetadataContext(context.Background(), arg)
+}
+
+func (m *Mock) GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
+	if m.GetFileMetadataBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetFileMetadataBatchFunc(ctx, arg)
+}
+
+func (m *Mock) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
+	return m.GetFileMetadataBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
+	if m.GetFolderMetadataFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetFolderMetadataFunc(ctx, arg)
+}
+
+func (m *Mock) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
+	return m.GetFolderMetadataContext(context.Background(), arg)
+}
+
+func (m *Mock) GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	if m.GetSharedLinkFileFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetSharedLinkFileFunc(ctx, arg)
+}
+
+func (m *Mock) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	return m.GetSharedLinkFileContext(context.Background(), arg)
+}
+
+func (m *Mock) GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
+	if m.GetSharedLinkMetadataFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetSharedLinkMetadataFunc(ctx, arg)
+}
+
+func (m *Mock) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
+	return m.GetSharedLinkMetadataContext(context.Background(), arg)
+}
+
+func (m *Mock) GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
+	if m.GetSharedLinksFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetSharedLinksFunc(ctx, arg)
+}
+
+func (m *Mock) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
+	return m.GetSharedLinksContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
+	if m.ListFileMembersFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFileMembersFunc(ctx, arg)
+}
+
+func (m *Mock) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
+	return m.ListFileMembersContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
+	if m.ListFileMembersBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFileMembersBatchFunc(ctx, arg)
+}
+
+func (m *Mock) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
+	return m.ListFileMembersBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
+	if m.ListFileMembersContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFileMembersContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
+	return m.ListFileMembersContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
+	if m.ListFolderMembersFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderMembersFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
+	return m.ListFolderMembersContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
+	if m.ListFolderMembersContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFolderMembersContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
+	return m.ListFolderMembersContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	if m.ListFoldersFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFoldersFunc(ctx, arg)
+}
+
+func (m *Mock) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	return m.ListFoldersContext(context.Background(), arg)
+}
+
+func (m *Mock) ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	if m.ListFoldersContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListFoldersContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	return m.ListFoldersContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	if m.ListMountableFoldersFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListMountableFoldersFunc(ctx, arg)
+}
+
+func (m *Mock) ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+	return m.ListMountableFoldersContext(context.Background(), arg)
+}
+
+func (m *Mock) ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	if m.ListMountableFoldersContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListMountableFoldersContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+	return m.ListMountableFoldersContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg) (res *ListFilesResult, err error) {
+	if m.ListReceivedFilesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListReceivedFilesFunc(ctx, arg)
+}
+
+func (m *Mock) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error) {
+	return m.ListReceivedFilesContext(context.Background(), arg)
+}
+
+func (m *Mock) ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
+	if m.ListReceivedFilesContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListReceivedFilesContinueFunc(ctx, arg)
+}
+
+func (m *Mock) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
+	return m.ListReceivedFilesContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
+	if m.ListSharedLinksFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ListSharedLinksFunc(ctx, arg)
+}
+
+func (m *Mock) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
+	return m.ListSharedLinksContext(context.Background(), arg)
+}
+
+func (m *Mock) ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
+	if m.ModifySharedLinkSettingsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ModifySharedLinkSettingsFunc(ctx, arg)
+}
+
+func (m *Mock) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
+	return m.ModifySharedLinkSettingsContext(context.Background(), arg)
+}
+
+func (m *Mock) MountFolderContext(ctx context.Context, arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
+	if m.MountFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MountFolderFunc(ctx, arg)
+}
+
+func (m *Mock) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
+	return m.MountFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg) (err error) {
+	if m.RelinquishFileMembershipFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RelinquishFileMembershipFunc(ctx, arg)
+}
+
+func (m *Mock) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error) {
+	return m.RelinquishFileMembershipContext(context.Background(), arg)
+}
+
+func (m *Mock) RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	if m.RelinquishFolderMembershipFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RelinquishFolderMembershipFunc(ctx, arg)
+}
+
+func (m *Mock) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+	return m.RelinquishFolderMembershipContext(context.Background(), arg)
+}
+
+func (m *Mock) RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
+	if m.RemoveFileMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RemoveFileMemberFunc(ctx, arg)
+}
+
+func (m *Mock) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
+	return m.RemoveFileMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
+	if m.RemoveFileMember2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RemoveFileMember2Func(ctx, arg)
+}
+
+func (m *Mock) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
+	return m.RemoveFileMember2Context(context.Background(), arg)
+}
+
+func (m *Mock) RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	if m.RemoveFolderMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RemoveFolderMemberFunc(ctx, arg)
+}
+
+func (m *Mock) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+	return m.RemoveFolderMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg) (err error) {
+	if m.RevokeSharedLinkFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.RevokeSharedLinkFunc(ctx, arg)
+}
+
+func (m *Mock) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
+	return m.RevokeSharedLinkContext(context.Background(), arg)
+}
+
+func (m *Mock) SetAccessInheritanceContext(ctx context.Context, arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error) {
+	if m.SetAccessInheritanceFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.SetAccessInheritanceFunc(ctx, arg)
+}
+
+func (m *Mock) SetAccessInheritance(arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error) {
+	return m.SetAccessInheritanceContext(context.Background(), arg)
+}
+
+func (m *Mock) ShareFolderContext(ctx context.Context, arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
+	if m.ShareFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ShareFolderFunc(ctx, arg)
+}
+
+func (m *Mock) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
+	return m.ShareFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) TransferFolderContext(ctx context.Context, arg *TransferFolderArg) (err error) {
+	if m.TransferFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TransferFolderFunc(ctx, arg)
+}
+
+func (m *Mock) TransferFolder(arg *TransferFolderArg) (err error) {
+	return m.TransferFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg) (err error) {
+	if m.UnmountFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UnmountFolderFunc(ctx, arg)
+}
+
+func (m *Mock) UnmountFolder(arg *UnmountFolderArg) (err error) {
+	return m.UnmountFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) UnshareFileContext(ctx context.Context, arg *UnshareFileArg) (err error) {
+	if m.UnshareFileFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UnshareFileFunc(ctx, arg)
+}
+
+func (m *Mock) UnshareFile(arg *UnshareFileArg) (err error) {
+	return m.UnshareFileContext(context.Background(), arg)
+}
+
+func (m *Mock) UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	if m.UnshareFolderFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UnshareFolderFunc(ctx, arg)
+}
+
+func (m *Mock) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+	return m.UnshareFolderContext(context.Background(), arg)
+}
+
+func (m *Mock) UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
+	if m.UpdateFileMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UpdateFileMemberFunc(ctx, arg)
+}
+
+func (m *Mock) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
+	return m.UpdateFileMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
+	if m.UpdateFolderMemberFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UpdateFolderMemberFunc(ctx, arg)
+}
+
+func (m *Mock) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
+	return m.UpdateFolderMemberContext(context.Background(), arg)
+}
+
+func (m *Mock) UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
+	if m.UpdateFolderPolicyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.UpdateFolderPolicyFunc(ctx, arg)
+}
+
+func (m *Mock) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
+	return m.UpdateFolderPolicyContext(context.Background(), arg)
+}
@@ -0,0 +1,115 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrFolderNotMountable is reported by ReconcileMounts for desired folders
+// that are neither mounted nor mountable by the account.
+var ErrFolderNotMountable = errors.New("shared folder is not mountable")
+
+// Actions taken by ReconcileMounts.
+const (
+	MountActionNone    = "none"
+	MountActionMount   = "mount"
+	MountActionUnmount = "unmount"
+)
+
+// ReconcileMountsOptions controls ReconcileMounts.
+type ReconcileMountsOptions struct {
+	// Unmount unmounts the mounted folders that aren't desired. By default,
+	// only missing folders are mounted.
+	Unmount bool
+	// DryRun reports the actions without taking them.
+	DryRun bool
+}
+
+// MountOutcome is the result of reconciling the mount of a single shared
+// folder.
+type MountOutcome struct {
+	SharedFolderId string
+	// Folder is the folder after the action, or as listed if no action was
+	// taken or it failed. It is nil for desired folders that weren't listed.
+	Folder *SharedFolderMetadata
+	// Action is one of the MountAction constants.
+	Action string
+	Err    error
+}
+
+// ReconcileMounts mounts the shared folders of desired, a list of shared
+// folder IDs, that are not mounted yet, comparing `list_folders` and
+// `list_mountable_folders`, and optionally unmounts the others. Every
+// desired or mounted folder gets an outcome, desired folders first in the
+// order of desired. Failures are reported per folder; the returned error is
+// only set if the folders couldn't be listed.
+func ReconcileMounts(ctx context.Context, client Client, desired []string, opts *ReconcileMountsOptions) ([]*MountOutcome, error) {
+	if opts == nil {
+		opts = &ReconcileMountsOptions{}
+	}
+	mounted := make(map[string]*SharedFolderMetadata)
+	var mountedOrder []string
+	it := NewListFoldersIterator(client, NewListFoldersArgs())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range res.Entries {
+			if f.PathLower != "" && mounted[f.SharedFolderId] == nil {
+				mounted[f.SharedFolderId] = f
+				mountedOrder = append(mountedOrder, f.SharedFolderId)
+			}
+		}
+	}
+	mountable := make(map[string]*SharedFolderMetadata)
+	mit := NewListMountableFoldersIterator(client, NewListFoldersArgs())
+	for mit.HasMore() {
+		res, err := mit.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range res.Entries {
+			mountable[f.SharedFolderId] = f
+		}
+	}
+
+	var outcomes []*MountOutcome
+	wanted := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+		o := &MountOutcome{SharedFolderId: id, Action: MountActionNone}
+		outcomes = append(outcomes, o)
+		if o.Folder = mounted[id]; o.Folder != nil {
+			continue
+		}
+		if o.Folder = mountable[id]; o.Folder == nil {
+			o.Err = ErrFolderNotMountable
+			continue
+		}
+		o.Action = MountActionMount
+		if !opts.DryRun {
+			folder, err := client.MountFolderContext(ctx, NewMountFolderArg(id))
+			if o.Err = err; err == nil {
+				o.Folder = folder
+			}
+		}
+	}
+	if !opts.Unmount {
+		return outcomes, nil
+	}
+	for _, id := range mountedOrder {
+		if wanted[id] {
+			continue
+		}
+		o := &MountOutcome{SharedFolderId: id, Folder: mounted[id], Action: MountActionUnmount}
+		outcomes = append(outcomes, o)
+		if !opts.DryRun {
+			o.Err = client.UnmountFolderContext(ctx, NewUnmountFolderArg(id))
+		}
+	}
+	return outcomes, nil
+}
@@ -0,0 +1,68 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestReconcileMounts(t *testing.T) {
+	var mounts, unmounts []string
+	folder := func(id string, path string) string {
+		return fmt.Sprintf(`{"access_type": {".tag": "editor"}, "is_inside_team_folder": false, "is_team_folder": false,
+			"path_lower": %q, "name": %q, "policy": {"acl_update_policy": {".tag": "owner"}, "shared_link_policy": {".tag": "anyone"}},
+			"preview_url": "https://www.dropbox.com/x", "shared_folder_id": %q, "time_invited": "2024-01-01T00:00:00Z",
+			"access_inheritance": {".tag": "inherit"}}`, path, id, id)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/sharing/list_folders":
+				_, _ = w.Write([]byte(`{"entries": [` + folder("sf1", "/a") + `,` + folder("sf2", "/b") + `]}`))
+			case "/sharing/list_mountable_folders":
+				_, _ = w.Write([]byte(`{"entries": [` + folder("sf3", "") + `]}`))
+			case "/sharing/mount_folder":
+				mounts = append(mounts, arg["shared_folder_id"].(string))
+				_, _ = w.Write([]byte(folder("sf3", "/c")))
+			case "/sharing/unmount_folder":
+				unmounts = append(unmounts, arg["shared_folder_id"].(string))
+				_, _ = w.Write([]byte(`null`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	desired := []string{"sf1", "sf3", "sf9"}
+	res, err := sharing.ReconcileMounts(context.Background(), dbx, desired, &sharing.ReconcileMountsOptions{Unmount: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 4 || len(mounts) != 0 || len(unmounts) != 0 {
+		t.Fatalf("Unexpected dry run: %d %v %v\n", len(res), mounts, unmounts)
+	}
+	res, err = sharing.ReconcileMounts(context.Background(), dbx, desired, &sharing.ReconcileMountsOptions{Unmount: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[0].Action != sharing.MountActionNone || res[1].Action != sharing.MountActionMount || res[1].Folder.PathLower != "/c" ||
+		res[2].Err != sharing.ErrFolderNotMountable || res[3].Action != sharing.MountActionUnmount || res[3].SharedFolderId != "sf2" {
+		t.Errorf("Unexpected outcomes: %+v %+v %+v %+v\n", res[0], res[1], res[2], res[3])
+	}
+	if strings.Join(mounts, ",") != "sf3" || strings.Join(unmounts, ",") != "sf2" {
+		t.Errorf("Unexpected requests: %v %v\n", mounts, unmounts)
+	}
+}
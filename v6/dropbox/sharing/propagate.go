@@ -0,0 +1,162 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// FolderChange is a member or ACL change applied to shared folders by
+// PropagateFolderChange.
+type FolderChange struct {
+	// Action is the FolderAction tag the caller must be allowed on a folder
+	// for the change to be applied, e.g. FolderActionInviteEditor.
+	Action string
+	// InheritedByChildren is set if the change reaches the nested folders
+	// that inherit their members from a changed folder, as additions do.
+	// Such folders are then skipped.
+	InheritedByChildren bool
+	// Apply changes folder.
+	Apply func(ctx context.Context, client Client, folder *SharedFolderMetadata) error
+}
+
+// AddFolderMembersChange adds members to the folders. It requires
+// FolderActionInviteEditor if a member is added with more than viewer
+// access, FolderActionInviteViewer otherwise.
+func AddFolderMembersChange(members []*AddMember, quiet bool) *FolderChange {
+	action := FolderActionInviteViewer
+	for _, m := range members {
+		if m.AccessLevel != nil && m.AccessLevel.Tag != AccessLevelViewer && m.AccessLevel.Tag != AccessLevelViewerNoComment {
+			action = FolderActionInviteEditor
+		}
+	}
+	return &FolderChange{
+		Action:              action,
+		InheritedByChildren: true,
+		Apply: func(ctx context.Context, client Client, folder *SharedFolderMetadata) error {
+			arg := NewAddFolderMemberArg(folder.SharedFolderId, members)
+			arg.Quiet = quiet
+			return client.AddFolderMemberContext(ctx, arg)
+		},
+	}
+}
+
+// UpdateFolderMemberChange changes the access level of member on the
+// folders.
+func UpdateFolderMemberChange(member *MemberSelector, level *AccessLevel) *FolderChange {
+	return &FolderChange{
+		Action: FolderActionInviteEditor,
+		Apply: func(ctx context.Context, client Client, folder *SharedFolderMetadata) error {
+			_, err := client.UpdateFolderMemberContext(ctx, NewUpdateFolderMemberArg(folder.SharedFolderId, member, level))
+			return err
+		},
+	}
+}
+
+// RemoveFolderMemberChange removes member from the folders, waiting for
+// each removal job to complete.
+func RemoveFolderMemberChange(member *MemberSelector, opts *async.JobOptions) *FolderChange {
+	return &FolderChange{
+		Action: FolderActionInviteEditor,
+		Apply: func(ctx context.Context, client Client, folder *SharedFolderMetadata) error {
+			launch, err := client.RemoveFolderMemberContext(ctx, NewRemoveFolderMemberArg(folder.SharedFolderId, member, false))
+			if err != nil || launch.Tag != async.LaunchResultBaseAsyncJobId {
+				return err
+			}
+			_, err = async.Await(ctx, launch.AsyncJobId, opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+				status, err := client.CheckRemoveMemberJobStatusContext(ctx, arg)
+				if err != nil {
+					return nil, true, err
+				}
+				if status.Tag == RemoveMemberJobStatusFailed && status.Failed != nil {
+					return nil, true, RemoveFolderMemberAPIError{
+						APIError:      dropbox.APIError{ErrorSummary: status.Failed.Tag},
+						EndpointError: status.Failed,
+					}
+				}
+				return nil, status.Tag != RemoveMemberJobStatusInProgress, nil
+			})
+			return err
+		},
+	}
+}
+
+// PropagationOutcome is the result of applying a FolderChange to a single
+// shared folder.
+type PropagationOutcome struct {
+	Path   string
+	Folder *SharedFolderMetadata
+	// Skipped is set if the folder inherits its members from a changed
+	// folder, so that the change already applies to it.
+	Skipped bool
+	// Denied is set if the caller isn't allowed the change's action on the
+	// folder, which was left unchanged.
+	Denied *FolderPermission
+	Err    error
+}
+
+// PropagateFolderChange applies change to every shared folder at or below
+// root, "" for the whole account, listed with filesClient. Folders are
+// changed one at a time, parents first. Failures don't stop the
+// propagation; every shared folder gets an outcome. The returned error is
+// only set if the folders couldn't be listed, along with the outcomes so
+// far.
+func PropagateFolderChange(ctx context.Context, filesClient files.Client, client Client, root string, change *FolderChange) ([]*PropagationOutcome, error) {
+	var outcomes []*PropagationOutcome
+	seen := make(map[string]bool)
+	changed := make(map[string]bool)
+	visit := func(entry files.IsMetadata) error {
+		folder, ok := entry.(*files.FolderMetadata)
+		if !ok || folder.SharingInfo == nil || folder.SharingInfo.SharedFolderId == "" || seen[folder.SharingInfo.SharedFolderId] {
+			return nil
+		}
+		seen[folder.SharingInfo.SharedFolderId] = true
+		o := &PropagationOutcome{Path: folder.PathDisplay}
+		outcomes = append(outcomes, o)
+		o.Folder, o.Denied, o.Err = folderForChange(ctx, client, folder.SharingInfo.SharedFolderId, change.Action)
+		if o.Err != nil || o.Denied != nil {
+			return nil
+		}
+		if change.InheritedByChildren && changed[o.Folder.ParentSharedFolderId] &&
+			(o.Folder.AccessInheritance == nil || o.Folder.AccessInheritance.Tag == AccessInheritanceInherit) {
+			o.Skipped = true
+			changed[o.Folder.SharedFolderId] = true
+			return nil
+		}
+		if o.Err = change.Apply(ctx, client, o.Folder); o.Err == nil {
+			changed[o.Folder.SharedFolderId] = true
+		}
+		return nil
+	}
+
+	if root != "" {
+		md, err := filesClient.GetMetadataContext(ctx, files.NewGetMetadataArg(root))
+		if err != nil {
+			return nil, err
+		}
+		_ = visit(md)
+	}
+	err := files.Walk(ctx, filesClient, root, visit, nil)
+	return outcomes, err
+}
+
+// folderForChange returns the metadata of the shared folder id, or the
+// permission denying action on it.
+func folderForChange(ctx context.Context, client Client, id string, action string) (*SharedFolderMetadata, *FolderPermission, error) {
+	arg := NewGetMetadataArgs(id)
+	if action != "" {
+		arg.Actions = []*FolderAction{{Tagged: dropbox.Tagged{Tag: action}}}
+	}
+	folder, err := client.GetFolderMetadataContext(ctx, arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, p := range folder.Permissions {
+		if p.Action != nil && p.Action.Tag == action && !p.Allow {
+			return folder, p, nil
+		}
+	}
+	return folder, nil, nil
+}
@@ -0,0 +1,75 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestPropagateFolderChange(t *testing.T) {
+	var added []string
+	folderEntry := func(path string, id string) string {
+		return fmt.Sprintf(`{".tag": "folder", "name": "x", "id": "id:%s", "path_lower": %q, "path_display": %q,
+			"sharing_info": {"read_only": false, "shared_folder_id": %q, "traverse_only": false, "no_access": false}}`, id, path, path, id)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/files/get_metadata":
+				_, _ = w.Write([]byte(folderEntry("/proj", "sf1")))
+			case "/files/list_folder":
+				_, _ = w.Write([]byte(`{"entries": [` + folderEntry("/proj", "sf1") + `,` + folderEntry("/proj/a", "sf2") + `,` +
+					folderEntry("/proj/b", "sf3") + `,` + folderEntry("/proj/c", "sf4") + `], "cursor": "c1", "has_more": false}`))
+			case "/sharing/get_folder_metadata":
+				id := arg["shared_folder_id"].(string)
+				inheritance, parent, allow := "inherit", "", true
+				switch id {
+				case "sf2":
+					parent = "sf1"
+				case "sf3":
+					parent, inheritance = "sf1", "no_inherit"
+				case "sf4":
+					parent, allow = "sf1", false
+				}
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"access_type": {".tag": "editor"}, "is_inside_team_folder": false, "is_team_folder": false,
+					"parent_shared_folder_id": %q, "name": "x", "policy": {"acl_update_policy": {".tag": "editors"}, "shared_link_policy": {".tag": "anyone"}},
+					"preview_url": "https://www.dropbox.com/x", "shared_folder_id": %q, "time_invited": "2024-01-01T00:00:00Z",
+					"access_inheritance": {".tag": %q}, "permissions": [{"action": {".tag": "invite_editor"}, "allow": %v}]}`, parent, id, inheritance, allow)))
+			case "/sharing/add_folder_member":
+				added = append(added, arg["shared_folder_id"].(string))
+				_, _ = w.Write([]byte(`null`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	member := sharing.NewAddMember(&sharing.MemberSelector{Tagged: dropbox.Tagged{Tag: sharing.MemberSelectorEmail}, Email: "a@example.com"})
+	member.AccessLevel = &sharing.AccessLevel{Tagged: dropbox.Tagged{Tag: sharing.AccessLevelEditor}}
+	change := sharing.AddFolderMembersChange([]*sharing.AddMember{member}, true)
+	res, err := sharing.PropagateFolderChange(context.Background(), files.New(config), sharing.New(config), "/proj", change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Action != sharing.FolderActionInviteEditor || len(res) != 4 {
+		t.Fatalf("Unexpected outcomes: %v %d\n", change.Action, len(res))
+	}
+	if len(added) != 2 || added[0] != "sf1" || added[1] != "sf3" {
+		t.Errorf("Unexpected changed folders: %v\n", added)
+	}
+	if !res[1].Skipped || res[1].Path != "/proj/a" || res[2].Skipped || res[3].Denied == nil || res[3].Err != nil {
+		t.Errorf("Unexpected outcomes: %+v %+v %+v\n", res[1], res[2], res[3])
+	}
+}
@@ -0,0 +1,52 @@
+package sharing
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"add_file_member":                  "sharing.write",
+	"add_folder_member":                "sharing.write",
+	"check_job_status":                 "sharing.write",
+	"check_remove_member_job_status":   "sharing.write",
+	"check_share_job_status":           "sharing.write",
+	"create_shared_link":               "sharing.write",
+	"create_shared_link_with_settings": "sharing.write",
+	"get_file_metadata":                "sharing.read",
+	"get_file_metadata/batch":          "sharing.read",
+	"get_folder_metadata":              "sharing.read",
+	"get_shared_link_file":             "sharing.read",
+	"get_shared_link_metadata":         "sharing.read",
+	"get_shared_links":                 "sharing.read",
+	"list_file_members":                "sharing.read",
+	"list_file_members/batch":          "sharing.read",
+	"list_file_members/continue":       "sharing.read",
+	"list_folder_members":              "sharing.read",
+	"list_folder_members/continue":     "sharing.read",
+	"list_folders":                     "sharing.read",
+	"list_folders/continue":            "sharing.read",
+	"list_mountable_folders":           "sharing.read",
+	"list_mountable_folders/continue":  "sharing.read",
+	"list_received_files":              "sharing.read",
+	"list_received_files/continue":     "sharing.read",
+	"list_shared_links":                "sharing.read",
+	"modify_shared_link_settings":      "sharing.write",
+	"mount_folder":                     "sharing.write",
+	"relinquish_file_membership":       "sharing.write",
+	"relinquish_folder_membership":     "sharing.write",
+	"remove_file_member":               "sharing.write",
+	"remove_file_member_2":             "sharing.write",
+	"remove_folder_member":             "sharing.write",
+	"revoke_shared_link":               "sharing.write",
+	"set_access_inheritance":           "sharing.write",
+	"share_folder":                     "sharing.write",
+	"transfer_folder":                  "sharing.write",
+	"unmount_folder":                   "sharing.write",
+	"unshare_file":                     "sharing.write",
+	"unshare_folder":                   "sharing.write",
+	"update_file_member":               "sharing.write",
+	"update_folder_member":             "sharing.write",
+	"update_folder_policy":             "sharing.write",
+}
@@ -0,0 +1,54 @@
+package sharing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestListSeenStates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/sharing/list_file_members/batch":
+				_, _ = w.Write([]byte(`[
+					{"file": "id:a", "result": {".tag": "result", "member_count": 2, "cursor": "c",
+						"members": {"users": [{"access_type": {".tag": "owner"}, "user": {"account_id": "dbid:1", "email": "a@example.com"},
+							"time_last_seen": "2024-05-01T10:00:00Z", "platform_type": {".tag": "web"}}], "groups": [], "invitees": [], "cursor": "c"}}},
+					{"file": "id:b", "result": {".tag": "access_error", "access_error": {".tag": "no_permission"}}}]`))
+			case "/sharing/list_file_members/continue":
+				_, _ = w.Write([]byte(`{"users": [{"access_type": {".tag": "viewer"}, "user": {"account_id": "dbid:2", "email": "b@example.com"}}],
+					"groups": [], "invitees": []}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	res, err := sharing.ListSeenStates(context.Background(), sharing.New(config), []string{"id:a", "id:b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || len(res[0].States) != 2 || res[0].Err != nil {
+		t.Fatalf("Unexpected result: %+v\n", res)
+	}
+	seen := res[0].States[0]
+	if seen.User.Email != "a@example.com" || seen.Platform != "web" || seen.TimeLastSeen == nil ||
+		!seen.TimeLastSeen.Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected seen state: %+v\n", seen)
+	}
+	if unseen := res[0].States[1]; unseen.User.Email != "b@example.com" || unseen.TimeLastSeen != nil {
+		t.Errorf("Unexpected seen state: %+v\n", unseen)
+	}
+	if res[1].AccessError == nil || res[1].AccessError.Tag != "no_permission" {
+		t.Errorf("Unexpected access error: %+v\n", res[1])
+	}
+}
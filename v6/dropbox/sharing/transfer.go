@@ -0,0 +1,88 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// ErrNewOwnerNotEditor is returned by TransferFolderAndVerify when the new
+// owner is a member of the folder without edit access.
+var ErrNewOwnerNotEditor = errors.New("new owner can't edit the shared folder")
+
+// TransferFolderResult is the result of TransferFolderAndVerify.
+type TransferFolderResult struct {
+	// Before is the folder as seen by the caller before the transfer.
+	Before *SharedFolderMetadata
+	// After is the folder as seen by the caller once the transfer is
+	// visible, the caller being an editor.
+	After *SharedFolderMetadata
+	// NewOwner is the membership of the new owner before the transfer.
+	NewOwner *UserMembershipInfo
+}
+
+// TransferFolderAndVerify transfers the ownership of the shared folder
+// sharedFolderID to the account or team member toDropboxID, then polls
+// `get_folder_metadata` until the caller is no longer the owner.
+//
+// Before transferring, it checks that the folder is not a team folder, that
+// the caller owns it and that the new owner is an editor of it. Failed
+// checks are reported with a TransferFolderAPIError, as if
+// `transfer_folder` had failed, or ErrNewOwnerNotEditor. Whether the new
+// owner has the folder mounted can only be checked by Dropbox, which fails
+// with TransferFolderErrorNewOwnerUnmounted if not.
+func TransferFolderAndVerify(ctx context.Context, client Client, sharedFolderID string, toDropboxID string, opts *async.JobOptions) (*TransferFolderResult, error) {
+	before, err := client.GetFolderMetadataContext(ctx, NewGetMetadataArgs(sharedFolderID))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case before.IsTeamFolder:
+		return nil, transferFolderError(TransferFolderErrorTeamFolder)
+	case !before.AccessType.Is(AccessLevelOwner):
+		return nil, transferFolderError(TransferFolderErrorNoPermission)
+	}
+	res := &TransferFolderResult{Before: before}
+	err = WalkFolderMembers(ctx, client, sharedFolderID, func(members *SharedFolderMembers) error {
+		for _, u := range members.Users {
+			if u.User != nil && (u.User.AccountId == toDropboxID || u.User.TeamMemberId == toDropboxID) {
+				res.NewOwner = u
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.NewOwner == nil {
+		return nil, transferFolderError(TransferFolderErrorNewOwnerNotAMember)
+	}
+	if !res.NewOwner.AccessType.Is(AccessLevelEditor) {
+		return nil, ErrNewOwnerNotEditor
+	}
+
+	if err = client.TransferFolderContext(ctx, NewTransferFolderArg(sharedFolderID, toDropboxID)); err != nil {
+		return nil, err
+	}
+	v, err := async.Await(ctx, sharedFolderID, opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		folder, err := client.GetFolderMetadataContext(ctx, NewGetMetadataArgs(arg.AsyncJobId))
+		if err != nil {
+			return nil, true, err
+		}
+		return folder, !folder.AccessType.Is(AccessLevelOwner), nil
+	})
+	if err != nil {
+		return res, err
+	}
+	res.After, _ = v.(*SharedFolderMetadata)
+	return res, nil
+}
+
+func transferFolderError(tag string) TransferFolderAPIError {
+	return TransferFolderAPIError{
+		APIError:      dropbox.APIError{ErrorSummary: tag},
+		EndpointError: &TransferFolderError{Tagged: dropbox.Tagged{Tag: tag}},
+	}
+}
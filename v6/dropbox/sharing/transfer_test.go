@@ -0,0 +1,75 @@
+package sharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestTransferFolderAndVerify(t *testing.T) {
+	transferred := false
+	checks := 0
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/sharing/get_folder_metadata":
+				access := "owner"
+				if transferred {
+					checks++
+					if checks > 1 {
+						access = "editor"
+					}
+				}
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"access_type": {".tag": %q}, "is_inside_team_folder": false, "is_team_folder": false,
+					"name": "Docs", "policy": {"acl_update_policy": {".tag": "owner"}, "shared_link_policy": {".tag": "anyone"}},
+					"preview_url": "https://www.dropbox.com/x", "shared_folder_id": "sf1", "time_invited": "2024-01-01T00:00:00Z",
+					"access_inheritance": {".tag": "inherit"}}`, access)))
+			case "/sharing/list_folder_members":
+				_, _ = w.Write([]byte(`{"users": [
+					{"access_type": {".tag": "owner"}, "is_inherited": false, "user": {"account_id": "dbid:me", "email": "me@example.com", "display_name": "Me", "same_team": true}},
+					{"access_type": {".tag": "editor"}, "is_inherited": false, "user": {"account_id": "dbid:ed", "email": "ed@example.com", "display_name": "Ed", "same_team": true}},
+					{"access_type": {".tag": "viewer"}, "is_inherited": false, "user": {"account_id": "dbid:vi", "email": "vi@example.com", "display_name": "Vi", "same_team": true}}],
+					"groups": [], "invitees": []}`))
+			case "/sharing/transfer_folder":
+				if arg["to_dropbox_id"] != "dbid:ed" {
+					t.Errorf("Unexpected transfer: %v\n", arg)
+				}
+				transferred = true
+				_, _ = w.Write([]byte(`null`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := sharing.New(config)
+	opts := &async.JobOptions{PollInterval: time.Millisecond, MaxPollInterval: 2 * time.Millisecond}
+	ctx := context.Background()
+	_, err := sharing.TransferFolderAndVerify(ctx, dbx, "sf1", "dbid:nobody", opts)
+	if apiErr, ok := err.(sharing.TransferFolderAPIError); !ok || apiErr.EndpointError.Tag != sharing.TransferFolderErrorNewOwnerNotAMember {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	if _, err = sharing.TransferFolderAndVerify(ctx, dbx, "sf1", "dbid:vi", opts); err != sharing.ErrNewOwnerNotEditor || transferred {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	res, err := sharing.TransferFolderAndVerify(ctx, dbx, "sf1", "dbid:ed", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NewOwner.User.Email != "ed@example.com" || res.After.AccessType.Tag != sharing.AccessLevelEditor || checks != 2 {
+		t.Errorf("Unexpected result: %+v %d\n", res, checks)
+	}
+}
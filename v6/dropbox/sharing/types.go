@@ -23,6 +23,7 @@
 package sharing
 
 import (
+	"bytes"
 	"encoding/json"
 	"time"
 
@@ -470,15 +471,10 @@ const (
 
 // UnmarshalJSON deserializes into a linkMetadataUnion instance
 func (u *linkMetadataUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "path":
 		if err = json.Unmarshal(body, &u.Path); err != nil {
@@ -508,7 +504,33 @@ func IsLinkMetadataFromJSON(data []byte) (IsLinkMetadata, error) {
 		return t.Collection, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownLinkMetadata{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.LinkMetadata); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownLinkMetadata is a LinkMetadata whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsLinkMetadataFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownLinkMetadata struct {
+	LinkMetadata
+	// Tag is the unknown `.tag` of the LinkMetadata.
+	Tag string
+	// Raw is the JSON the LinkMetadata was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownLinkMetadata) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.LinkMetadata)
 }
 
 // CollectionLinkMetadata : Metadata for a collection-based shared link.
@@ -835,15 +857,10 @@ const (
 
 // UnmarshalJSON deserializes into a sharedLinkMetadataUnion instance
 func (u *sharedLinkMetadataUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "file":
 		if err = json.Unmarshal(body, &u.File); err != nil {
@@ -873,7 +890,33 @@ func IsSharedLinkMetadataFromJSON(data []byte) (IsSharedLinkMetadata, error) {
 		return t.Folder, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownSharedLinkMetadata{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.SharedLinkMetadata); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownSharedLinkMetadata is a SharedLinkMetadata whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsSharedLinkMetadataFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownSharedLinkMetadata struct {
+	SharedLinkMetadata
+	// Tag is the unknown `.tag` of the SharedLinkMetadata.
+	Tag string
+	// Raw is the JSON the SharedLinkMetadata was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownSharedLinkMetadata) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.SharedLinkMetadata)
 }
 
 // FileLinkMetadata : The metadata of a file shared link.
@@ -1369,6 +1412,8 @@ type GetSharedLinkMetadataArg struct {
 	// LinkPassword : If the shared link has a password, this parameter can be
 	// used.
 	LinkPassword string `json:"link_password,omitempty"`
+	// ExtraHeaders can be used to pass Range, If-None-Match headers
+	ExtraHeaders map[string]string `json:"-"`
 }
 
 // NewGetSharedLinkMetadataArg returns a new GetSharedLinkMetadataArg instance
@@ -2854,15 +2899,10 @@ const (
 
 // UnmarshalJSON deserializes into a PermissionDeniedReason instance
 func (u *PermissionDeniedReason) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "insufficient_plan":
 		if err = json.Unmarshal(body, &u.InsufficientPlan); err != nil {
@@ -3501,15 +3541,10 @@ const (
 
 // UnmarshalJSON deserializes into a SharePathError instance
 func (u *SharePathError) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "already_shared":
 		if err = json.Unmarshal(body, &u.AlreadyShared); err != nil {
@@ -3659,15 +3694,10 @@ const (
 
 // UnmarshalJSON deserializes into a SharedFolderMemberError instance
 func (u *SharedFolderMemberError) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "no_explicit_access":
 		if err = json.Unmarshal(body, &u.NoExplicitAccess); err != nil {
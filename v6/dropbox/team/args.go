@@ -0,0 +1,379 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package team
+
+import (
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_common"
+)
+
+// WithStartDate sets StartDate and returns u
+func (u *DateRange) WithStartDate(StartDate *time.Time) *DateRange {
+	u.StartDate = StartDate
+	return u
+}
+
+// WithEndDate sets EndDate and returns u
+func (u *DateRange) WithEndDate(EndDate *time.Time) *DateRange {
+	u.EndDate = EndDate
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *ExcludedUsersListArg) WithLimit(Limit uint32) *ExcludedUsersListArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithUsers sets Users and returns u
+func (u *ExcludedUsersUpdateArg) WithUsers(Users []*UserSelectorArg) *ExcludedUsersUpdateArg {
+	u.Users = Users
+	return u
+}
+
+// WithAddCreatorAsOwner sets AddCreatorAsOwner and returns u
+func (u *GroupCreateArg) WithAddCreatorAsOwner(AddCreatorAsOwner bool) *GroupCreateArg {
+	u.AddCreatorAsOwner = AddCreatorAsOwner
+	return u
+}
+
+// WithGroupExternalId sets GroupExternalId and returns u
+func (u *GroupCreateArg) WithGroupExternalId(GroupExternalId string) *GroupCreateArg {
+	u.GroupExternalId = GroupExternalId
+	return u
+}
+
+// WithGroupManagementType sets GroupManagementType and returns u
+func (u *GroupCreateArg) WithGroupManagementType(GroupManagementType *team_common.GroupManagementType) *GroupCreateArg {
+	u.GroupManagementType = GroupManagementType
+	return u
+}
+
+// WithReturnMembers sets ReturnMembers and returns u
+func (u *GroupMembersAddArg) WithReturnMembers(ReturnMembers bool) *GroupMembersAddArg {
+	u.ReturnMembers = ReturnMembers
+	return u
+}
+
+// WithReturnMembers sets ReturnMembers and returns u
+func (u *GroupMembersRemoveArg) WithReturnMembers(ReturnMembers bool) *GroupMembersRemoveArg {
+	u.ReturnMembers = ReturnMembers
+	return u
+}
+
+// WithReturnMembers sets ReturnMembers and returns u
+func (u *GroupMembersSetAccessTypeArg) WithReturnMembers(ReturnMembers bool) *GroupMembersSetAccessTypeArg {
+	u.ReturnMembers = ReturnMembers
+	return u
+}
+
+// WithReturnMembers sets ReturnMembers and returns u
+func (u *GroupUpdateArgs) WithReturnMembers(ReturnMembers bool) *GroupUpdateArgs {
+	u.ReturnMembers = ReturnMembers
+	return u
+}
+
+// WithNewGroupName sets NewGroupName and returns u
+func (u *GroupUpdateArgs) WithNewGroupName(NewGroupName string) *GroupUpdateArgs {
+	u.NewGroupName = NewGroupName
+	return u
+}
+
+// WithNewGroupExternalId sets NewGroupExternalId and returns u
+func (u *GroupUpdateArgs) WithNewGroupExternalId(NewGroupExternalId string) *GroupUpdateArgs {
+	u.NewGroupExternalId = NewGroupExternalId
+	return u
+}
+
+// WithNewGroupManagementType sets NewGroupManagementType and returns u
+func (u *GroupUpdateArgs) WithNewGroupManagementType(NewGroupManagementType *team_common.GroupManagementType) *GroupUpdateArgs {
+	u.NewGroupManagementType = NewGroupManagementType
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *GroupsListArg) WithLimit(Limit uint32) *GroupsListArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *GroupsMembersListArg) WithLimit(Limit uint32) *GroupsMembersListArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *LegalHoldsListHeldRevisionsContinueArg) WithCursor(Cursor string) *LegalHoldsListHeldRevisionsContinueArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithIncludeReleased sets IncludeReleased and returns u
+func (u *LegalHoldsListPoliciesArg) WithIncludeReleased(IncludeReleased bool) *LegalHoldsListPoliciesArg {
+	u.IncludeReleased = IncludeReleased
+	return u
+}
+
+// WithDescription sets Description and returns u
+func (u *LegalHoldsPolicyCreateArg) WithDescription(Description string) *LegalHoldsPolicyCreateArg {
+	u.Description = Description
+	return u
+}
+
+// WithStartDate sets StartDate and returns u
+func (u *LegalHoldsPolicyCreateArg) WithStartDate(StartDate *time.Time) *LegalHoldsPolicyCreateArg {
+	u.StartDate = StartDate
+	return u
+}
+
+// WithEndDate sets EndDate and returns u
+func (u *LegalHoldsPolicyCreateArg) WithEndDate(EndDate *time.Time) *LegalHoldsPolicyCreateArg {
+	u.EndDate = EndDate
+	return u
+}
+
+// WithName sets Name and returns u
+func (u *LegalHoldsPolicyUpdateArg) WithName(Name string) *LegalHoldsPolicyUpdateArg {
+	u.Name = Name
+	return u
+}
+
+// WithDescription sets Description and returns u
+func (u *LegalHoldsPolicyUpdateArg) WithDescription(Description string) *LegalHoldsPolicyUpdateArg {
+	u.Description = Description
+	return u
+}
+
+// WithMembers sets Members and returns u
+func (u *LegalHoldsPolicyUpdateArg) WithMembers(Members []string) *LegalHoldsPolicyUpdateArg {
+	u.Members = Members
+	return u
+}
+
+// WithIncludeWebSessions sets IncludeWebSessions and returns u
+func (u *ListMemberDevicesArg) WithIncludeWebSessions(IncludeWebSessions bool) *ListMemberDevicesArg {
+	u.IncludeWebSessions = IncludeWebSessions
+	return u
+}
+
+// WithIncludeDesktopClients sets IncludeDesktopClients and returns u
+func (u *ListMemberDevicesArg) WithIncludeDesktopClients(IncludeDesktopClients bool) *ListMemberDevicesArg {
+	u.IncludeDesktopClients = IncludeDesktopClients
+	return u
+}
+
+// WithIncludeMobileClients sets IncludeMobileClients and returns u
+func (u *ListMemberDevicesArg) WithIncludeMobileClients(IncludeMobileClients bool) *ListMemberDevicesArg {
+	u.IncludeMobileClients = IncludeMobileClients
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *ListMembersAppsArg) WithCursor(Cursor string) *ListMembersAppsArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *ListMembersDevicesArg) WithCursor(Cursor string) *ListMembersDevicesArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithIncludeWebSessions sets IncludeWebSessions and returns u
+func (u *ListMembersDevicesArg) WithIncludeWebSessions(IncludeWebSessions bool) *ListMembersDevicesArg {
+	u.IncludeWebSessions = IncludeWebSessions
+	return u
+}
+
+// WithIncludeDesktopClients sets IncludeDesktopClients and returns u
+func (u *ListMembersDevicesArg) WithIncludeDesktopClients(IncludeDesktopClients bool) *ListMembersDevicesArg {
+	u.IncludeDesktopClients = IncludeDesktopClients
+	return u
+}
+
+// WithIncludeMobileClients sets IncludeMobileClients and returns u
+func (u *ListMembersDevicesArg) WithIncludeMobileClients(IncludeMobileClients bool) *ListMembersDevicesArg {
+	u.IncludeMobileClients = IncludeMobileClients
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *ListTeamAppsArg) WithCursor(Cursor string) *ListTeamAppsArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithCursor sets Cursor and returns u
+func (u *ListTeamDevicesArg) WithCursor(Cursor string) *ListTeamDevicesArg {
+	u.Cursor = Cursor
+	return u
+}
+
+// WithIncludeWebSessions sets IncludeWebSessions and returns u
+func (u *ListTeamDevicesArg) WithIncludeWebSessions(IncludeWebSessions bool) *ListTeamDevicesArg {
+	u.IncludeWebSessions = IncludeWebSessions
+	return u
+}
+
+// WithIncludeDesktopClients sets IncludeDesktopClients and returns u
+func (u *ListTeamDevicesArg) WithIncludeDesktopClients(IncludeDesktopClients bool) *ListTeamDevicesArg {
+	u.IncludeDesktopClients = IncludeDesktopClients
+	return u
+}
+
+// WithIncludeMobileClients sets IncludeMobileClients and returns u
+func (u *ListTeamDevicesArg) WithIncludeMobileClients(IncludeMobileClients bool) *ListTeamDevicesArg {
+	u.IncludeMobileClients = IncludeMobileClients
+	return u
+}
+
+// WithForceAsync sets ForceAsync and returns u
+func (u *MembersAddArg) WithForceAsync(ForceAsync bool) *MembersAddArg {
+	u.ForceAsync = ForceAsync
+	return u
+}
+
+// WithForceAsync sets ForceAsync and returns u
+func (u *MembersAddV2Arg) WithForceAsync(ForceAsync bool) *MembersAddV2Arg {
+	u.ForceAsync = ForceAsync
+	return u
+}
+
+// WithWipeData sets WipeData and returns u
+func (u *MembersDeactivateArg) WithWipeData(WipeData bool) *MembersDeactivateArg {
+	u.WipeData = WipeData
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *MembersListArg) WithLimit(Limit uint32) *MembersListArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithIncludeRemoved sets IncludeRemoved and returns u
+func (u *MembersListArg) WithIncludeRemoved(IncludeRemoved bool) *MembersListArg {
+	u.IncludeRemoved = IncludeRemoved
+	return u
+}
+
+// WithWipeData sets WipeData and returns u
+func (u *MembersRemoveArg) WithWipeData(WipeData bool) *MembersRemoveArg {
+	u.WipeData = WipeData
+	return u
+}
+
+// WithTransferDestId sets TransferDestId and returns u
+func (u *MembersRemoveArg) WithTransferDestId(TransferDestId *UserSelectorArg) *MembersRemoveArg {
+	u.TransferDestId = TransferDestId
+	return u
+}
+
+// WithTransferAdminId sets TransferAdminId and returns u
+func (u *MembersRemoveArg) WithTransferAdminId(TransferAdminId *UserSelectorArg) *MembersRemoveArg {
+	u.TransferAdminId = TransferAdminId
+	return u
+}
+
+// WithKeepAccount sets KeepAccount and returns u
+func (u *MembersRemoveArg) WithKeepAccount(KeepAccount bool) *MembersRemoveArg {
+	u.KeepAccount = KeepAccount
+	return u
+}
+
+// WithRetainTeamShares sets RetainTeamShares and returns u
+func (u *MembersRemoveArg) WithRetainTeamShares(RetainTeamShares bool) *MembersRemoveArg {
+	u.RetainTeamShares = RetainTeamShares
+	return u
+}
+
+// WithNewRoles sets NewRoles and returns u
+func (u *MembersSetPermissions2Arg) WithNewRoles(NewRoles []string) *MembersSetPermissions2Arg {
+	u.NewRoles = NewRoles
+	return u
+}
+
+// WithNewEmail sets NewEmail and returns u
+func (u *MembersSetProfileArg) WithNewEmail(NewEmail string) *MembersSetProfileArg {
+	u.NewEmail = NewEmail
+	return u
+}
+
+// WithNewExternalId sets NewExternalId and returns u
+func (u *MembersSetProfileArg) WithNewExternalId(NewExternalId string) *MembersSetProfileArg {
+	u.NewExternalId = NewExternalId
+	return u
+}
+
+// WithNewGivenName sets NewGivenName and returns u
+func (u *MembersSetProfileArg) WithNewGivenName(NewGivenName string) *MembersSetProfileArg {
+	u.NewGivenName = NewGivenName
+	return u
+}
+
+// WithNewSurname sets NewSurname and returns u
+func (u *MembersSetProfileArg) WithNewSurname(NewSurname string) *MembersSetProfileArg {
+	u.NewSurname = NewSurname
+	return u
+}
+
+// WithNewPersistentId sets NewPersistentId and returns u
+func (u *MembersSetProfileArg) WithNewPersistentId(NewPersistentId string) *MembersSetProfileArg {
+	u.NewPersistentId = NewPersistentId
+	return u
+}
+
+// WithNewIsDirectoryRestricted sets NewIsDirectoryRestricted and returns u
+func (u *MembersSetProfileArg) WithNewIsDirectoryRestricted(NewIsDirectoryRestricted bool) *MembersSetProfileArg {
+	u.NewIsDirectoryRestricted = NewIsDirectoryRestricted
+	return u
+}
+
+// WithKeepAppFolder sets KeepAppFolder and returns u
+func (u *RevokeLinkedApiAppArg) WithKeepAppFolder(KeepAppFolder bool) *RevokeLinkedApiAppArg {
+	u.KeepAppFolder = KeepAppFolder
+	return u
+}
+
+// WithForceAsyncOff sets ForceAsyncOff and returns u
+func (u *TeamFolderArchiveArg) WithForceAsyncOff(ForceAsyncOff bool) *TeamFolderArchiveArg {
+	u.ForceAsyncOff = ForceAsyncOff
+	return u
+}
+
+// WithSyncSetting sets SyncSetting and returns u
+func (u *TeamFolderCreateArg) WithSyncSetting(SyncSetting *files.SyncSettingArg) *TeamFolderCreateArg {
+	u.SyncSetting = SyncSetting
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *TeamFolderListArg) WithLimit(Limit uint32) *TeamFolderListArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithSyncSetting sets SyncSetting and returns u
+func (u *TeamFolderUpdateSyncSettingsArg) WithSyncSetting(SyncSetting *files.SyncSettingArg) *TeamFolderUpdateSyncSettingsArg {
+	u.SyncSetting = SyncSetting
+	return u
+}
+
+// WithContentSyncSettings sets ContentSyncSettings and returns u
+func (u *TeamFolderUpdateSyncSettingsArg) WithContentSyncSettings(ContentSyncSettings []*files.ContentSyncSettingArg) *TeamFolderUpdateSyncSettingsArg {
+	u.ContentSyncSettings = ContentSyncSettings
+	return u
+}
+
+// WithLimit sets Limit and returns u
+func (u *TeamNamespacesListArg) WithLimit(Limit uint32) *TeamNamespacesListArg {
+	u.Limit = Limit
+	return u
+}
@@ -0,0 +1,78 @@
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+)
+
+// MemberClients holds the clients of the user namespaces acting on behalf of
+// a team member, built from a team config.
+type MemberClients struct {
+	// Config is the team config with Dropbox-API-Select-User or
+	// Dropbox-API-Select-Admin set, for building clients of other
+	// namespaces.
+	Config         dropbox.Config
+	Files          files.Client
+	Sharing        sharing.Client
+	Users          users.Client
+	FileProperties file_properties.Client
+}
+
+func newMemberClients(config dropbox.Config) *MemberClients {
+	return &MemberClients{
+		Config:         config,
+		Files:          files.New(config),
+		Sharing:        sharing.New(config),
+		Users:          users.New(config),
+		FileProperties: file_properties.New(config),
+	}
+}
+
+// AsMember returns clients acting as the team member memberID, with the team
+// config config. Their requests are sent with Dropbox-API-Select-User.
+func AsMember(config dropbox.Config, memberID string) *MemberClients {
+	config.AsMemberID = memberID
+	config.AsAdminID = ""
+	return newMemberClients(config)
+}
+
+// AsAdmin returns clients acting as the team admin adminID, with access to
+// the team's content, with the team config config. Their requests are sent
+// with Dropbox-API-Select-Admin.
+func AsAdmin(config dropbox.Config, adminID string) *MemberClients {
+	config.AsMemberID = ""
+	config.AsAdminID = adminID
+	return newMemberClients(config)
+}
+
+// ForEachMember calls fn with the clients acting as every active member of
+// the team, one member at a time, listing the members with client. It
+// stops at the first error, returned by the listing or by fn.
+//
+//	err := team.ForEachMember(ctx, dbx, config, func(ctx context.Context, m *team.TeamMemberInfoV2, c *team.MemberClients) error {
+//		usage, err := c.Users.GetSpaceUsage()
+//		...
+//	})
+func ForEachMember(ctx context.Context, client Client, config dropbox.Config, fn func(ctx context.Context, member *TeamMemberInfoV2, clients *MemberClients) error) error {
+	it := NewMembersListV2Iterator(client, NewMembersListArg())
+	for it.HasMore() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range page.Members {
+			if m.Profile == nil || m.Profile.Status == nil || m.Profile.Status.Tag != TeamMemberStatusActive {
+				continue
+			}
+			if err = fn(ctx, m, AsMember(config, m.Profile.TeamMemberId)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
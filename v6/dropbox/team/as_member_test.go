@@ -0,0 +1,57 @@
+package team_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestForEachMember(t *testing.T) {
+	var mu sync.Mutex
+	var users []string
+	member := func(id string, status string) string {
+		return fmt.Sprintf(`{"profile": {"team_member_id": %q, "email": "%s@example.com", "email_verified": true, "status": {".tag": %q}, "name": {}, "membership_type": {".tag": "full"}, "groups": [], "member_folder_id": "1"}}`, id, id, status)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/members/list_v2":
+				_, _ = w.Write([]byte(`{"members": [` + member("a", "active") + `,` + member("b", "invited") + `], "cursor": "c1", "has_more": true}`))
+			case "/team/members/list/continue_v2":
+				_, _ = w.Write([]byte(`{"members": [` + member("c", "active") + `], "cursor": "c2", "has_more": false}`))
+			case "/users/get_space_usage":
+				mu.Lock()
+				users = append(users, r.Header.Get("Dropbox-API-Select-User"))
+				mu.Unlock()
+				_, _ = w.Write([]byte(`{"used": 1, "allocation": {".tag": "individual", "allocated": 10}}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	err := team.ForEachMember(context.Background(), team.New(config), config,
+		func(ctx context.Context, m *team.TeamMemberInfoV2, c *team.MemberClients) error {
+			_, err := c.Users.GetSpaceUsage()
+			return err
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(users, ",") != "a,c" {
+		t.Errorf("Unexpected members: %v\n", users)
+	}
+	if c := team.AsAdmin(config, "admin"); c.Config.AsAdminID != "admin" || c.Config.AsMemberID != "" {
+		t.Errorf("Unexpected config: %+v\n", c.Config)
+	}
+}
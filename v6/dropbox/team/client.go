@@ -25,7 +25,6 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"log"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
@@ -490,6 +489,11 @@ type DevicesListMemberDevicesAPIError struct {
 	EndpointError *ListMemberDevicesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DevicesListMemberDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -532,6 +536,11 @@ type DevicesListMembersDevicesAPIError struct {
 	EndpointError *ListMembersDevicesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DevicesListMembersDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -574,9 +583,13 @@ type DevicesListTeamDevicesAPIError struct {
 	EndpointError *ListTeamDevicesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DevicesListTeamDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
-	log.Printf("WARNING: API `DevicesListTeamDevices` is deprecated")
-	log.Printf("Use API `DevicesListMembersDevices` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("DevicesListTeamDevices", "DevicesListMembersDevices")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -619,6 +632,11 @@ type DevicesRevokeDeviceSessionAPIError struct {
 	EndpointError *RevokeDeviceSessionError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DevicesRevokeDeviceSessionAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -657,6 +675,11 @@ type DevicesRevokeDeviceSessionBatchAPIError struct {
 	EndpointError *RevokeDeviceSessionBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e DevicesRevokeDeviceSessionBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -699,6 +722,11 @@ type FeaturesGetValuesAPIError struct {
 	EndpointError *FeaturesGetValuesBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e FeaturesGetValuesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -741,6 +769,11 @@ type GetInfoAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetInfoContext(ctx context.Context) (res *TeamGetInfoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -783,6 +816,11 @@ type GroupsCreateAPIError struct {
 	EndpointError *GroupCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsCreateContext(ctx context.Context, arg *GroupCreateArg) (res *GroupFullInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -825,6 +863,11 @@ type GroupsDeleteAPIError struct {
 	EndpointError *GroupDeleteError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsDeleteContext(ctx context.Context, arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -867,6 +910,11 @@ type GroupsGetInfoAPIError struct {
 	EndpointError *GroupsGetInfoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -909,6 +957,11 @@ type GroupsJobStatusGetAPIError struct {
 	EndpointError *GroupsPollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -951,6 +1004,11 @@ type GroupsListAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsListContext(ctx context.Context, arg *GroupsListArg) (res *GroupsListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -993,6 +1051,11 @@ type GroupsListContinueAPIError struct {
 	EndpointError *GroupsListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1035,6 +1098,11 @@ type GroupsMembersAddAPIError struct {
 	EndpointError *GroupMembersAddError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsMembersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1077,6 +1145,11 @@ type GroupsMembersListAPIError struct {
 	EndpointError *GroupSelectorError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsMembersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1119,6 +1192,11 @@ type GroupsMembersListContinueAPIError struct {
 	EndpointError *GroupsMembersListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsMembersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1161,6 +1239,11 @@ type GroupsMembersRemoveAPIError struct {
 	EndpointError *GroupMembersRemoveError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsMembersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1203,6 +1286,11 @@ type GroupsMembersSetAccessTypeAPIError struct {
 	EndpointError *GroupMemberSetAccessTypeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsMembersSetAccessTypeAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1245,6 +1333,11 @@ type GroupsUpdateAPIError struct {
 	EndpointError *GroupUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GroupsUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1287,6 +1380,11 @@ type LegalHoldsCreatePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsCreatePolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsCreatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1329,6 +1427,11 @@ type LegalHoldsGetPolicyAPIError struct {
 	EndpointError *LegalHoldsGetPolicyError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsGetPolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsGetPolicyContext(ctx context.Context, arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1371,6 +1474,11 @@ type LegalHoldsListHeldRevisionsAPIError struct {
 	EndpointError *LegalHoldsListHeldRevisionsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsListHeldRevisionsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsListHeldRevisionsContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1413,6 +1521,11 @@ type LegalHoldsListHeldRevisionsContinueAPIError struct {
 	EndpointError *LegalHoldsListHeldRevisionsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsListHeldRevisionsContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsListHeldRevisionsContinueContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1455,6 +1568,11 @@ type LegalHoldsListPoliciesAPIError struct {
 	EndpointError *LegalHoldsListPoliciesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsListPoliciesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsListPoliciesContext(ctx context.Context, arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1497,6 +1615,11 @@ type LegalHoldsReleasePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyReleaseError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsReleasePolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsReleasePolicyContext(ctx context.Context, arg *LegalHoldsPolicyReleaseArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1535,6 +1658,11 @@ type LegalHoldsUpdatePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LegalHoldsUpdatePolicyAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LegalHoldsUpdatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1577,6 +1705,11 @@ type LinkedAppsListMemberLinkedAppsAPIError struct {
 	EndpointError *ListMemberAppsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LinkedAppsListMemberLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1619,6 +1752,11 @@ type LinkedAppsListMembersLinkedAppsAPIError struct {
 	EndpointError *ListMembersAppsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LinkedAppsListMembersLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1661,9 +1799,13 @@ type LinkedAppsListTeamLinkedAppsAPIError struct {
 	EndpointError *ListTeamAppsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LinkedAppsListTeamLinkedAppsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
-	log.Printf("WARNING: API `LinkedAppsListTeamLinkedApps` is deprecated")
-	log.Printf("Use API `LinkedAppsListMembersLinkedApps` instead")
+	(*dropbox.Context)(dbx).WarnDeprecated("LinkedAppsListTeamLinkedApps", "LinkedAppsListMembersLinkedApps")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -1706,6 +1848,11 @@ type LinkedAppsRevokeLinkedAppAPIError struct {
 	EndpointError *RevokeLinkedAppError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LinkedAppsRevokeLinkedAppAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1744,6 +1891,11 @@ type LinkedAppsRevokeLinkedAppBatchAPIError struct {
 	EndpointError *RevokeLinkedAppBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e LinkedAppsRevokeLinkedAppBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1786,6 +1938,11 @@ type MemberSpaceLimitsExcludedUsersAddAPIError struct {
 	EndpointError *ExcludedUsersUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsExcludedUsersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersAddContext(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1828,6 +1985,11 @@ type MemberSpaceLimitsExcludedUsersListAPIError struct {
 	EndpointError *ExcludedUsersListError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsExcludedUsersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContext(ctx context.Context, arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1870,6 +2032,11 @@ type MemberSpaceLimitsExcludedUsersListContinueAPIError struct {
 	EndpointError *ExcludedUsersListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsExcludedUsersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContinueContext(ctx context.Context, arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1912,6 +2079,11 @@ type MemberSpaceLimitsExcludedUsersRemoveAPIError struct {
 	EndpointError *ExcludedUsersUpdateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsExcludedUsersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersRemoveContext(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1954,6 +2126,11 @@ type MemberSpaceLimitsGetCustomQuotaAPIError struct {
 	EndpointError *CustomQuotaError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsGetCustomQuotaAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsGetCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -1996,6 +2173,11 @@ type MemberSpaceLimitsRemoveCustomQuotaAPIError struct {
 	EndpointError *CustomQuotaError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsRemoveCustomQuotaAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsRemoveCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2038,6 +2220,11 @@ type MemberSpaceLimitsSetCustomQuotaAPIError struct {
 	EndpointError *SetCustomQuotaError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MemberSpaceLimitsSetCustomQuotaAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MemberSpaceLimitsSetCustomQuotaContext(ctx context.Context, arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2080,6 +2267,11 @@ type MembersAddAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersAddContext(ctx context.Context, arg *MembersAddArg) (res *MembersAddLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2122,6 +2314,11 @@ type MembersAddV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersAddV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersAddV2Context(ctx context.Context, arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2164,6 +2361,11 @@ type MembersAddJobStatusGetAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersAddJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2206,6 +2408,11 @@ type MembersAddJobStatusGetV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersAddJobStatusGetV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersAddJobStatusGetV2Context(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2248,6 +2455,11 @@ type MembersDeleteProfilePhotoAPIError struct {
 	EndpointError *MembersDeleteProfilePhotoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersDeleteProfilePhotoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersDeleteProfilePhotoContext(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2290,6 +2502,11 @@ type MembersDeleteProfilePhotoV2APIError struct {
 	EndpointError *MembersDeleteProfilePhotoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersDeleteProfilePhotoV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersDeleteProfilePhotoV2Context(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2332,6 +2549,11 @@ type MembersGetAvailableTeamMemberRolesAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersGetAvailableTeamMemberRolesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersGetAvailableTeamMemberRolesContext(ctx context.Context) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2374,6 +2596,11 @@ type MembersGetInfoAPIError struct {
 	EndpointError *MembersGetInfoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2416,6 +2643,11 @@ type MembersGetInfoV2APIError struct {
 	EndpointError *MembersGetInfoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersGetInfoV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersGetInfoV2Context(ctx context.Context, arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2458,6 +2690,11 @@ type MembersListAPIError struct {
 	EndpointError *MembersListError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersListContext(ctx context.Context, arg *MembersListArg) (res *MembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2500,6 +2737,11 @@ type MembersListV2APIError struct {
 	EndpointError *MembersListError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersListV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersListV2Context(ctx context.Context, arg *MembersListArg) (res *MembersListV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2542,6 +2784,11 @@ type MembersListContinueAPIError struct {
 	EndpointError *MembersListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg) (res *MembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2584,6 +2831,11 @@ type MembersListContinueV2APIError struct {
 	EndpointError *MembersListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersListContinueV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersListContinueV2Context(ctx context.Context, arg *MembersListContinueArg) (res *MembersListV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2626,6 +2878,11 @@ type MembersMoveFormerMemberFilesAPIError struct {
 	EndpointError *MembersTransferFormerMembersFilesError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersMoveFormerMemberFilesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersMoveFormerMemberFilesContext(ctx context.Context, arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2668,6 +2925,11 @@ type MembersMoveFormerMemberFilesJobStatusCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersMoveFormerMemberFilesJobStatusCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersMoveFormerMemberFilesJobStatusCheckContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2710,6 +2972,11 @@ type MembersRecoverAPIError struct {
 	EndpointError *MembersRecoverError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersRecoverAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2748,6 +3015,11 @@ type MembersRemoveAPIError struct {
 	EndpointError *MembersRemoveError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersRemoveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2790,6 +3062,11 @@ type MembersRemoveJobStatusGetAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersRemoveJobStatusGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2832,6 +3109,11 @@ type MembersSecondaryEmailsAddAPIError struct {
 	EndpointError *AddSecondaryEmailsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSecondaryEmailsAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSecondaryEmailsAddContext(ctx context.Context, arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2874,6 +3156,11 @@ type MembersSecondaryEmailsDeleteAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSecondaryEmailsDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSecondaryEmailsDeleteContext(ctx context.Context, arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2916,6 +3203,11 @@ type MembersSecondaryEmailsResendVerificationEmailsAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSecondaryEmailsResendVerificationEmailsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSecondaryEmailsResendVerificationEmailsContext(ctx context.Context, arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2958,6 +3250,11 @@ type MembersSendWelcomeEmailAPIError struct {
 	EndpointError *MembersSendWelcomeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSendWelcomeEmailAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -2996,6 +3293,11 @@ type MembersSetAdminPermissionsAPIError struct {
 	EndpointError *MembersSetPermissionsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetAdminPermissionsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3038,6 +3340,11 @@ type MembersSetAdminPermissionsV2APIError struct {
 	EndpointError *MembersSetPermissions2Error `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetAdminPermissionsV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetAdminPermissionsV2Context(ctx context.Context, arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3080,6 +3387,11 @@ type MembersSetProfileAPIError struct {
 	EndpointError *MembersSetProfileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetProfileAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3122,6 +3434,11 @@ type MembersSetProfileV2APIError struct {
 	EndpointError *MembersSetProfileError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetProfileV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetProfileV2Context(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3164,6 +3481,11 @@ type MembersSetProfilePhotoAPIError struct {
 	EndpointError *MembersSetProfilePhotoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetProfilePhotoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetProfilePhotoContext(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3206,6 +3528,11 @@ type MembersSetProfilePhotoV2APIError struct {
 	EndpointError *MembersSetProfilePhotoError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSetProfilePhotoV2APIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSetProfilePhotoV2Context(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3248,6 +3575,11 @@ type MembersSuspendAPIError struct {
 	EndpointError *MembersSuspendError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersSuspendAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3286,6 +3618,11 @@ type MembersUnsuspendAPIError struct {
 	EndpointError *MembersUnsuspendError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e MembersUnsuspendAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3324,6 +3661,11 @@ type NamespacesListAPIError struct {
 	EndpointError *TeamNamespacesListError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e NamespacesListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) NamespacesListContext(ctx context.Context, arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3366,6 +3708,11 @@ type NamespacesListContinueAPIError struct {
 	EndpointError *TeamNamespacesListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e NamespacesListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) NamespacesListContinueContext(ctx context.Context, arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3408,8 +3755,13 @@ type PropertiesTemplateAddAPIError struct {
 	EndpointError *file_properties.ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateAddAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateAddContext(ctx context.Context, arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateAdd` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateAdd", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3452,8 +3804,13 @@ type PropertiesTemplateGetAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateGetAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateGet` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateGet", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3496,8 +3853,13 @@ type PropertiesTemplateListAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateListContext(ctx context.Context) (res *file_properties.ListTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateList` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateList", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3540,8 +3902,13 @@ type PropertiesTemplateUpdateAPIError struct {
 	EndpointError *file_properties.ModifyTemplateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e PropertiesTemplateUpdateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) PropertiesTemplateUpdateContext(ctx context.Context, arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error) {
-	log.Printf("WARNING: API `PropertiesTemplateUpdate` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("PropertiesTemplateUpdate", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3584,8 +3951,13 @@ type ReportsGetActivityAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ReportsGetActivityAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ReportsGetActivityContext(ctx context.Context, arg *DateRange) (res *GetActivityReport, err error) {
-	log.Printf("WARNING: API `ReportsGetActivity` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("ReportsGetActivity", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3628,8 +4000,13 @@ type ReportsGetDevicesAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ReportsGetDevicesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ReportsGetDevicesContext(ctx context.Context, arg *DateRange) (res *GetDevicesReport, err error) {
-	log.Printf("WARNING: API `ReportsGetDevices` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("ReportsGetDevices", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3672,8 +4049,13 @@ type ReportsGetMembershipAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ReportsGetMembershipAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ReportsGetMembershipContext(ctx context.Context, arg *DateRange) (res *GetMembershipReport, err error) {
-	log.Printf("WARNING: API `ReportsGetMembership` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("ReportsGetMembership", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3716,8 +4098,13 @@ type ReportsGetStorageAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e ReportsGetStorageAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) ReportsGetStorageContext(ctx context.Context, arg *DateRange) (res *GetStorageReport, err error) {
-	log.Printf("WARNING: API `ReportsGetStorage` is deprecated")
+	(*dropbox.Context)(dbx).WarnDeprecated("ReportsGetStorage", "")
 
 	req := dropbox.Request{
 		Host:         "api",
@@ -3760,6 +4147,11 @@ type TeamFolderActivateAPIError struct {
 	EndpointError *TeamFolderActivateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderActivateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3802,6 +4194,11 @@ type TeamFolderArchiveAPIError struct {
 	EndpointError *TeamFolderArchiveError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderArchiveAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3844,6 +4241,11 @@ type TeamFolderArchiveCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderArchiveCheckAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3886,6 +4288,11 @@ type TeamFolderCreateAPIError struct {
 	EndpointError *TeamFolderCreateError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderCreateAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3928,6 +4335,11 @@ type TeamFolderGetInfoAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderGetInfoAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -3970,6 +4382,11 @@ type TeamFolderListAPIError struct {
 	EndpointError *TeamFolderListError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderListAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -4012,6 +4429,11 @@ type TeamFolderListContinueAPIError struct {
 	EndpointError *TeamFolderListContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderListContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -4054,6 +4476,11 @@ type TeamFolderPermanentlyDeleteAPIError struct {
 	EndpointError *TeamFolderPermanentlyDeleteError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderPermanentlyDeleteAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -4092,6 +4519,11 @@ type TeamFolderRenameAPIError struct {
 	EndpointError *TeamFolderRenameError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderRenameAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -4134,6 +4566,11 @@ type TeamFolderUpdateSyncSettingsAPIError struct {
 	EndpointError *TeamFolderUpdateSyncSettingsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TeamFolderUpdateSyncSettingsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TeamFolderUpdateSyncSettingsContext(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -4176,6 +4613,11 @@ type TokenGetAuthenticatedAdminAPIError struct {
 	EndpointError *TokenGetAuthenticatedAdminError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e TokenGetAuthenticatedAdminAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) TokenGetAuthenticatedAdminContext(ctx context.Context) (res *TokenGetAuthenticatedAdminResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -0,0 +1,82 @@
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// RevokeSessionsOptions controls RevokeAllSessions.
+type RevokeSessionsOptions struct {
+	// DeleteOnUnlink deletes the member's files from the desktop clients
+	// that support it when they are unlinked.
+	DeleteOnUnlink bool
+}
+
+// SessionRevocation is the result of revoking a single session.
+type SessionRevocation struct {
+	// Kind is one of the RevokeDeviceSessionArg tags, e.g.
+	// RevokeDeviceSessionArgWebSession.
+	Kind    string
+	Session *DeviceSession
+	// Failure tells why the session wasn't revoked.
+	Failure *RevokeDeviceSessionError
+}
+
+// RevokeAllSessions revokes all the web sessions, desktop clients and
+// mobile clients of the team member memberID with a single
+// `devices/revoke_device_session_batch` request, and returns a revocation
+// per session.
+func RevokeAllSessions(ctx context.Context, client Client, memberID string, opts *RevokeSessionsOptions) ([]*SessionRevocation, error) {
+	if opts == nil {
+		opts = &RevokeSessionsOptions{}
+	}
+	arg := NewListMemberDevicesArg(memberID)
+	arg.IncludeWebSessions = true
+	arg.IncludeDesktopClients = true
+	arg.IncludeMobileClients = true
+	devices, err := client.DevicesListMemberDevicesContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*SessionRevocation
+	var revoke []*RevokeDeviceSessionArg
+	for _, s := range devices.ActiveWebSessions {
+		res = append(res, &SessionRevocation{Kind: RevokeDeviceSessionArgWebSession, Session: &s.DeviceSession})
+		revoke = append(revoke, &RevokeDeviceSessionArg{Tagged: dropbox.Tagged{Tag: RevokeDeviceSessionArgWebSession},
+			WebSession: NewDeviceSessionArg(s.SessionId, memberID)})
+	}
+	for _, s := range devices.DesktopClientSessions {
+		desktop := NewRevokeDesktopClientArg(s.SessionId, memberID)
+		desktop.DeleteOnUnlink = opts.DeleteOnUnlink && s.IsDeleteOnUnlinkSupported
+		res = append(res, &SessionRevocation{Kind: RevokeDeviceSessionArgDesktopClient, Session: &s.DeviceSession})
+		revoke = append(revoke, &RevokeDeviceSessionArg{Tagged: dropbox.Tagged{Tag: RevokeDeviceSessionArgDesktopClient},
+			DesktopClient: desktop})
+	}
+	for _, s := range devices.MobileClientSessions {
+		res = append(res, &SessionRevocation{Kind: RevokeDeviceSessionArgMobileClient, Session: &s.DeviceSession})
+		revoke = append(revoke, &RevokeDeviceSessionArg{Tagged: dropbox.Tagged{Tag: RevokeDeviceSessionArgMobileClient},
+			MobileClient: NewDeviceSessionArg(s.SessionId, memberID)})
+	}
+	if len(revoke) == 0 {
+		return nil, nil
+	}
+
+	result, err := client.DevicesRevokeDeviceSessionBatchContext(ctx, NewRevokeDeviceSessionBatchArg(revoke))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.RevokeDevicesStatus) != len(res) {
+		return nil, errUnexpectedBatchResult
+	}
+	for i, status := range result.RevokeDevicesStatus {
+		if !status.Success {
+			res[i].Failure = status.ErrorType
+			if res[i].Failure == nil {
+				res[i].Failure = &RevokeDeviceSessionError{Tagged: dropbox.Tagged{Tag: RevokeDeviceSessionErrorOther}}
+			}
+		}
+	}
+	return res, nil
+}
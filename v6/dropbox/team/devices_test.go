@@ -0,0 +1,54 @@
+package team_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestRevokeAllSessions(t *testing.T) {
+	var batch struct {
+		RevokeDevices []struct {
+			Tag           string                       `json:".tag"`
+			DesktopClient *team.RevokeDesktopClientArg `json:"desktop_client"`
+			MobileClient  *team.DeviceSessionArg       `json:"mobile_client"`
+		} `json:"revoke_devices"`
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/devices/list_member_devices":
+				_, _ = w.Write([]byte(`{
+					"active_web_sessions": [{"session_id": "web1", "user_agent": "ua", "os": "mac", "browser": "firefox"}],
+					"desktop_client_sessions": [{"session_id": "desk1", "host_name": "h", "client_type": {".tag": "mac"}, "client_version": "1", "platform": "mac", "is_delete_on_unlink_supported": true}],
+					"mobile_client_sessions": [{"session_id": "mob1", "device_name": "phone", "client_type": {".tag": "iphone"}}]}`))
+			case "/team/devices/revoke_device_session_batch":
+				_ = json.NewDecoder(r.Body).Decode(&batch)
+				_, _ = w.Write([]byte(`{"revoke_devices_status": [{"success": true}, {"success": true}, {"success": false, "error_type": {".tag": "device_session_not_found"}}]}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	res, err := team.RevokeAllSessions(context.Background(), team.New(config), "dbmid:1", &team.RevokeSessionsOptions{DeleteOnUnlink: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batch.RevokeDevices) != 3 || batch.RevokeDevices[1].DesktopClient == nil || !batch.RevokeDevices[1].DesktopClient.DeleteOnUnlink ||
+		batch.RevokeDevices[2].MobileClient.TeamMemberId != "dbmid:1" {
+		t.Errorf("Unexpected batch: %+v\n", batch)
+	}
+	if len(res) != 3 || res[0].Session.SessionId != "web1" || res[0].Failure != nil ||
+		res[2].Kind != team.RevokeDeviceSessionArgMobileClient || res[2].Failure.Tag != team.RevokeDeviceSessionErrorDeviceSessionNotFound {
+		t.Errorf("Unexpected revocations: %+v\n", res)
+	}
+}
@@ -0,0 +1,640 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// DevicesListMembersDevicesIterator iterates over the pages returned by `DevicesListMembersDevices`.
+type DevicesListMembersDevicesIterator struct {
+	client  Client
+	arg     *ListMembersDevicesArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewDevicesListMembersDevicesIterator returns a new DevicesListMembersDevicesIterator instance.
+// A nil arg lists with the default arguments.
+func NewDevicesListMembersDevicesIterator(client Client, arg *ListMembersDevicesArg) *DevicesListMembersDevicesIterator {
+	if arg == nil {
+		arg = NewListMembersDevicesArg()
+	}
+	return &DevicesListMembersDevicesIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *DevicesListMembersDevicesIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *DevicesListMembersDevicesIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *DevicesListMembersDevicesIterator) Next(ctx context.Context) (res *ListMembersDevicesResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	arg := *it.arg
+	if it.started {
+		arg.Cursor = it.cursor
+	}
+	res, err = it.client.DevicesListMembersDevicesContext(ctx, &arg)
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// DevicesListTeamDevicesIterator iterates over the pages returned by `DevicesListTeamDevices`.
+type DevicesListTeamDevicesIterator struct {
+	client  Client
+	arg     *ListTeamDevicesArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewDevicesListTeamDevicesIterator returns a new DevicesListTeamDevicesIterator instance.
+// A nil arg lists with the default arguments.
+func NewDevicesListTeamDevicesIterator(client Client, arg *ListTeamDevicesArg) *DevicesListTeamDevicesIterator {
+	if arg == nil {
+		arg = NewListTeamDevicesArg()
+	}
+	return &DevicesListTeamDevicesIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *DevicesListTeamDevicesIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *DevicesListTeamDevicesIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *DevicesListTeamDevicesIterator) Next(ctx context.Context) (res *ListTeamDevicesResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	arg := *it.arg
+	if it.started {
+		arg.Cursor = it.cursor
+	}
+	res, err = it.client.DevicesListTeamDevicesContext(ctx, &arg)
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// GroupsListIterator iterates over the pages returned by `GroupsList` and
+// `GroupsListContinue`.
+type GroupsListIterator struct {
+	client  Client
+	arg     *GroupsListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewGroupsListIterator returns a new GroupsListIterator instance.
+// A nil arg lists with the default arguments.
+func NewGroupsListIterator(client Client, arg *GroupsListArg) *GroupsListIterator {
+	if arg == nil {
+		arg = NewGroupsListArg()
+	}
+	return &GroupsListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *GroupsListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *GroupsListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *GroupsListIterator) Next(ctx context.Context) (res *GroupsListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.GroupsListContext(ctx, it.arg)
+	default:
+		arg := NewGroupsListContinueArg(it.cursor)
+		res, err = it.client.GroupsListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// GroupsMembersListIterator iterates over the pages returned by `GroupsMembersList` and
+// `GroupsMembersListContinue`.
+type GroupsMembersListIterator struct {
+	client  Client
+	arg     *GroupsMembersListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewGroupsMembersListIterator returns a new GroupsMembersListIterator instance.
+// arg is required, as `GroupsMembersList` has required arguments.
+func NewGroupsMembersListIterator(client Client, arg *GroupsMembersListArg) *GroupsMembersListIterator {
+	if arg == nil {
+		panic("team: NewGroupsMembersListIterator called with a nil arg")
+	}
+	return &GroupsMembersListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *GroupsMembersListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *GroupsMembersListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *GroupsMembersListIterator) Next(ctx context.Context) (res *GroupsMembersListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.GroupsMembersListContext(ctx, it.arg)
+	default:
+		arg := NewGroupsMembersListContinueArg(it.cursor)
+		res, err = it.client.GroupsMembersListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// LegalHoldsListHeldRevisionsIterator iterates over the pages returned by `LegalHoldsListHeldRevisions` and
+// `LegalHoldsListHeldRevisionsContinue`.
+type LegalHoldsListHeldRevisionsIterator struct {
+	client  Client
+	arg     *LegalHoldsListHeldRevisionsArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewLegalHoldsListHeldRevisionsIterator returns a new LegalHoldsListHeldRevisionsIterator instance.
+// arg is required, as `LegalHoldsListHeldRevisions` has required arguments.
+func NewLegalHoldsListHeldRevisionsIterator(client Client, arg *LegalHoldsListHeldRevisionsArg) *LegalHoldsListHeldRevisionsIterator {
+	if arg == nil {
+		panic("team: NewLegalHoldsListHeldRevisionsIterator called with a nil arg")
+	}
+	return &LegalHoldsListHeldRevisionsIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *LegalHoldsListHeldRevisionsIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *LegalHoldsListHeldRevisionsIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *LegalHoldsListHeldRevisionsIterator) Next(ctx context.Context) (res *LegalHoldsListHeldRevisionResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.LegalHoldsListHeldRevisionsContext(ctx, it.arg)
+	default:
+		arg := NewLegalHoldsListHeldRevisionsContinueArg(it.arg.Id)
+		arg.Cursor = it.cursor
+		res, err = it.client.LegalHoldsListHeldRevisionsContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// LinkedAppsListMembersLinkedAppsIterator iterates over the pages returned by `LinkedAppsListMembersLinkedApps`.
+type LinkedAppsListMembersLinkedAppsIterator struct {
+	client  Client
+	arg     *ListMembersAppsArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewLinkedAppsListMembersLinkedAppsIterator returns a new LinkedAppsListMembersLinkedAppsIterator instance.
+// A nil arg lists with the default arguments.
+func NewLinkedAppsListMembersLinkedAppsIterator(client Client, arg *ListMembersAppsArg) *LinkedAppsListMembersLinkedAppsIterator {
+	if arg == nil {
+		arg = NewListMembersAppsArg()
+	}
+	return &LinkedAppsListMembersLinkedAppsIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *LinkedAppsListMembersLinkedAppsIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *LinkedAppsListMembersLinkedAppsIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *LinkedAppsListMembersLinkedAppsIterator) Next(ctx context.Context) (res *ListMembersAppsResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	arg := *it.arg
+	if it.started {
+		arg.Cursor = it.cursor
+	}
+	res, err = it.client.LinkedAppsListMembersLinkedAppsContext(ctx, &arg)
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// LinkedAppsListTeamLinkedAppsIterator iterates over the pages returned by `LinkedAppsListTeamLinkedApps`.
+type LinkedAppsListTeamLinkedAppsIterator struct {
+	client  Client
+	arg     *ListTeamAppsArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewLinkedAppsListTeamLinkedAppsIterator returns a new LinkedAppsListTeamLinkedAppsIterator instance.
+// A nil arg lists with the default arguments.
+func NewLinkedAppsListTeamLinkedAppsIterator(client Client, arg *ListTeamAppsArg) *LinkedAppsListTeamLinkedAppsIterator {
+	if arg == nil {
+		arg = NewListTeamAppsArg()
+	}
+	return &LinkedAppsListTeamLinkedAppsIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *LinkedAppsListTeamLinkedAppsIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *LinkedAppsListTeamLinkedAppsIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *LinkedAppsListTeamLinkedAppsIterator) Next(ctx context.Context) (res *ListTeamAppsResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	arg := *it.arg
+	if it.started {
+		arg.Cursor = it.cursor
+	}
+	res, err = it.client.LinkedAppsListTeamLinkedAppsContext(ctx, &arg)
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// MemberSpaceLimitsExcludedUsersListIterator iterates over the pages returned by `MemberSpaceLimitsExcludedUsersList` and
+// `MemberSpaceLimitsExcludedUsersListContinue`.
+type MemberSpaceLimitsExcludedUsersListIterator struct {
+	client  Client
+	arg     *ExcludedUsersListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewMemberSpaceLimitsExcludedUsersListIterator returns a new MemberSpaceLimitsExcludedUsersListIterator instance.
+// A nil arg lists with the default arguments.
+func NewMemberSpaceLimitsExcludedUsersListIterator(client Client, arg *ExcludedUsersListArg) *MemberSpaceLimitsExcludedUsersListIterator {
+	if arg == nil {
+		arg = NewExcludedUsersListArg()
+	}
+	return &MemberSpaceLimitsExcludedUsersListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *MemberSpaceLimitsExcludedUsersListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Next(ctx context.Context) (res *ExcludedUsersListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.MemberSpaceLimitsExcludedUsersListContext(ctx, it.arg)
+	default:
+		arg := NewExcludedUsersListContinueArg(it.cursor)
+		res, err = it.client.MemberSpaceLimitsExcludedUsersListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// MembersListIterator iterates over the pages returned by `MembersList` and
+// `MembersListContinue`.
+type MembersListIterator struct {
+	client  Client
+	arg     *MembersListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewMembersListIterator returns a new MembersListIterator instance.
+// A nil arg lists with the default arguments.
+func NewMembersListIterator(client Client, arg *MembersListArg) *MembersListIterator {
+	if arg == nil {
+		arg = NewMembersListArg()
+	}
+	return &MembersListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *MembersListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *MembersListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *MembersListIterator) Next(ctx context.Context) (res *MembersListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.MembersListContext(ctx, it.arg)
+	default:
+		arg := NewMembersListContinueArg(it.cursor)
+		res, err = it.client.MembersListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// MembersListV2Iterator iterates over the pages returned by `MembersListV2` and
+// `MembersListContinueV2`.
+type MembersListV2Iterator struct {
+	client  Client
+	arg     *MembersListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewMembersListV2Iterator returns a new MembersListV2Iterator instance.
+// A nil arg lists with the default arguments.
+func NewMembersListV2Iterator(client Client, arg *MembersListArg) *MembersListV2Iterator {
+	if arg == nil {
+		arg = NewMembersListArg()
+	}
+	return &MembersListV2Iterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *MembersListV2Iterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *MembersListV2Iterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *MembersListV2Iterator) Next(ctx context.Context) (res *MembersListV2Result, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.MembersListV2Context(ctx, it.arg)
+	default:
+		arg := NewMembersListContinueArg(it.cursor)
+		res, err = it.client.MembersListContinueV2Context(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// NamespacesListIterator iterates over the pages returned by `NamespacesList` and
+// `NamespacesListContinue`.
+type NamespacesListIterator struct {
+	client  Client
+	arg     *TeamNamespacesListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewNamespacesListIterator returns a new NamespacesListIterator instance.
+// A nil arg lists with the default arguments.
+func NewNamespacesListIterator(client Client, arg *TeamNamespacesListArg) *NamespacesListIterator {
+	if arg == nil {
+		arg = NewTeamNamespacesListArg()
+	}
+	return &NamespacesListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *NamespacesListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *NamespacesListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *NamespacesListIterator) Next(ctx context.Context) (res *TeamNamespacesListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.NamespacesListContext(ctx, it.arg)
+	default:
+		arg := NewTeamNamespacesListContinueArg(it.cursor)
+		res, err = it.client.NamespacesListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
+
+// TeamFolderListIterator iterates over the pages returned by `TeamFolderList` and
+// `TeamFolderListContinue`.
+type TeamFolderListIterator struct {
+	client  Client
+	arg     *TeamFolderListArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewTeamFolderListIterator returns a new TeamFolderListIterator instance.
+// A nil arg lists with the default arguments.
+func NewTeamFolderListIterator(client Client, arg *TeamFolderListArg) *TeamFolderListIterator {
+	if arg == nil {
+		arg = NewTeamFolderListArg()
+	}
+	return &TeamFolderListIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *TeamFolderListIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *TeamFolderListIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *TeamFolderListIterator) Next(ctx context.Context) (res *TeamFolderListResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.TeamFolderListContext(ctx, it.arg)
+	default:
+		arg := NewTeamFolderListContinueArg(it.cursor)
+		res, err = it.client.TeamFolderListContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
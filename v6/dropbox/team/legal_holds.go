@@ -0,0 +1,116 @@
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// legalHoldSettled reports whether a legal hold with status is no longer
+// being changed by Dropbox.
+func legalHoldSettled(status *LegalHoldStatus) bool {
+	if status == nil {
+		return true
+	}
+	switch status.Tag {
+	case LegalHoldStatusActivating, LegalHoldStatusUpdating, LegalHoldStatusExporting, LegalHoldStatusReleasing:
+		return false
+	}
+	return true
+}
+
+// WaitForLegalHold polls the legal hold id with `legal_holds/get_policy`
+// until Dropbox is done activating, updating, exporting or releasing it,
+// and returns the settled policy.
+func WaitForLegalHold(ctx context.Context, client Client, id string, opts *async.JobOptions) (*LegalHoldPolicy, error) {
+	v, err := async.Await(ctx, id, opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		policy, err := client.LegalHoldsGetPolicyContext(ctx, NewLegalHoldsGetPolicyArg(id))
+		if err != nil {
+			return nil, true, err
+		}
+		return policy, legalHoldSettled(policy.Status), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	policy, _ := v.(*LegalHoldPolicy)
+	return policy, nil
+}
+
+// CreateLegalHold creates a legal hold policy and waits for it to be
+// active, or released if its end date has passed.
+func CreateLegalHold(ctx context.Context, client Client, arg *LegalHoldsPolicyCreateArg, opts *async.JobOptions) (*LegalHoldPolicy, error) {
+	policy, err := client.LegalHoldsCreatePolicyContext(ctx, arg)
+	if err != nil || legalHoldSettled(policy.Status) {
+		return policy, err
+	}
+	return WaitForLegalHold(ctx, client, policy.Id, opts)
+}
+
+// UpdateLegalHold updates a legal hold policy and waits for the update to
+// be applied.
+func UpdateLegalHold(ctx context.Context, client Client, arg *LegalHoldsPolicyUpdateArg, opts *async.JobOptions) (*LegalHoldPolicy, error) {
+	policy, err := client.LegalHoldsUpdatePolicyContext(ctx, arg)
+	if err != nil || legalHoldSettled(policy.Status) {
+		return policy, err
+	}
+	return WaitForLegalHold(ctx, client, policy.Id, opts)
+}
+
+// ReleaseLegalHold releases the legal hold id and waits for it to be
+// released.
+func ReleaseLegalHold(ctx context.Context, client Client, id string, opts *async.JobOptions) (*LegalHoldPolicy, error) {
+	if err := client.LegalHoldsReleasePolicyContext(ctx, NewLegalHoldsPolicyReleaseArg(id)); err != nil {
+		return nil, err
+	}
+	return WaitForLegalHold(ctx, client, id, opts)
+}
+
+// ListLegalHolds returns the legal hold policies of the team, including the
+// released ones if includeReleased is set.
+func ListLegalHolds(ctx context.Context, client Client, includeReleased bool) ([]*LegalHoldPolicy, error) {
+	arg := NewLegalHoldsListPoliciesArg()
+	arg.IncludeReleased = includeReleased
+	res, err := client.LegalHoldsListPoliciesContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return res.Policies, nil
+}
+
+// HeldRevisionIterator iterates over the file revisions held by a legal
+// hold, fetching the pages of `legal_holds/list_held_revisions` and
+// `legal_holds/list_held_revisions_continue` as needed. Listing fails with
+// LegalHoldsListHeldRevisionsErrorLegalHoldStillEmpty until the hold is
+// active; see WaitForLegalHold.
+type HeldRevisionIterator struct {
+	pages     *LegalHoldsListHeldRevisionsIterator
+	revisions []*LegalHoldHeldRevisionMetadata
+}
+
+// NewHeldRevisionIterator returns a new HeldRevisionIterator instance
+func NewHeldRevisionIterator(client Client, id string) *HeldRevisionIterator {
+	return &HeldRevisionIterator{pages: NewLegalHoldsListHeldRevisionsIterator(client, NewLegalHoldsListHeldRevisionsArg(id))}
+}
+
+// HasMore returns false once all revisions have been returned. As a page may
+// be empty, Next can still return `dropbox.ErrNoMorePages` after HasMore
+// returned true.
+func (it *HeldRevisionIterator) HasMore() bool {
+	return len(it.revisions) > 0 || it.pages.HasMore()
+}
+
+// Next returns the next held revision, fetching the next page if needed. It
+// returns `dropbox.ErrNoMorePages` once all revisions have been returned.
+func (it *HeldRevisionIterator) Next(ctx context.Context) (*LegalHoldHeldRevisionMetadata, error) {
+	for len(it.revisions) == 0 {
+		res, err := it.pages.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		it.revisions = res.Entries
+	}
+	rev := it.revisions[0]
+	it.revisions = it.revisions[1:]
+	return rev, nil
+}
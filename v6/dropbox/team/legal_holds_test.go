@@ -0,0 +1,83 @@
+package team_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestLegalHolds(t *testing.T) {
+	gets := 0
+	policy := func(status string) string {
+		return fmt.Sprintf(`{"id": "pid:1", "name": "Case", "members": {"team_member_ids": ["dbmid:1"], "permanently_deleted_users": 0}, "status": {".tag": %q}, "start_date": "2024-01-01T00:00:00Z"}`, status)
+	}
+	revision := func(name string) string {
+		return fmt.Sprintf(`{"new_filename": %q, "original_revision_id": "r", "original_file_path": "/a", "server_modified": "2024-01-01T00:00:00Z", "author_member_id": "dbmid:1", "author_member_status": {".tag": "active"}, "author_email": "a@example.com", "file_type": "txt", "size": 1, "content_hash": "h"}`, name)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/legal_holds/create_policy":
+				_, _ = w.Write([]byte(policy("activating")))
+			case "/team/legal_holds/get_policy":
+				gets++
+				switch gets {
+				case 1:
+					_, _ = w.Write([]byte(policy("activating")))
+				case 2:
+					_, _ = w.Write([]byte(policy("active")))
+				default:
+					_, _ = w.Write([]byte(policy("released")))
+				}
+			case "/team/legal_holds/release_policy":
+				_, _ = w.Write([]byte("null"))
+			case "/team/legal_holds/list_policies":
+				_, _ = w.Write([]byte(`{"policies": [` + policy("active") + `]}`))
+			case "/team/legal_holds/list_held_revisions":
+				_, _ = w.Write([]byte(`{"entries": [` + revision("a") + `], "cursor": "c1", "has_more": true}`))
+			case "/team/legal_holds/list_held_revisions_continue":
+				_, _ = w.Write([]byte(`{"entries": [` + revision("b") + `], "has_more": false}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := team.New(config)
+	ctx := context.Background()
+	opts := &async.JobOptions{PollInterval: time.Millisecond}
+	p, err := team.CreateLegalHold(ctx, client, team.NewLegalHoldsPolicyCreateArg("Case", []string{"dbmid:1"}), opts)
+	if err != nil || p.Status.Tag != team.LegalHoldStatusActive || gets != 2 {
+		t.Fatalf("Unexpected policy: %+v %v %d\n", p, err, gets)
+	}
+	policies, err := team.ListLegalHolds(ctx, client, false)
+	if err != nil || len(policies) != 1 {
+		t.Errorf("Unexpected policies: %v %v\n", policies, err)
+	}
+	it := team.NewHeldRevisionIterator(client, p.Id)
+	var names []string
+	for it.HasMore() {
+		rev, err := it.Next(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, rev.NewFilename)
+	}
+	if strings.Join(names, ",") != "a,b" {
+		t.Errorf("Unexpected revisions: %v\n", names)
+	}
+	if p, err = team.ReleaseLegalHold(ctx, client, p.Id, opts); err != nil || p.Status.Tag != team.LegalHoldStatusReleased {
+		t.Errorf("Unexpected policy: %+v %v\n", p, err)
+	}
+}
@@ -0,0 +1,153 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// MembersOptions controls AddMembers and RemoveMembers.
+type MembersOptions struct {
+	async.JobOptions
+	// Concurrency is the number of members removed in parallel by
+	// RemoveMembers. Defaults to 4.
+	Concurrency int
+}
+
+// MemberAddOutcome is the result of adding a single member.
+type MemberAddOutcome struct {
+	Member *MemberAddV2Arg
+	// Info describes the added member, on success.
+	Info *TeamMemberInfoV2
+	// Failure tells why the member wasn't added; its tag is one of the
+	// MemberAddV2Result tags other than MemberAddV2ResultSuccess.
+	Failure *MemberAddV2Result
+}
+
+// MemberRemoveOutcome is the result of removing a single member.
+type MemberRemoveOutcome struct {
+	Member *MembersRemoveArg
+	// Err is the error returned by `members/remove` or by its job, if any.
+	Err error
+}
+
+// MembersAddFailedError is returned when a `members/add_v2` job failed as a
+// whole.
+type MembersAddFailedError struct {
+	Message string
+}
+
+func (e MembersAddFailedError) Error() string {
+	return fmt.Sprintf("members add failed: %s", e.Message)
+}
+
+// errUnexpectedBatchResult is returned for batch responses of an unknown
+// type.
+var errUnexpectedBatchResult = errors.New("unexpected batch result")
+
+// AddMembers adds members to the team with `members/add_v2`, in batches of
+// dropbox.MaxTeamMembersAddBatch members submitted one after the other, and
+// waits for every job to complete. The outcomes are in the order of
+// members. If a request fails, the outcomes of the batches completed so far
+// are returned along with the error.
+func AddMembers(ctx context.Context, client Client, members []*MemberAddV2Arg, opts *MembersOptions) ([]*MemberAddOutcome, error) {
+	if opts == nil {
+		opts = &MembersOptions{}
+	}
+	var res []*MemberAddOutcome
+	for start := 0; start < len(members); start += dropbox.MaxTeamMembersAddBatch {
+		end := start + dropbox.MaxTeamMembersAddBatch
+		if end > len(members) {
+			end = len(members)
+		}
+		chunk := members[start:end]
+		launch, err := client.MembersAddV2Context(ctx, NewMembersAddV2Arg(chunk))
+		if err != nil {
+			return res, err
+		}
+		result := launch.Complete
+		if launch.Tag == MembersAddLaunchV2ResultAsyncJobId {
+			v, err := async.Await(ctx, launch.AsyncJobId, &opts.JobOptions, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+				status, err := client.MembersAddJobStatusGetV2Context(ctx, arg)
+				if err != nil {
+					return nil, true, err
+				}
+				if status.Tag == MembersAddJobStatusV2ResultFailed {
+					return nil, true, MembersAddFailedError{Message: status.Failed}
+				}
+				return status.Complete, status.Tag != MembersAddJobStatusV2ResultInProgress, nil
+			})
+			if err != nil {
+				return res, err
+			}
+			result, _ = v.([]*MemberAddV2Result)
+		}
+		if len(result) != len(chunk) {
+			return res, errUnexpectedBatchResult
+		}
+		for i, m := range chunk {
+			o := &MemberAddOutcome{Member: m}
+			if r := result[i]; r.Tag == MemberAddV2ResultSuccess {
+				o.Info = r.Success
+			} else {
+				o.Failure = r
+			}
+			res = append(res, o)
+		}
+	}
+	return res, nil
+}
+
+// RemoveMembers removes members from the team with `members/remove`, with up
+// to opts.Concurrency removals in flight, and waits for their jobs to
+// complete. A failure doesn't stop the other members from being removed;
+// the outcomes are in the order of members, those not started when ctx is
+// cancelled failing with its error.
+func RemoveMembers(ctx context.Context, client Client, members []*MembersRemoveArg, opts *MembersOptions) []*MemberRemoveOutcome {
+	if opts == nil {
+		opts = &MembersOptions{}
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 4
+	}
+
+	res := make([]*MemberRemoveOutcome, len(members))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, m := range members {
+		o := &MemberRemoveOutcome{Member: m}
+		res[i] = o
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			// The members not started fail with the error of ctx.
+			o.Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			launch, err := client.MembersRemoveContext(ctx, o.Member)
+			if err != nil || launch.Tag != async.LaunchEmptyResultAsyncJobId {
+				o.Err = err
+				return
+			}
+			_, o.Err = async.Await(ctx, launch.AsyncJobId, &opts.JobOptions, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+				status, err := client.MembersRemoveJobStatusGetContext(ctx, arg)
+				if err != nil {
+					return nil, true, err
+				}
+				return nil, status.Tag != async.PollEmptyResultInProgress, nil
+			})
+		}()
+	}
+	wg.Wait()
+	return res
+}
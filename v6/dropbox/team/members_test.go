@@ -0,0 +1,131 @@
+package team_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestTeamMembers(t *testing.T) {
+	var mu sync.Mutex
+	var batches []int
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mu.Lock()
+			defer mu.Unlock()
+			switch r.URL.Path {
+			case "/team/members/add_v2":
+				var arg team.MembersAddV2Arg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				batches = append(batches, len(arg.NewMembers))
+				if len(batches) == 1 {
+					_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "job1"}`))
+					return
+				}
+				var results []string
+				for _, m := range arg.NewMembers {
+					if strings.HasPrefix(m.MemberEmail, "taken") {
+						results = append(results, fmt.Sprintf(`{".tag": "user_already_on_team", "user_already_on_team": %q}`, m.MemberEmail))
+					} else {
+						results = append(results, fmt.Sprintf(`{".tag": "success", "profile": {"team_member_id": "dbmid:%s", "email": %q}}`, m.MemberEmail, m.MemberEmail))
+					}
+				}
+				_, _ = w.Write([]byte(`{".tag": "complete", "complete": [` + strings.Join(results, ",") + `]}`))
+			case "/team/members/add/job_status/get_v2":
+				polls++
+				if polls == 1 {
+					_, _ = w.Write([]byte(`{".tag": "in_progress"}`))
+					return
+				}
+				var results []string
+				for i := 0; i < 20; i++ {
+					results = append(results, fmt.Sprintf(`{".tag": "success", "profile": {"team_member_id": "dbmid:%d"}}`, i))
+				}
+				_, _ = w.Write([]byte(`{".tag": "complete", "complete": [` + strings.Join(results, ",") + `]}`))
+			case "/team/members/remove":
+				var arg team.MembersRemoveArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				if arg.User.Email == "gone@example.com" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"error_summary": "user_not_found/", "error": {".tag": "user_not_found"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "job2"}`))
+			case "/team/members/remove/job_status/get":
+				_, _ = w.Write([]byte(`{".tag": "complete"}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := team.New(config)
+	opts := &team.MembersOptions{JobOptions: async.JobOptions{PollInterval: time.Millisecond}}
+	var members []*team.MemberAddV2Arg
+	for i := 0; i < 24; i++ {
+		members = append(members, team.NewMemberAddV2Arg(fmt.Sprintf("user%d@example.com", i)))
+	}
+	members = append(members, team.NewMemberAddV2Arg("taken@example.com"))
+	outcomes, err := team.AddMembers(context.Background(), client, members, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 2 || batches[0] != 20 || batches[1] != 5 || polls != 2 {
+		t.Errorf("Unexpected batches: %v %d\n", batches, polls)
+	}
+	if len(outcomes) != 25 || outcomes[3].Info == nil || outcomes[3].Info.Profile.TeamMemberId != "dbmid:3" ||
+		outcomes[24].Failure == nil || outcomes[24].Failure.Tag != team.MemberAddV2ResultUserAlreadyOnTeam {
+		t.Errorf("Unexpected outcomes: %+v\n", outcomes)
+	}
+
+	removals := []*team.MembersRemoveArg{
+		{MembersDeactivateArg: *team.NewMembersDeactivateArg(&team.UserSelectorArg{Tagged: dropbox.Tagged{Tag: team.UserSelectorArgEmail}, Email: "a@example.com"})},
+		{MembersDeactivateArg: *team.NewMembersDeactivateArg(&team.UserSelectorArg{Tagged: dropbox.Tagged{Tag: team.UserSelectorArgEmail}, Email: "gone@example.com"})},
+	}
+	removed := team.RemoveMembers(context.Background(), client, removals, opts)
+	if len(removed) != 2 || removed[0].Err != nil || removed[1].Err == nil {
+		t.Errorf("Unexpected removals: %+v %+v\n", removed[0], removed[1])
+	}
+}
+
+func TestRemoveMembersCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+	client := &team.Mock{
+		MembersRemoveFunc: func(ctx context.Context, arg *team.MembersRemoveArg) (*async.LaunchEmptyResult, error) {
+			atomic.AddInt32(&calls, 1)
+			cancel()
+			return nil, ctx.Err()
+		},
+	}
+	var removals []*team.MembersRemoveArg
+	for i := 0; i < 5; i++ {
+		removals = append(removals, team.NewMembersRemoveArg(&team.UserSelectorArg{
+			Tagged: dropbox.Tagged{Tag: team.UserSelectorArgEmail}, Email: fmt.Sprintf("u%d@example.com", i)}))
+	}
+	outcomes := team.RemoveMembers(ctx, client, removals, &team.MembersOptions{Concurrency: 1})
+	if calls != 1 {
+		t.Errorf("Unexpected removals after cancellation: %d\n", calls)
+	}
+	for _, o := range outcomes {
+		if o.Err != context.Canceled {
+			t.Errorf("Unexpected outcome of %s: %v\n", o.Member.User.Email, o.Err)
+		}
+	}
+}
@@ -0,0 +1,1194 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	DevicesListMemberDevicesFunc                       func(ctx context.Context, arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error)
+	DevicesListMembersDevicesFunc                      func(ctx context.Context, arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error)
+	DevicesListTeamDevicesFunc                         func(ctx context.Context, arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error)
+	DevicesRevokeDeviceSessionFunc                     func(ctx context.Context, arg *RevokeDeviceSessionArg) (err error)
+	DevicesRevokeDeviceSessionBatchFunc                func(ctx context.Context, arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error)
+	FeaturesGetValuesFunc                              func(ctx context.Context, arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error)
+	GetInfoFunc                                        func(ctx context.Context) (res *TeamGetInfoResult, err error)
+	GroupsCreateFunc                                   func(ctx context.Context, arg *GroupCreateArg) (res *GroupFullInfo, err error)
+	GroupsDeleteFunc                                   func(ctx context.Context, arg *GroupSelector) (res *async.LaunchEmptyResult, err error)
+	GroupsGetInfoFunc                                  func(ctx context.Context, arg *GroupsSelector) (res []*GroupsGetInfoItem, err error)
+	GroupsJobStatusGetFunc                             func(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	GroupsListFunc                                     func(ctx context.Context, arg *GroupsListArg) (res *GroupsListResult, err error)
+	GroupsListContinueFunc                             func(ctx context.Context, arg *GroupsListContinueArg) (res *GroupsListResult, err error)
+	GroupsMembersAddFunc                               func(ctx context.Context, arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error)
+	GroupsMembersListFunc                              func(ctx context.Context, arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error)
+	GroupsMembersListContinueFunc                      func(ctx context.Context, arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error)
+	GroupsMembersRemoveFunc                            func(ctx context.Context, arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error)
+	GroupsMembersSetAccessTypeFunc                     func(ctx context.Context, arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error)
+	GroupsUpdateFunc                                   func(ctx context.Context, arg *GroupUpdateArgs) (res *GroupFullInfo, err error)
+	LegalHoldsCreatePolicyFunc                         func(ctx context.Context, arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error)
+	LegalHoldsGetPolicyFunc                            func(ctx context.Context, arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error)
+	LegalHoldsListHeldRevisionsFunc                    func(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListHeldRevisionsContinueFunc            func(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListPoliciesFunc                         func(ctx context.Context, arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error)
+	LegalHoldsReleasePolicyFunc                        func(ctx context.Context, arg *LegalHoldsPolicyReleaseArg) (err error)
+	LegalHoldsUpdatePolicyFunc                         func(ctx context.Context, arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error)
+	LinkedAppsListMemberLinkedAppsFunc                 func(ctx context.Context, arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error)
+	LinkedAppsListMembersLinkedAppsFunc                func(ctx context.Context, arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error)
+	LinkedAppsListTeamLinkedAppsFunc                   func(ctx context.Context, arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error)
+	LinkedAppsRevokeLinkedAppFunc                      func(ctx context.Context, arg *RevokeLinkedApiAppArg) (err error)
+	LinkedAppsRevokeLinkedAppBatchFunc                 func(ctx context.Context, arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error)
+	MemberSpaceLimitsExcludedUsersAddFunc              func(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsExcludedUsersListFunc             func(ctx context.Context, arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersListContinueFunc     func(ctx context.Context, arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersRemoveFunc           func(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsGetCustomQuotaFunc                func(ctx context.Context, arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error)
+	MemberSpaceLimitsRemoveCustomQuotaFunc             func(ctx context.Context, arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error)
+	MemberSpaceLimitsSetCustomQuotaFunc                func(ctx context.Context, arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error)
+	MembersAddFunc                                     func(ctx context.Context, arg *MembersAddArg) (res *MembersAddLaunch, err error)
+	MembersAddV2Func                                   func(ctx context.Context, arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error)
+	MembersAddJobStatusGetFunc                         func(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatus, err error)
+	MembersAddJobStatusGetV2Func                       func(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error)
+	MembersDeleteProfilePhotoFunc                      func(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error)
+	MembersDeleteProfilePhotoV2Func                    func(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error)
+	MembersGetAvailableTeamMemberRolesFunc             func(ctx context.Context) (res *MembersGetAvailableTeamMemberRolesResult, err error)
+	MembersGetInfoFunc                                 func(ctx context.Context, arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error)
+	MembersGetInfoV2Func                               func(ctx context.Context, arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error)
+	MembersListFunc                                    func(ctx context.Context, arg *MembersListArg) (res *MembersListResult, err error)
+	MembersListV2Func                                  func(ctx context.Context, arg *MembersListArg) (res *MembersListV2Result, err error)
+	MembersListContinueFunc                            func(ctx context.Context, arg *MembersListContinueArg) (res *MembersListResult, err error)
+	MembersListContinueV2Func                          func(ctx context.Context, arg *MembersListContinueArg) (res *MembersListV2Result, err error)
+	MembersMoveFormerMemberFilesFunc                   func(ctx context.Context, arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error)
+	MembersMoveFormerMemberFilesJobStatusCheckFunc     func(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	MembersRecoverFunc                                 func(ctx context.Context, arg *MembersRecoverArg) (err error)
+	MembersRemoveFunc                                  func(ctx context.Context, arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error)
+	MembersRemoveJobStatusGetFunc                      func(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	MembersSecondaryEmailsAddFunc                      func(ctx context.Context, arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsDeleteFunc                   func(ctx context.Context, arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsResendVerificationEmailsFunc func(ctx context.Context, arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error)
+	MembersSendWelcomeEmailFunc                        func(ctx context.Context, arg *UserSelectorArg) (err error)
+	MembersSetAdminPermissionsFunc                     func(ctx context.Context, arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error)
+	MembersSetAdminPermissionsV2Func                   func(ctx context.Context, arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error)
+	MembersSetProfileFunc                              func(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfo, err error)
+	MembersSetProfileV2Func                            func(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error)
+	MembersSetProfilePhotoFunc                         func(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error)
+	MembersSetProfilePhotoV2Func                       func(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error)
+	MembersSuspendFunc                                 func(ctx context.Context, arg *MembersDeactivateArg) (err error)
+	MembersUnsuspendFunc                               func(ctx context.Context, arg *MembersUnsuspendArg) (err error)
+	NamespacesListFunc                                 func(ctx context.Context, arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error)
+	NamespacesListContinueFunc                         func(ctx context.Context, arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error)
+	PropertiesTemplateAddFunc                          func(ctx context.Context, arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error)
+	PropertiesTemplateGetFunc                          func(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateListFunc                         func(ctx context.Context) (res *file_properties.ListTemplateResult, err error)
+	PropertiesTemplateUpdateFunc                       func(ctx context.Context, arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error)
+	ReportsGetActivityFunc                             func(ctx context.Context, arg *DateRange) (res *GetActivityReport, err error)
+	ReportsGetDevicesFunc                              func(ctx context.Context, arg *DateRange) (res *GetDevicesReport, err error)
+	ReportsGetMembershipFunc                           func(ctx context.Context, arg *DateRange) (res *GetMembershipReport, err error)
+	ReportsGetStorageFunc                              func(ctx context.Context, arg *DateRange) (res *GetStorageReport, err error)
+	TeamFolderActivateFunc                             func(ctx context.Context, arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error)
+	TeamFolderArchiveFunc                              func(ctx context.Context, arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error)
+	TeamFolderArchiveCheckFunc                         func(ctx context.Context, arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error)
+	TeamFolderCreateFunc                               func(ctx context.Context, arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error)
+	TeamFolderGetInfoFunc                              func(ctx context.Context, arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error)
+	TeamFolderListFunc                                 func(ctx context.Context, arg *TeamFolderListArg) (res *TeamFolderListResult, err error)
+	TeamFolderListContinueFunc                         func(ctx context.Context, arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error)
+	TeamFolderPermanentlyDeleteFunc                    func(ctx context.Context, arg *TeamFolderIdArg) (err error)
+	TeamFolderRenameFunc                               func(ctx context.Context, arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error)
+	TeamFolderUpdateSyncSettingsFunc                   func(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error)
+	TokenGetAuthenticatedAdminFunc                     func(ctx context.Context) (res *TokenGetAuthenticatedAdminResult, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
+	if m.DevicesListMemberDevicesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DevicesListMemberDevicesFunc(ctx, arg)
+}
+
+func (m *Mock) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
+	return m.DevicesListMemberDevicesContext(context.Background(), arg)
+}
+
+func (m *Mock) DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
+	if m.DevicesListMembersDevicesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DevicesListMembersDevicesFunc(ctx, arg)
+}
+
+func (m *Mock) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
+	return m.DevicesListMembersDevicesContext(context.Background(), arg)
+}
+
+func (m *Mock) DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
+	if m.DevicesListTeamDevicesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DevicesListTeamDevicesFunc(ctx, arg)
+}
+
+func (m *Mock) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
+	return m.DevicesListTeamDevicesContext(context.Background(), arg)
+}
+
+func (m *Mock) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg) (err error) {
+	if m.DevicesRevokeDeviceSessionFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DevicesRevokeDeviceSessionFunc(ctx, arg)
+}
+
+func (m *Mock) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error) {
+	return m.DevicesRevokeDeviceSessionContext(context.Background(), arg)
+}
+
+func (m *Mock) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
+	if m.DevicesRevokeDeviceSessionBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.DevicesRevokeDeviceSessionBatchFunc(ctx, arg)
+}
+
+func (m *Mock) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
+	return m.DevicesRevokeDeviceSessionBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
+	if m.FeaturesGetValuesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.FeaturesGetValuesFunc(ctx, arg)
+}
+
+func (m *Mock) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
+	return m.FeaturesGetValuesContext(context.Background(), arg)
+}
+
+func (m *Mock) GetInfoContext(ctx context.Context) (res *TeamGetInfoResult, err error) {
+	if m.GetInfoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetInfoFunc(ctx)
+}
+
+func (m *Mock) GetInfo() (res *TeamGetInfoResult, err error) {
+	return m.GetInfoContext(context.Background())
+}
+
+func (m *Mock) GroupsCreateContext(ctx context.Context, arg *GroupCreateArg) (res *GroupFullInfo, err error) {
+	if m.GroupsCreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsCreateFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error) {
+	return m.GroupsCreateContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsDeleteContext(ctx context.Context, arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	if m.GroupsDeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsDeleteFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
+	return m.GroupsDeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
+	if m.GroupsGetInfoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsGetInfoFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
+	return m.GroupsGetInfoContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	if m.GroupsJobStatusGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsJobStatusGetFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return m.GroupsJobStatusGetContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsListContext(ctx context.Context, arg *GroupsListArg) (res *GroupsListResult, err error) {
+	if m.GroupsListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsListFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error) {
+	return m.GroupsListContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
+	if m.GroupsListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
+	return m.GroupsListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
+	if m.GroupsMembersAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsMembersAddFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
+	return m.GroupsMembersAddContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
+	if m.GroupsMembersListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsMembersListFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
+	return m.GroupsMembersListContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
+	if m.GroupsMembersListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsMembersListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
+	return m.GroupsMembersListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
+	if m.GroupsMembersRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsMembersRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
+	return m.GroupsMembersRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
+	if m.GroupsMembersSetAccessTypeFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsMembersSetAccessTypeFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
+	return m.GroupsMembersSetAccessTypeContext(context.Background(), arg)
+}
+
+func (m *Mock) GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
+	if m.GroupsUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GroupsUpdateFunc(ctx, arg)
+}
+
+func (m *Mock) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
+	return m.GroupsUpdateContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsCreatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error) {
+	if m.LegalHoldsCreatePolicyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsCreatePolicyFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error) {
+	return m.LegalHoldsCreatePolicyContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsGetPolicyContext(ctx context.Context, arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error) {
+	if m.LegalHoldsGetPolicyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsGetPolicyFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error) {
+	return m.LegalHoldsGetPolicyContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsListHeldRevisionsContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+	if m.LegalHoldsListHeldRevisionsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsListHeldRevisionsFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return m.LegalHoldsListHeldRevisionsContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsListHeldRevisionsContinueContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+	if m.LegalHoldsListHeldRevisionsContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsListHeldRevisionsContinueFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return m.LegalHoldsListHeldRevisionsContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsListPoliciesContext(ctx context.Context, arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error) {
+	if m.LegalHoldsListPoliciesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsListPoliciesFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error) {
+	return m.LegalHoldsListPoliciesContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsReleasePolicyContext(ctx context.Context, arg *LegalHoldsPolicyReleaseArg) (err error) {
+	if m.LegalHoldsReleasePolicyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsReleasePolicyFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg) (err error) {
+	return m.LegalHoldsReleasePolicyContext(context.Background(), arg)
+}
+
+func (m *Mock) LegalHoldsUpdatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error) {
+	if m.LegalHoldsUpdatePolicyFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LegalHoldsUpdatePolicyFunc(ctx, arg)
+}
+
+func (m *Mock) LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error) {
+	return m.LegalHoldsUpdatePolicyContext(context.Background(), arg)
+}
+
+func (m *Mock) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
+	if m.LinkedAppsListMemberLinkedAppsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LinkedAppsListMemberLinkedAppsFunc(ctx, arg)
+}
+
+func (m *Mock) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
+	return m.LinkedAppsListMemberLinkedAppsContext(context.Background(), arg)
+}
+
+func (m *Mock) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
+	if m.LinkedAppsListMembersLinkedAppsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LinkedAppsListMembersLinkedAppsFunc(ctx, arg)
+}
+
+func (m *Mock) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
+	return m.LinkedAppsListMembersLinkedAppsContext(context.Background(), arg)
+}
+
+func (m *Mock) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
+	if m.LinkedAppsListTeamLinkedAppsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LinkedAppsListTeamLinkedAppsFunc(ctx, arg)
+}
+
+func (m *Mock) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
+	return m.LinkedAppsListTeamLinkedAppsContext(context.Background(), arg)
+}
+
+func (m *Mock) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg) (err error) {
+	if m.LinkedAppsRevokeLinkedAppFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LinkedAppsRevokeLinkedAppFunc(ctx, arg)
+}
+
+func (m *Mock) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error) {
+	return m.LinkedAppsRevokeLinkedAppContext(context.Background(), arg)
+}
+
+func (m *Mock) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
+	if m.LinkedAppsRevokeLinkedAppBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.LinkedAppsRevokeLinkedAppBatchFunc(ctx, arg)
+}
+
+func (m *Mock) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
+	return m.LinkedAppsRevokeLinkedAppBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersAddContext(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+	if m.MemberSpaceLimitsExcludedUsersAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsExcludedUsersAddFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+	return m.MemberSpaceLimitsExcludedUsersAddContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersListContext(ctx context.Context, arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error) {
+	if m.MemberSpaceLimitsExcludedUsersListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsExcludedUsersListFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error) {
+	return m.MemberSpaceLimitsExcludedUsersListContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersListContinueContext(ctx context.Context, arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error) {
+	if m.MemberSpaceLimitsExcludedUsersListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsExcludedUsersListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error) {
+	return m.MemberSpaceLimitsExcludedUsersListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersRemoveContext(ctx context.Context, arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+	if m.MemberSpaceLimitsExcludedUsersRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsExcludedUsersRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+	return m.MemberSpaceLimitsExcludedUsersRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsGetCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error) {
+	if m.MemberSpaceLimitsGetCustomQuotaFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsGetCustomQuotaFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error) {
+	return m.MemberSpaceLimitsGetCustomQuotaContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsRemoveCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error) {
+	if m.MemberSpaceLimitsRemoveCustomQuotaFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsRemoveCustomQuotaFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error) {
+	return m.MemberSpaceLimitsRemoveCustomQuotaContext(context.Background(), arg)
+}
+
+func (m *Mock) MemberSpaceLimitsSetCustomQuotaContext(ctx context.Context, arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error) {
+	if m.MemberSpaceLimitsSetCustomQuotaFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MemberSpaceLimitsSetCustomQuotaFunc(ctx, arg)
+}
+
+func (m *Mock) MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error) {
+	return m.MemberSpaceLimitsSetCustomQuotaContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersAddContext(ctx context.Context, arg *MembersAddArg) (res *MembersAddLaunch, err error) {
+	if m.MembersAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersAddFunc(ctx, arg)
+}
+
+func (m *Mock) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error) {
+	return m.MembersAddContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersAddV2Context(ctx context.Context, arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error) {
+	if m.MembersAddV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersAddV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersAddV2(arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error) {
+	return m.MembersAddV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatus, err error) {
+	if m.MembersAddJobStatusGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersAddJobStatusGetFunc(ctx, arg)
+}
+
+func (m *Mock) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error) {
+	return m.MembersAddJobStatusGetContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersAddJobStatusGetV2Context(ctx context.Context, arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error) {
+	if m.MembersAddJobStatusGetV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersAddJobStatusGetV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersAddJobStatusGetV2(arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error) {
+	return m.MembersAddJobStatusGetV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersDeleteProfilePhotoContext(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error) {
+	if m.MembersDeleteProfilePhotoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersDeleteProfilePhotoFunc(ctx, arg)
+}
+
+func (m *Mock) MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error) {
+	return m.MembersDeleteProfilePhotoContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersDeleteProfilePhotoV2Context(ctx context.Context, arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+	if m.MembersDeleteProfilePhotoV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersDeleteProfilePhotoV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+	return m.MembersDeleteProfilePhotoV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersGetAvailableTeamMemberRolesContext(ctx context.Context) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+	if m.MembersGetAvailableTeamMemberRolesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersGetAvailableTeamMemberRolesFunc(ctx)
+}
+
+func (m *Mock) MembersGetAvailableTeamMemberRoles() (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+	return m.MembersGetAvailableTeamMemberRolesContext(context.Background())
+}
+
+func (m *Mock) MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
+	if m.MembersGetInfoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersGetInfoFunc(ctx, arg)
+}
+
+func (m *Mock) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
+	return m.MembersGetInfoContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersGetInfoV2Context(ctx context.Context, arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error) {
+	if m.MembersGetInfoV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersGetInfoV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersGetInfoV2(arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error) {
+	return m.MembersGetInfoV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersListContext(ctx context.Context, arg *MembersListArg) (res *MembersListResult, err error) {
+	if m.MembersListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersListFunc(ctx, arg)
+}
+
+func (m *Mock) MembersList(arg *MembersListArg) (res *MembersListResult, err error) {
+	return m.MembersListContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersListV2Context(ctx context.Context, arg *MembersListArg) (res *MembersListV2Result, err error) {
+	if m.MembersListV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersListV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersListV2(arg *MembersListArg) (res *MembersListV2Result, err error) {
+	return m.MembersListV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg) (res *MembersListResult, err error) {
+	if m.MembersListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error) {
+	return m.MembersListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersListContinueV2Context(ctx context.Context, arg *MembersListContinueArg) (res *MembersListV2Result, err error) {
+	if m.MembersListContinueV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersListContinueV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersListContinueV2(arg *MembersListContinueArg) (res *MembersListV2Result, err error) {
+	return m.MembersListContinueV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersMoveFormerMemberFilesContext(ctx context.Context, arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error) {
+	if m.MembersMoveFormerMemberFilesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersMoveFormerMemberFilesFunc(ctx, arg)
+}
+
+func (m *Mock) MembersMoveFormerMemberFiles(arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error) {
+	return m.MembersMoveFormerMemberFilesContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersMoveFormerMemberFilesJobStatusCheckContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	if m.MembersMoveFormerMemberFilesJobStatusCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersMoveFormerMemberFilesJobStatusCheckFunc(ctx, arg)
+}
+
+func (m *Mock) MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return m.MembersMoveFormerMemberFilesJobStatusCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg) (err error) {
+	if m.MembersRecoverFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersRecoverFunc(ctx, arg)
+}
+
+func (m *Mock) MembersRecover(arg *MembersRecoverArg) (err error) {
+	return m.MembersRecoverContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	if m.MembersRemoveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersRemoveFunc(ctx, arg)
+}
+
+func (m *Mock) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+	return m.MembersRemoveContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	if m.MembersRemoveJobStatusGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersRemoveJobStatusGetFunc(ctx, arg)
+}
+
+func (m *Mock) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+	return m.MembersRemoveJobStatusGetContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsAddContext(ctx context.Context, arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error) {
+	if m.MembersSecondaryEmailsAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSecondaryEmailsAddFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error) {
+	return m.MembersSecondaryEmailsAddContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsDeleteContext(ctx context.Context, arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error) {
+	if m.MembersSecondaryEmailsDeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSecondaryEmailsDeleteFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error) {
+	return m.MembersSecondaryEmailsDeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsResendVerificationEmailsContext(ctx context.Context, arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error) {
+	if m.MembersSecondaryEmailsResendVerificationEmailsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSecondaryEmailsResendVerificationEmailsFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error) {
+	return m.MembersSecondaryEmailsResendVerificationEmailsContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg) (err error) {
+	if m.MembersSendWelcomeEmailFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSendWelcomeEmailFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
+	return m.MembersSendWelcomeEmailContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
+	if m.MembersSetAdminPermissionsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetAdminPermissionsFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
+	return m.MembersSetAdminPermissionsContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetAdminPermissionsV2Context(ctx context.Context, arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error) {
+	if m.MembersSetAdminPermissionsV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetAdminPermissionsV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error) {
+	return m.MembersSetAdminPermissionsV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
+	if m.MembersSetProfileFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetProfileFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
+	return m.MembersSetProfileContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetProfileV2Context(ctx context.Context, arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error) {
+	if m.MembersSetProfileV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetProfileV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersSetProfileV2(arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error) {
+	return m.MembersSetProfileV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetProfilePhotoContext(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error) {
+	if m.MembersSetProfilePhotoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetProfilePhotoFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error) {
+	return m.MembersSetProfilePhotoContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersSetProfilePhotoV2Context(ctx context.Context, arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+	if m.MembersSetProfilePhotoV2Func == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSetProfilePhotoV2Func(ctx, arg)
+}
+
+func (m *Mock) MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+	return m.MembersSetProfilePhotoV2Context(context.Background(), arg)
+}
+
+func (m *Mock) MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg) (err error) {
+	if m.MembersSuspendFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersSuspendFunc(ctx, arg)
+}
+
+func (m *Mock) MembersSuspend(arg *MembersDeactivateArg) (err error) {
+	return m.MembersSuspendContext(context.Background(), arg)
+}
+
+func (m *Mock) MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg) (err error) {
+	if m.MembersUnsuspendFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.MembersUnsuspendFunc(ctx, arg)
+}
+
+func (m *Mock) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
+	return m.MembersUnsuspendContext(context.Background(), arg)
+}
+
+func (m *Mock) NamespacesListContext(ctx context.Context, arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error) {
+	if m.NamespacesListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.NamespacesListFunc(ctx, arg)
+}
+
+func (m *Mock) NamespacesList(arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error) {
+	return m.NamespacesListContext(context.Background(), arg)
+}
+
+func (m *Mock) NamespacesListContinueContext(ctx context.Context, arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error) {
+	if m.NamespacesListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.NamespacesListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) NamespacesListContinue(arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error) {
+	return m.NamespacesListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesTemplateAddContext(ctx context.Context, arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error) {
+	if m.PropertiesTemplateAddFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateAddFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesTemplateAdd(arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error) {
+	return m.PropertiesTemplateAddContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+	if m.PropertiesTemplateGetFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateGetFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+	return m.PropertiesTemplateGetContext(context.Background(), arg)
+}
+
+func (m *Mock) PropertiesTemplateListContext(ctx context.Context) (res *file_properties.ListTemplateResult, err error) {
+	if m.PropertiesTemplateListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateListFunc(ctx)
+}
+
+func (m *Mock) PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error) {
+	return m.PropertiesTemplateListContext(context.Background())
+}
+
+func (m *Mock) PropertiesTemplateUpdateContext(ctx context.Context, arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error) {
+	if m.PropertiesTemplateUpdateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.PropertiesTemplateUpdateFunc(ctx, arg)
+}
+
+func (m *Mock) PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error) {
+	return m.PropertiesTemplateUpdateContext(context.Background(), arg)
+}
+
+func (m *Mock) ReportsGetActivityContext(ctx context.Context, arg *DateRange) (res *GetActivityReport, err error) {
+	if m.ReportsGetActivityFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ReportsGetActivityFunc(ctx, arg)
+}
+
+func (m *Mock) ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error) {
+	return m.ReportsGetActivityContext(context.Background(), arg)
+}
+
+func (m *Mock) ReportsGetDevicesContext(ctx context.Context, arg *DateRange) (res *GetDevicesReport, err error) {
+	if m.ReportsGetDevicesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ReportsGetDevicesFunc(ctx, arg)
+}
+
+func (m *Mock) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error) {
+	return m.ReportsGetDevicesContext(context.Background(), arg)
+}
+
+func (m *Mock) ReportsGetMembershipContext(ctx context.Context, arg *DateRange) (res *GetMembershipReport, err error) {
+	if m.ReportsGetMembershipFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ReportsGetMembershipFunc(ctx, arg)
+}
+
+func (m *Mock) ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error) {
+	return m.ReportsGetMembershipContext(context.Background(), arg)
+}
+
+func (m *Mock) ReportsGetStorageContext(ctx context.Context, arg *DateRange) (res *GetStorageReport, err error) {
+	if m.ReportsGetStorageFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.ReportsGetStorageFunc(ctx, arg)
+}
+
+func (m *Mock) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error) {
+	return m.ReportsGetStorageContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
+	if m.TeamFolderActivateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderActivateFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
+	return m.TeamFolderActivateContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
+	if m.TeamFolderArchiveFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderArchiveFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
+	return m.TeamFolderArchiveContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
+	if m.TeamFolderArchiveCheckFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderArchiveCheckFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
+	return m.TeamFolderArchiveCheckContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
+	if m.TeamFolderCreateFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderCreateFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
+	return m.TeamFolderCreateContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
+	if m.TeamFolderGetInfoFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderGetInfoFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
+	return m.TeamFolderGetInfoContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
+	if m.TeamFolderListFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderListFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
+	return m.TeamFolderListContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
+	if m.TeamFolderListContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderListContinueFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
+	return m.TeamFolderListContinueContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg) (err error) {
+	if m.TeamFolderPermanentlyDeleteFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderPermanentlyDeleteFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error) {
+	return m.TeamFolderPermanentlyDeleteContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
+	if m.TeamFolderRenameFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderRenameFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
+	return m.TeamFolderRenameContext(context.Background(), arg)
+}
+
+func (m *Mock) TeamFolderUpdateSyncSettingsContext(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error) {
+	if m.TeamFolderUpdateSyncSettingsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TeamFolderUpdateSyncSettingsFunc(ctx, arg)
+}
+
+func (m *Mock) TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error) {
+	return m.TeamFolderUpdateSyncSettingsContext(context.Background(), arg)
+}
+
+func (m *Mock) TokenGetAuthenticatedAdminContext(ctx context.Context) (res *TokenGetAuthenticatedAdminResult, err error) {
+	if m.TokenGetAuthenticatedAdminFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.TokenGetAuthenticatedAdminFunc(ctx)
+}
+
+func (m *Mock) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error) {
+	return m.TokenGetAuthenticatedAdminContext(context.Background())
+}
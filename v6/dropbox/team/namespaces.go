@@ -0,0 +1,61 @@
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// ListNamespaces returns the namespaces of the team, e.g. team folders and
+// member home folders, optionally only those of one of types, e.g.
+// NamespaceTypeTeamFolder. If a request fails, the namespaces listed so far
+// are returned along with the error. Namespaces listed twice by Dropbox are
+// only returned once.
+func ListNamespaces(ctx context.Context, client Client, types ...string) ([]*NamespaceMetadata, error) {
+	var res []*NamespaceMetadata
+	seen := make(map[string]bool)
+	it := NewNamespacesListIterator(client, NewTeamNamespacesListArg())
+	for it.HasMore() {
+		page, err := it.Next(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, ns := range page.Namespaces {
+			if seen[ns.NamespaceId] {
+				continue
+			}
+			seen[ns.NamespaceId] = true
+			if len(types) == 0 || (ns.NamespaceType != nil && containsString(types, ns.NamespaceType.Tag)) {
+				res = append(res, ns)
+			}
+		}
+	}
+	return res, nil
+}
+
+// NamespaceConfig returns a copy of the team config whose user routes act on
+// the namespace ns, with paths relative to its root. Namespaces owned by a
+// member are accessed as that member, the others as the admin adminID.
+func NamespaceConfig(config dropbox.Config, ns *NamespaceMetadata, adminID string) dropbox.Config {
+	if ns.TeamMemberId != "" {
+		config.AsMemberID = ns.TeamMemberId
+		config.AsAdminID = ""
+	} else {
+		config.AsMemberID = ""
+		config.AsAdminID = adminID
+	}
+	return config.WithNamespaceID(ns.NamespaceId)
+}
+
+// NamespaceFilesClient returns a files client acting on the namespace ns;
+// see NamespaceConfig.
+//
+//	namespaces, err := team.ListNamespaces(ctx, dbx, team.NamespaceTypeTeamFolder)
+//	for _, ns := range namespaces {
+//		res, err := team.NamespaceFilesClient(config, ns, adminID).ListFolder(files.NewListFolderArg(""))
+//		...
+//	}
+func NamespaceFilesClient(config dropbox.Config, ns *NamespaceMetadata, adminID string) files.Client {
+	return files.New(NamespaceConfig(config, ns, adminID))
+}
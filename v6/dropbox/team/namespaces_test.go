@@ -0,0 +1,63 @@
+package team_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestTeamNamespaces(t *testing.T) {
+	headers := make(map[string]http.Header)
+	ns := func(id string, typ string, member string) string {
+		return fmt.Sprintf(`{"name": "n%s", "namespace_id": %q, "namespace_type": {".tag": %q}, "team_member_id": %q}`, id, id, typ, member)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/namespaces/list":
+				_, _ = w.Write([]byte(`{"namespaces": [` + ns("1", "team_folder", "") + `,` + ns("2", "team_member_folder", "dbmid:2") + `], "cursor": "c1", "has_more": true}`))
+			case "/team/namespaces/list/continue":
+				_, _ = w.Write([]byte(`{"namespaces": [` + ns("2", "team_member_folder", "dbmid:2") + `,` + ns("3", "team_folder", "") + `], "cursor": "c2", "has_more": false}`))
+			case "/files/list_folder":
+				var arg files.ListFolderArg
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				headers[arg.Path] = r.Header
+				_, _ = w.Write([]byte(`{"entries": [], "cursor": "c", "has_more": false}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	all, err := team.ListNamespaces(context.Background(), team.New(config))
+	if err != nil || len(all) != 3 {
+		t.Fatalf("Unexpected namespaces: %v %v\n", all, err)
+	}
+	folders, err := team.ListNamespaces(context.Background(), team.New(config), team.NamespaceTypeTeamFolder)
+	if err != nil || len(folders) != 2 || folders[1].NamespaceId != "3" {
+		t.Errorf("Unexpected namespaces: %v %v\n", folders, err)
+	}
+	for _, n := range all[:2] {
+		if _, err = team.NamespaceFilesClient(config, n, "dbmid:admin").ListFolder(files.NewListFolderArg("/" + n.NamespaceId)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if h := headers["/1"]; h.Get("Dropbox-API-Select-Admin") != "dbmid:admin" || h.Get("Dropbox-API-Select-User") != "" ||
+		!strings.Contains(h.Get("Dropbox-API-Path-Root"), `"namespace_id": "1"`) {
+		t.Errorf("Unexpected headers: %v\n", h)
+	}
+	if h := headers["/2"]; h.Get("Dropbox-API-Select-User") != "dbmid:2" || h.Get("Dropbox-API-Select-Admin") != "" {
+		t.Errorf("Unexpected headers: %v\n", h)
+	}
+}
@@ -0,0 +1,99 @@
+package team
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"devices/list_member_devices":                         "sessions.list",
+	"devices/list_members_devices":                        "sessions.list",
+	"devices/list_team_devices":                           "sessions.list",
+	"devices/revoke_device_session":                       "sessions.modify",
+	"devices/revoke_device_session_batch":                 "sessions.modify",
+	"features/get_values":                                 "team_info.read",
+	"get_info":                                            "team_info.read",
+	"groups/create":                                       "groups.write",
+	"groups/delete":                                       "groups.write",
+	"groups/get_info":                                     "groups.read",
+	"groups/job_status/get":                               "groups.write",
+	"groups/list":                                         "groups.read",
+	"groups/list/continue":                                "groups.read",
+	"groups/members/add":                                  "groups.write",
+	"groups/members/list":                                 "groups.read",
+	"groups/members/list/continue":                        "groups.read",
+	"groups/members/remove":                               "groups.write",
+	"groups/members/set_access_type":                      "groups.write",
+	"groups/update":                                       "groups.write",
+	"legal_holds/create_policy":                           "team_data.governance.write",
+	"legal_holds/get_policy":                              "team_data.governance.write",
+	"legal_holds/list_held_revisions":                     "team_data.governance.write",
+	"legal_holds/list_held_revisions_continue":            "team_data.governance.write",
+	"legal_holds/list_policies":                           "team_data.governance.write",
+	"legal_holds/release_policy":                          "team_data.governance.write",
+	"legal_holds/update_policy":                           "team_data.governance.write",
+	"linked_apps/list_member_linked_apps":                 "sessions.list",
+	"linked_apps/list_members_linked_apps":                "sessions.list",
+	"linked_apps/list_team_linked_apps":                   "sessions.list",
+	"linked_apps/revoke_linked_app":                       "sessions.modify",
+	"linked_apps/revoke_linked_app_batch":                 "sessions.modify",
+	"member_space_limits/excluded_users/add":              "members.write",
+	"member_space_limits/excluded_users/list":             "members.read",
+	"member_space_limits/excluded_users/list/continue":    "members.read",
+	"member_space_limits/excluded_users/remove":           "members.write",
+	"member_space_limits/get_custom_quota":                "members.read",
+	"member_space_limits/remove_custom_quota":             "members.write",
+	"member_space_limits/set_custom_quota":                "members.write",
+	"members/add":                                         "members.write",
+	"members/add_v2":                                      "members.write",
+	"members/add/job_status/get":                          "members.write",
+	"members/add/job_status/get_v2":                       "members.write",
+	"members/delete_profile_photo":                        "members.write",
+	"members/delete_profile_photo_v2":                     "members.write",
+	"members/get_available_team_member_roles":             "members.read",
+	"members/get_info":                                    "members.read",
+	"members/get_info_v2":                                 "members.read",
+	"members/list":                                        "members.read",
+	"members/list_v2":                                     "members.read",
+	"members/list/continue":                               "members.read",
+	"members/list/continue_v2":                            "members.read",
+	"members/move_former_member_files":                    "members.write",
+	"members/move_former_member_files/job_status/check":   "members.write",
+	"members/recover":                                     "members.delete",
+	"members/remove":                                      "members.delete",
+	"members/remove/job_status/get":                       "members.delete",
+	"members/secondary_emails/add":                        "members.write",
+	"members/secondary_emails/delete":                     "members.write",
+	"members/secondary_emails/resend_verification_emails": "members.write",
+	"members/send_welcome_email":                          "members.write",
+	"members/set_admin_permissions":                       "members.write",
+	"members/set_admin_permissions_v2":                    "members.write",
+	"members/set_profile":                                 "members.write",
+	"members/set_profile_v2":                              "members.write",
+	"members/set_profile_photo":                           "members.write",
+	"members/set_profile_photo_v2":                        "members.write",
+	"members/suspend":                                     "members.write",
+	"members/unsuspend":                                   "members.write",
+	"namespaces/list":                                     "team_data.member",
+	"namespaces/list/continue":                            "team_data.member",
+	"properties/template/add":                             "files.team_metadata.write",
+	"properties/template/get":                             "files.team_metadata.write",
+	"properties/template/list":                            "files.team_metadata.write",
+	"properties/template/update":                          "files.team_metadata.write",
+	"reports/get_activity":                                "team_info.read",
+	"reports/get_devices":                                 "team_info.read",
+	"reports/get_membership":                              "team_info.read",
+	"reports/get_storage":                                 "team_info.read",
+	"team_folder/activate":                                "team_data.team_space",
+	"team_folder/archive":                                 "team_data.team_space",
+	"team_folder/archive/check":                           "team_data.team_space",
+	"team_folder/create":                                  "team_data.team_space",
+	"team_folder/get_info":                                "team_data.team_space",
+	"team_folder/list":                                    "team_data.team_space",
+	"team_folder/list/continue":                           "team_data.team_space",
+	"team_folder/permanently_delete":                      "team_data.team_space",
+	"team_folder/rename":                                  "team_data.team_space",
+	"team_folder/update_sync_settings":                    "team_data.team_space",
+	"token/get_authenticated_admin":                       "team_info.read",
+}
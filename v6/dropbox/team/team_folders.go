@@ -0,0 +1,122 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// TeamFolderArchiveFailedError is returned when a `team_folder/archive` job
+// failed.
+type TeamFolderArchiveFailedError struct {
+	Failure *TeamFolderArchiveError
+}
+
+func (e TeamFolderArchiveFailedError) Error() string {
+	return fmt.Sprintf("team folder archive failed: %s", e.Failure.Tag)
+}
+
+// TeamFolderManager creates, lists and updates the team folders of a team,
+// waiting for archive jobs to complete.
+//
+//	m := team.NewTeamFolderManager(dbx, nil)
+//	folder, err := m.Create(ctx, "Projects")
+//	...
+//	folder, err = m.Archive(ctx, folder.TeamFolderId)
+type TeamFolderManager struct {
+	client Client
+	opts   *async.JobOptions
+}
+
+// NewTeamFolderManager returns a TeamFolderManager using client, polling
+// archive jobs with opts.
+func NewTeamFolderManager(client Client, opts *async.JobOptions) *TeamFolderManager {
+	return &TeamFolderManager{client: client, opts: opts}
+}
+
+// Create creates an active team folder named name.
+func (m *TeamFolderManager) Create(ctx context.Context, name string) (*TeamFolderMetadata, error) {
+	return m.client.TeamFolderCreateContext(ctx, NewTeamFolderCreateArg(name))
+}
+
+// Rename renames the team folder id to name.
+func (m *TeamFolderManager) Rename(ctx context.Context, id string, name string) (*TeamFolderMetadata, error) {
+	return m.client.TeamFolderRenameContext(ctx, NewTeamFolderRenameArg(id, name))
+}
+
+// Activate makes the archived team folder id active again.
+func (m *TeamFolderManager) Activate(ctx context.Context, id string) (*TeamFolderMetadata, error) {
+	return m.client.TeamFolderActivateContext(ctx, NewTeamFolderIdArg(id))
+}
+
+// Archive archives the team folder id and waits for the archive to
+// complete. A failed archive job is reported with a
+// TeamFolderArchiveFailedError.
+func (m *TeamFolderManager) Archive(ctx context.Context, id string) (*TeamFolderMetadata, error) {
+	launch, err := m.client.TeamFolderArchiveContext(ctx, NewTeamFolderArchiveArg(id))
+	if err != nil {
+		return nil, err
+	}
+	if launch.Tag != TeamFolderArchiveLaunchAsyncJobId {
+		return launch.Complete, nil
+	}
+	v, err := async.Await(ctx, launch.AsyncJobId, m.opts, func(ctx context.Context, arg *async.PollArg) (interface{}, bool, error) {
+		status, err := m.client.TeamFolderArchiveCheckContext(ctx, arg)
+		if err != nil {
+			return nil, true, err
+		}
+		if status.Tag == TeamFolderArchiveJobStatusFailed && status.Failed != nil {
+			return nil, true, TeamFolderArchiveFailedError{Failure: status.Failed}
+		}
+		return status.Complete, status.Tag != TeamFolderArchiveJobStatusInProgress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	folder, _ := v.(*TeamFolderMetadata)
+	return folder, nil
+}
+
+// List returns all the team folders of the team, optionally only those with
+// one of statuses, e.g. TeamFolderStatusActive. If a request fails, the
+// folders listed so far are returned along with the error.
+func (m *TeamFolderManager) List(ctx context.Context, statuses ...string) ([]*TeamFolderMetadata, error) {
+	var folders []*TeamFolderMetadata
+	it := NewTeamFolderListIterator(m.client, NewTeamFolderListArg())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return folders, err
+		}
+		for _, f := range res.TeamFolders {
+			if len(statuses) == 0 || (f.Status != nil && containsString(statuses, f.Status.Tag)) {
+				folders = append(folders, f)
+			}
+		}
+	}
+	return folders, nil
+}
+
+// Find returns the team folder named name, or nil if there is none.
+func (m *TeamFolderManager) Find(ctx context.Context, name string) (*TeamFolderMetadata, error) {
+	folders, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range folders {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
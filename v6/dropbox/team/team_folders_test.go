@@ -0,0 +1,74 @@
+package team_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestTeamFolderManager(t *testing.T) {
+	checks := 0
+	folder := func(name string, status string) string {
+		return fmt.Sprintf(`{"team_folder_id": "tf:%s", "name": %q, "status": {".tag": %q}, "is_team_shared_dropbox": false}`, name, name, status)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/team_folder/create":
+				_, _ = w.Write([]byte(folder("Projects", "active")))
+			case "/team/team_folder/rename":
+				_, _ = w.Write([]byte(folder("Plans", "active")))
+			case "/team/team_folder/activate":
+				_, _ = w.Write([]byte(folder("Plans", "active")))
+			case "/team/team_folder/archive":
+				_, _ = w.Write([]byte(`{".tag": "async_job_id", "async_job_id": "job1"}`))
+			case "/team/team_folder/archive/check":
+				checks++
+				if checks == 1 {
+					_, _ = w.Write([]byte(`{".tag": "in_progress"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{".tag": "complete", ` + folder("Plans", "archived")[1:]))
+			case "/team/team_folder/list":
+				_, _ = w.Write([]byte(`{"team_folders": [` + folder("Plans", "archived") + `], "cursor": "c1", "has_more": true}`))
+			case "/team/team_folder/list/continue":
+				_, _ = w.Write([]byte(`{"team_folders": [` + folder("Other", "active") + `], "cursor": "c2", "has_more": false}`))
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	m := team.NewTeamFolderManager(team.New(config), &async.JobOptions{PollInterval: time.Millisecond})
+	ctx := context.Background()
+	f, err := m.Create(ctx, "Projects")
+	if err != nil || f.TeamFolderId != "tf:Projects" {
+		t.Fatalf("Unexpected result: %+v %v\n", f, err)
+	}
+	if f, err = m.Rename(ctx, f.TeamFolderId, "Plans"); err != nil || f.Name != "Plans" {
+		t.Fatalf("Unexpected result: %+v %v\n", f, err)
+	}
+	if f, err = m.Archive(ctx, f.TeamFolderId); err != nil || f.Status.Tag != team.TeamFolderStatusArchived || checks != 2 {
+		t.Fatalf("Unexpected result: %+v %v %d\n", f, err, checks)
+	}
+	active, err := m.List(ctx, team.TeamFolderStatusActive)
+	if err != nil || len(active) != 1 || active[0].Name != "Other" {
+		t.Errorf("Unexpected folders: %v %v\n", active, err)
+	}
+	if f, err = m.Find(ctx, "Plans"); err != nil || f == nil || f.Status.Tag != team.TeamFolderStatusArchived {
+		t.Errorf("Unexpected folder: %+v %v\n", f, err)
+	}
+	if f, err = m.Activate(ctx, f.TeamFolderId); err != nil || f.Status.Tag != team.TeamFolderStatusActive {
+		t.Errorf("Unexpected result: %+v %v\n", f, err)
+	}
+}
@@ -4325,15 +4325,10 @@ const (
 
 // UnmarshalJSON deserializes into a RevokeDeviceSessionArg instance
 func (u *RevokeDeviceSessionArg) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "web_session":
 		if err = json.Unmarshal(body, &u.WebSession); err != nil {
@@ -5352,15 +5347,10 @@ const (
 
 // UnmarshalJSON deserializes into a TeamMemberStatus instance
 func (u *TeamMemberStatus) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "removed":
 		if err = json.Unmarshal(body, &u.Removed); err != nil {
@@ -0,0 +1,301 @@
+package team_log
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+// Kinds of principals having access to a file or folder.
+const (
+	AccessKindUser    = "user"
+	AccessKindGroup   = "group"
+	AccessKindInvitee = "invitee"
+	AccessKindLink    = "link"
+)
+
+// Ways access to a file or folder is granted.
+const (
+	AccessViaDirect    = "direct"
+	AccessViaInherited = "inherited"
+	AccessViaGroup     = "group"
+	AccessViaLink      = "link"
+)
+
+// Access tells who has access to a file or folder and how.
+type Access struct {
+	Path string `json:"path"`
+	// Kind is one of the AccessKind constants.
+	Kind string `json:"kind"`
+	// Principal is the email of a user or invitee, the name of a group or
+	// the URL of a shared link.
+	Principal string `json:"principal"`
+	// Via is one of the AccessVia constants.
+	Via string `json:"via"`
+	// Level is the sharing.AccessLevel tag of a member, or the
+	// sharing.ResolvedVisibility tag of a link.
+	Level string `json:"level"`
+}
+
+// AuditReport lists who has access to a path, or what a member has access
+// to, along with the related sharing events of the audit log.
+type AuditReport struct {
+	Subject string       `json:"subject"`
+	Access  []*Access    `json:"access"`
+	Events  []*TeamEvent `json:"events,omitempty"`
+}
+
+// AuditClients holds the clients used to build an AuditReport. Files and
+// Sharing act as a team member, e.g. built with team.AsMember. TeamLog is
+// optional; without it, the report has no events.
+type AuditClients struct {
+	Files   files.Client
+	Sharing sharing.Client
+	TeamLog Client
+}
+
+// AuditOptions controls PathAuditReport and MemberAuditReport.
+type AuditOptions struct {
+	// Since limits the events to those that happened at or after Since.
+	Since time.Time
+}
+
+// PathAuditReport reports the users, groups and invitees who are members
+// of the file or folder at path, or of the shared folder containing it, and
+// its shared links, including those of its parent folders. With a team log
+// client, it adds the sharing events whose assets are in path.
+func PathAuditReport(ctx context.Context, clients *AuditClients, path string, opts *AuditOptions) (*AuditReport, error) {
+	if opts == nil {
+		opts = &AuditOptions{}
+	}
+	arg := files.NewGetMetadataArg(path)
+	arg.IncludeHasExplicitSharedMembers = true
+	md, err := clients.Files.GetMetadataContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	r := &AuditReport{Subject: path}
+	switch m := md.(type) {
+	case *files.FileMetadata:
+		r.Subject = m.PathDisplay
+		if m.SharingInfo != nil || m.HasExplicitSharedMembers {
+			if err = r.addFileMembers(ctx, clients.Sharing, m); err != nil {
+				return r, err
+			}
+		}
+	case *files.FolderMetadata:
+		r.Subject = m.PathDisplay
+		if m.SharingInfo != nil {
+			id := m.SharingInfo.SharedFolderId
+			if id == "" {
+				id = m.SharingInfo.ParentSharedFolderId
+			}
+			if id != "" {
+				if err = r.addFolderMembers(ctx, clients.Sharing, m.PathDisplay, id); err != nil {
+					return r, err
+				}
+			}
+		}
+	}
+
+	links := sharing.NewListSharedLinksArg()
+	links.Path = path
+	if err = r.addLinks(ctx, clients.Sharing, links); err != nil {
+		return r, err
+	}
+
+	if clients.TeamLog != nil {
+		prefix := strings.ToLower(r.Subject)
+		err = r.addEvents(ctx, clients.TeamLog, NewEventsQuery().Category(EventCategorySharing), opts, func(ev *TeamEvent) bool {
+			return eventInPath(ev, prefix)
+		})
+	}
+	return r, err
+}
+
+// MemberAuditReport reports the shared folders the member accountID is a
+// member of and the shared links they created, using clients acting as that
+// member. With a team log client, it adds the sharing events involving the
+// member.
+func MemberAuditReport(ctx context.Context, clients *AuditClients, accountID string, opts *AuditOptions) (*AuditReport, error) {
+	if opts == nil {
+		opts = &AuditOptions{}
+	}
+	r := &AuditReport{Subject: accountID}
+	it := sharing.NewListFoldersIterator(clients.Sharing, sharing.NewListFoldersArgs())
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return r, err
+		}
+		for _, f := range res.Entries {
+			path := f.PathLower
+			if path == "" {
+				path = f.Name
+			}
+			r.Access = append(r.Access, &Access{Path: path, Kind: AccessKindUser, Principal: accountID,
+				Via: AccessViaDirect, Level: accessLevel(f.AccessType)})
+		}
+	}
+
+	if err := r.addLinks(ctx, clients.Sharing, sharing.NewListSharedLinksArg()); err != nil {
+		return r, err
+	}
+
+	if clients.TeamLog == nil {
+		return r, nil
+	}
+	q := NewEventsQuery().Category(EventCategorySharing).Account(accountID)
+	return r, r.addEvents(ctx, clients.TeamLog, q, opts, nil)
+}
+
+// WriteJSON writes the report as JSON to w.
+func (r *AuditReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the access entries of the report as CSV to w, with a
+// header row. Events aren't written.
+func (r *AuditReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"path", "kind", "principal", "via", "level"}); err != nil {
+		return err
+	}
+	for _, a := range r.Access {
+		if err := cw.Write([]string{a.Path, a.Kind, a.Principal, a.Via, a.Level}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (r *AuditReport) addFolderMembers(ctx context.Context, client sharing.Client, path string, id string) error {
+	return sharing.WalkFolderMembers(ctx, client, id, func(res *sharing.SharedFolderMembers) error {
+		r.addMembers(path, res.Users, res.Groups, res.Invitees)
+		return nil
+	})
+}
+
+func (r *AuditReport) addFileMembers(ctx context.Context, client sharing.Client, m *files.FileMetadata) error {
+	return sharing.WalkFileMembers(ctx, client, m.Id, func(res *sharing.SharedFileMembers) error {
+		users := make([]*sharing.UserMembershipInfo, len(res.Users))
+		for i, u := range res.Users {
+			users[i] = &u.UserMembershipInfo
+		}
+		r.addMembers(m.PathDisplay, users, res.Groups, res.Invitees)
+		return nil
+	})
+}
+
+func (r *AuditReport) addMembers(path string, users []*sharing.UserMembershipInfo, groups []*sharing.GroupMembershipInfo, invitees []*sharing.InviteeMembershipInfo) {
+	for _, u := range users {
+		if u.User == nil {
+			continue
+		}
+		r.Access = append(r.Access, &Access{Path: path, Kind: AccessKindUser, Principal: u.User.Email,
+			Via: membershipVia(&u.MembershipInfo), Level: accessLevel(u.AccessType)})
+	}
+	for _, g := range groups {
+		if g.Group == nil {
+			continue
+		}
+		r.Access = append(r.Access, &Access{Path: path, Kind: AccessKindGroup, Principal: g.Group.GroupName,
+			Via: AccessViaGroup, Level: accessLevel(g.AccessType)})
+	}
+	for _, i := range invitees {
+		if i.Invitee == nil {
+			continue
+		}
+		r.Access = append(r.Access, &Access{Path: path, Kind: AccessKindInvitee, Principal: i.Invitee.Email,
+			Via: membershipVia(&i.MembershipInfo), Level: accessLevel(i.AccessType)})
+	}
+}
+
+func (r *AuditReport) addLinks(ctx context.Context, client sharing.Client, arg *sharing.ListSharedLinksArg) error {
+	it := sharing.NewListSharedLinksIterator(client, arg)
+	for it.HasMore() {
+		res, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range res.Links {
+			link := sharing.SharedLinkBase(l)
+			if link == nil {
+				continue
+			}
+			level := ""
+			if link.LinkPermissions != nil && link.LinkPermissions.ResolvedVisibility != nil {
+				level = link.LinkPermissions.ResolvedVisibility.Tag
+			}
+			r.Access = append(r.Access, &Access{Path: link.PathLower, Kind: AccessKindLink, Principal: link.Url,
+				Via: AccessViaLink, Level: level})
+		}
+	}
+	return nil
+}
+
+func (r *AuditReport) addEvents(ctx context.Context, client Client, q *EventsQuery, opts *AuditOptions, keep func(*TeamEvent) bool) error {
+	if !opts.Since.IsZero() {
+		q.Since(opts.Since)
+	}
+	it := NewEventIterator(client, q, nil)
+	for it.HasMore() {
+		ev, err := it.Next(ctx)
+		if err == dropbox.ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if keep == nil || keep(ev) {
+			r.Events = append(r.Events, ev)
+		}
+	}
+	return nil
+}
+
+func membershipVia(m *sharing.MembershipInfo) string {
+	if m.IsInherited {
+		return AccessViaInherited
+	}
+	return AccessViaDirect
+}
+
+func accessLevel(l *sharing.AccessLevel) string {
+	if l == nil {
+		return ""
+	}
+	return l.Tag
+}
+
+// eventInPath reports whether one of the file or folder assets of ev is at
+// or below the lower-cased path prefix.
+func eventInPath(ev *TeamEvent, prefix string) bool {
+	for _, a := range ev.Assets {
+		var p *PathLogInfo
+		switch {
+		case a.File != nil:
+			p = a.File.Path
+		case a.Folder != nil:
+			p = a.Folder.Path
+		}
+		if p == nil {
+			continue
+		}
+		path := strings.ToLower(p.Contextual)
+		if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package team_log_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_log"
+)
+
+func TestAuditReport(t *testing.T) {
+	var membersOf, linksOf string
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var arg map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&arg)
+			switch r.URL.Path {
+			case "/files/get_metadata":
+				_, _ = w.Write([]byte(`{".tag": "folder", "name": "Docs", "id": "id:1", "path_display": "/Team/Docs",
+					"sharing_info": {"read_only": false, "parent_shared_folder_id": "sf1", "traverse_only": false, "no_access": false}}`))
+			case "/sharing/list_folder_members":
+				membersOf, _ = arg["shared_folder_id"].(string)
+				_, _ = w.Write([]byte(`{"users": [{"access_type": {".tag": "owner"}, "is_inherited": false,
+					"user": {"account_id": "a1", "email": "ann@example.com", "display_name": "Ann", "same_team": true}}],
+					"groups": [{"access_type": {".tag": "editor"}, "is_inherited": false,
+					"group": {"group_name": "Sales", "group_id": "g1", "group_management_type": {".tag": "user_managed"},
+					"group_type": {".tag": "team"}, "is_member": false, "is_owner": false, "same_team": true}}],
+					"invitees": [{"access_type": {".tag": "viewer"}, "is_inherited": true, "invitee": {".tag": "email", "email": "bob@example.com"}}]}`))
+			case "/sharing/list_shared_links":
+				linksOf, _ = arg["path"].(string)
+				_, _ = w.Write([]byte(`{"links": [{".tag": "folder", "url": "https://db.tt/x", "name": "Docs", "path_lower": "/team/docs",
+					"link_permissions": {"can_revoke": true, "resolved_visibility": {".tag": "public"}}}], "has_more": false}`))
+			case "/team_log/get_events":
+				_, _ = w.Write([]byte(`{"events": [
+					{"timestamp": "2024-01-01T00:00:00Z", "event_category": {".tag": "sharing"}, "event_type": {".tag": "other"}, "details": {".tag": "other"},
+					"assets": [{".tag": "file", "path": {"contextual": "/Team/Docs/a.txt", "namespace_relative": {"is_shared_namespace": true}}, "display_name": "a.txt"}]},
+					{"timestamp": "2024-01-02T00:00:00Z", "event_category": {".tag": "sharing"}, "event_type": {".tag": "other"}, "details": {".tag": "other"},
+					"assets": [{".tag": "file", "path": {"contextual": "/Team/Docsx/b.txt", "namespace_relative": {"is_shared_namespace": true}}, "display_name": "b.txt"}]}],
+					"cursor": "c1", "has_more": false}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	clients := &team_log.AuditClients{Files: files.New(config), Sharing: sharing.New(config), TeamLog: team_log.New(config)}
+	r, err := team_log.PathAuditReport(context.Background(), clients, "/team/docs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if membersOf != "sf1" || linksOf != "/team/docs" || r.Subject != "/Team/Docs" {
+		t.Errorf("Unexpected requests: %v %v %v\n", membersOf, linksOf, r.Subject)
+	}
+	if len(r.Access) != 4 || len(r.Events) != 1 {
+		t.Fatalf("Unexpected report: %d %d\n", len(r.Access), len(r.Events))
+	}
+
+	var buf bytes.Buffer
+	if err = r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `path,kind,principal,via,level
+/Team/Docs,user,ann@example.com,direct,owner
+/Team/Docs,group,Sales,group,editor
+/Team/Docs,invitee,bob@example.com,inherited,viewer
+/team/docs,link,https://db.tt/x,link,public
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected CSV: %s\n", buf.String())
+	}
+	buf.Reset()
+	if err = r.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded team_log.AuditReport
+	if err = json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded.Access) != 4 {
+		t.Errorf("Unexpected JSON: %v %s\n", err, buf.String())
+	}
+}
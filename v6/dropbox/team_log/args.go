@@ -0,0 +1,38 @@
+// The With setters and Validate methods of the route arguments are
+// maintained by hand, as the SDK hasn't been regenerated since the generator
+// started emitting them into types.go. generate-sdk.sh removes this file
+// when regenerating the SDK.
+
+package team_log
+
+import "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_common"
+
+// WithLimit sets Limit and returns u
+func (u *GetTeamEventsArg) WithLimit(Limit uint32) *GetTeamEventsArg {
+	u.Limit = Limit
+	return u
+}
+
+// WithAccountId sets AccountId and returns u
+func (u *GetTeamEventsArg) WithAccountId(AccountId string) *GetTeamEventsArg {
+	u.AccountId = AccountId
+	return u
+}
+
+// WithTime sets Time and returns u
+func (u *GetTeamEventsArg) WithTime(Time *team_common.TimeRange) *GetTeamEventsArg {
+	u.Time = Time
+	return u
+}
+
+// WithCategory sets Category and returns u
+func (u *GetTeamEventsArg) WithCategory(Category *EventCategory) *GetTeamEventsArg {
+	u.Category = Category
+	return u
+}
+
+// WithEventType sets EventType and returns u
+func (u *GetTeamEventsArg) WithEventType(EventType *EventTypeArg) *GetTeamEventsArg {
+	u.EventType = EventType
+	return u
+}
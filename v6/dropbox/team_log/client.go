@@ -58,6 +58,11 @@ type GetEventsAPIError struct {
 	EndpointError *GetTeamEventsError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetEventsAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetEventsContext(ctx context.Context, arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -100,6 +105,11 @@ type GetEventsContinueAPIError struct {
 	EndpointError *GetTeamEventsContinueError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetEventsContinueAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetEventsContinueContext(ctx context.Context, arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -0,0 +1,180 @@
+package team_log
+
+import (
+	"context"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_common"
+)
+
+// maxEventsLimit is the maximum `limit` of `get_events`.
+const maxEventsLimit = 1000
+
+// DefaultEventPollInterval is the delay between two polls of an
+// EventIterator in follow mode, by default.
+const DefaultEventPollInterval = time.Minute
+
+// EventsQuery builds the argument of `get_events`:
+//
+//	q := team_log.NewEventsQuery().Since(start).Category(team_log.EventCategorySharing)
+//	it := team_log.NewEventIterator(dbx, q, &team_log.EventIteratorOptions{Follow: true})
+type EventsQuery struct {
+	arg *GetTeamEventsArg
+}
+
+// NewEventsQuery returns an EventsQuery matching all the events of the team.
+func NewEventsQuery() *EventsQuery {
+	return &EventsQuery{arg: NewGetTeamEventsArg()}
+}
+
+// Since matches the events that happened at or after t.
+func (q *EventsQuery) Since(t time.Time) *EventsQuery {
+	if q.arg.Time == nil {
+		q.arg.Time = team_common.NewTimeRange()
+	}
+	t = t.UTC()
+	q.arg.Time.StartTime = &t
+	return q
+}
+
+// Until matches the events that happened before t.
+func (q *EventsQuery) Until(t time.Time) *EventsQuery {
+	if q.arg.Time == nil {
+		q.arg.Time = team_common.NewTimeRange()
+	}
+	t = t.UTC()
+	q.arg.Time.EndTime = &t
+	return q
+}
+
+// Category matches the events of category, one of the EventCategory tags.
+// It replaces any event type set with EventType.
+func (q *EventsQuery) Category(category string) *EventsQuery {
+	q.arg.Category = &EventCategory{Tagged: dropbox.Tagged{Tag: category}}
+	q.arg.EventType = nil
+	return q
+}
+
+// EventType matches the events of type eventType, one of the EventTypeArg
+// tags. It replaces any category set with Category.
+func (q *EventsQuery) EventType(eventType string) *EventsQuery {
+	q.arg.EventType = &EventTypeArg{Tagged: dropbox.Tagged{Tag: eventType}}
+	q.arg.Category = nil
+	return q
+}
+
+// Account matches the events involving the account accountID as their
+// actor, context or participant.
+func (q *EventsQuery) Account(accountID string) *EventsQuery {
+	q.arg.AccountId = accountID
+	return q
+}
+
+// PageSize sets the number of events fetched per request, up to 1000.
+func (q *EventsQuery) PageSize(n uint32) *EventsQuery {
+	if n > maxEventsLimit {
+		n = maxEventsLimit
+	}
+	q.arg.Limit = n
+	return q
+}
+
+// Arg returns the built argument.
+func (q *EventsQuery) Arg() *GetTeamEventsArg {
+	return q.arg
+}
+
+// EventIteratorOptions controls an EventIterator.
+type EventIteratorOptions struct {
+	// Follow keeps polling for new events once all past events have been
+	// returned, until the context passed to Next is done.
+	Follow bool
+	// PollInterval is the delay between two polls in follow mode. Defaults
+	// to DefaultEventPollInterval.
+	PollInterval time.Duration
+}
+
+// EventIterator iterates over the events of the audit log, fetching the
+// pages of `get_events` and `get_events/continue` as needed.
+type EventIterator struct {
+	client       Client
+	arg          *GetTeamEventsArg
+	follow       bool
+	pollInterval time.Duration
+
+	cursor  string
+	started bool
+	hasMore bool
+	events  []*TeamEvent
+}
+
+// NewEventIterator returns a new EventIterator instance
+func NewEventIterator(client Client, q *EventsQuery, opts *EventIteratorOptions) *EventIterator {
+	if opts == nil {
+		opts = &EventIteratorOptions{}
+	}
+	it := &EventIterator{client: client, arg: q.Arg(), follow: opts.Follow, pollInterval: opts.PollInterval}
+	if it.pollInterval <= 0 {
+		it.pollInterval = DefaultEventPollInterval
+	}
+	return it
+}
+
+// ResumeEventIterator returns an EventIterator continuing from cursor, as
+// returned by Cursor.
+func ResumeEventIterator(client Client, cursor string, opts *EventIteratorOptions) *EventIterator {
+	it := NewEventIterator(client, NewEventsQuery(), opts)
+	it.cursor = cursor
+	it.started = true
+	it.hasMore = true
+	return it
+}
+
+// HasMore returns false once all events have been returned. It always
+// returns true in follow mode. As a page may be empty, Next can still return
+// `dropbox.ErrNoMorePages` after HasMore returned true.
+func (it *EventIterator) HasMore() bool {
+	return it.follow || len(it.events) > 0 || !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched, from which the
+// iteration can be resumed with ResumeEventIterator.
+func (it *EventIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next returns the next event, fetching the next page if needed. It returns
+// `dropbox.ErrNoMorePages` once all events have been returned, unless in
+// follow mode, where it waits for new events.
+func (it *EventIterator) Next(ctx context.Context) (*TeamEvent, error) {
+	for len(it.events) == 0 {
+		if it.started && !it.hasMore {
+			if !it.follow {
+				return nil, dropbox.ErrNoMorePages
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(it.pollInterval):
+			}
+		}
+		var res *GetTeamEventsResult
+		var err error
+		if !it.started {
+			res, err = it.client.GetEventsContext(ctx, it.arg)
+		} else {
+			res, err = it.client.GetEventsContinueContext(ctx, NewGetTeamEventsContinueArg(it.cursor))
+		}
+		if err != nil {
+			return nil, err
+		}
+		it.started = true
+		it.cursor = res.Cursor
+		it.hasMore = res.HasMore
+		it.events = res.Events
+	}
+	ev := it.events[0]
+	it.events = it.events[1:]
+	return ev, nil
+}
@@ -0,0 +1,77 @@
+package team_log_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_log"
+)
+
+func TestEventIterator(t *testing.T) {
+	var arg team_log.GetTeamEventsArg
+	continues := 0
+	event := func(ts string) string {
+		return fmt.Sprintf(`{"timestamp": %q, "event_category": {".tag": "sharing"}, "event_type": {".tag": "other"}, "details": {".tag": "other"}}`, ts)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team_log/get_events":
+				_ = json.NewDecoder(r.Body).Decode(&arg)
+				_, _ = w.Write([]byte(`{"events": [` + event("2024-01-01T00:00:00Z") + `], "cursor": "c1", "has_more": true}`))
+			case "/team_log/get_events/continue":
+				continues++
+				switch continues {
+				case 1:
+					_, _ = w.Write([]byte(`{"events": [` + event("2024-01-02T00:00:00Z") + `], "cursor": "c2", "has_more": false}`))
+				case 2:
+					_, _ = w.Write([]byte(`{"events": [], "cursor": "c3", "has_more": false}`))
+				default:
+					_, _ = w.Write([]byte(`{"events": [` + event("2024-01-03T00:00:00Z") + `], "cursor": "c4", "has_more": false}`))
+				}
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := team_log.NewEventsQuery().Since(start).Category(team_log.EventCategorySharing).PageSize(5000)
+	it := team_log.NewEventIterator(team_log.New(config), q, nil)
+	var n int
+	for it.HasMore() {
+		if _, err := it.Next(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 2 || it.Cursor() != "c2" {
+		t.Errorf("Unexpected events: %d %v\n", n, it.Cursor())
+	}
+	if arg.Limit != 1000 || arg.Category.Tag != team_log.EventCategorySharing || !arg.Time.StartTime.Equal(start) {
+		t.Errorf("Unexpected query: %+v\n", arg)
+	}
+
+	it = team_log.ResumeEventIterator(team_log.New(config), it.Cursor(), &team_log.EventIteratorOptions{Follow: true, PollInterval: time.Millisecond})
+	ev, err := it.Next(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Timestamp.Day() != 3 || continues != 3 || it.Cursor() != "c4" {
+		t.Errorf("Unexpected event: %v %d\n", ev.Timestamp, continues)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = it.Next(ctx); err != context.Canceled {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
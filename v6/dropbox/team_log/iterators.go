@@ -0,0 +1,79 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team_log
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// GetEventsIterator iterates over the pages returned by `GetEvents` and
+// `GetEventsContinue`.
+type GetEventsIterator struct {
+	client  Client
+	arg     *GetTeamEventsArg
+	cursor  string
+	started bool
+	hasMore bool
+}
+
+// NewGetEventsIterator returns a new GetEventsIterator instance.
+// A nil arg lists with the default arguments.
+func NewGetEventsIterator(client Client, arg *GetTeamEventsArg) *GetEventsIterator {
+	if arg == nil {
+		arg = NewGetTeamEventsArg()
+	}
+	return &GetEventsIterator{client: client, arg: arg}
+}
+
+// HasMore returns false once the last page has been fetched
+func (it *GetEventsIterator) HasMore() bool {
+	return !it.started || it.hasMore
+}
+
+// Cursor returns the cursor of the last page fetched
+func (it *GetEventsIterator) Cursor() string {
+	return it.cursor
+}
+
+// Next fetches the next page. It returns `dropbox.ErrNoMorePages` once
+// all pages have been fetched.
+func (it *GetEventsIterator) Next(ctx context.Context) (res *GetTeamEventsResult, err error) {
+	if !it.HasMore() {
+		err = dropbox.ErrNoMorePages
+		return
+	}
+	switch {
+	case !it.started:
+		res, err = it.client.GetEventsContext(ctx, it.arg)
+	default:
+		arg := NewGetTeamEventsContinueArg(it.cursor)
+		res, err = it.client.GetEventsContinueContext(ctx, arg)
+	}
+	if err != nil {
+		return
+	}
+	it.started = true
+	it.cursor = res.Cursor
+	it.hasMore = res.HasMore
+	return
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team_log
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	GetEventsFunc         func(ctx context.Context, arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error)
+	GetEventsContinueFunc func(ctx context.Context, arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) GetEventsContext(ctx context.Context, arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error) {
+	if m.GetEventsFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetEventsFunc(ctx, arg)
+}
+
+func (m *Mock) GetEvents(arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error) {
+	return m.GetEventsContext(context.Background(), arg)
+}
+
+func (m *Mock) GetEventsContinueContext(ctx context.Context, arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error) {
+	if m.GetEventsContinueFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetEventsContinueFunc(ctx, arg)
+}
+
+func (m *Mock) GetEventsContinue(arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error) {
+	return m.GetEventsContinueContext(context.Background(), arg)
+}
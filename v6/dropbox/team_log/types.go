@@ -22,6 +22,7 @@
 package team_log
 
 import (
+	"bytes"
 	"encoding/json"
 	"time"
 
@@ -989,15 +990,10 @@ const (
 
 // UnmarshalJSON deserializes into a appLogInfoUnion instance
 func (u *appLogInfoUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "user_or_team_linked_app":
 		if err = json.Unmarshal(body, &u.UserOrTeamLinkedApp); err != nil {
@@ -1035,7 +1031,33 @@ func IsAppLogInfoFromJSON(data []byte) (IsAppLogInfo, error) {
 		return t.TeamLinkedApp, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownAppLogInfo{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.AppLogInfo); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownAppLogInfo is a AppLogInfo whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsAppLogInfoFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownAppLogInfo struct {
+	AppLogInfo
+	// Tag is the unknown `.tag` of the AppLogInfo.
+	Tag string
+	// Raw is the JSON the AppLogInfo was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownAppLogInfo) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.AppLogInfo)
 }
 
 // AppPermissionsChangedDetails : Changed app permissions.
@@ -1209,15 +1231,10 @@ const (
 
 // UnmarshalJSON deserializes into a AssetLogInfo instance
 func (u *AssetLogInfo) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "file":
 		if err = json.Unmarshal(body, &u.File); err != nil {
@@ -1980,15 +1997,10 @@ const (
 
 // UnmarshalJSON deserializes into a ContextLogInfo instance
 func (u *ContextLogInfo) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "non_team_member":
 		if err = json.Unmarshal(body, &u.NonTeamMember); err != nil {
@@ -2262,15 +2274,10 @@ const (
 
 // UnmarshalJSON deserializes into a deviceSessionLogInfoUnion instance
 func (u *deviceSessionLogInfoUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "desktop_device_session":
 		if err = json.Unmarshal(body, &u.DesktopDeviceSession); err != nil {
@@ -2316,7 +2323,33 @@ func IsDeviceSessionLogInfoFromJSON(data []byte) (IsDeviceSessionLogInfo, error)
 		return t.LegacyDeviceSession, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownDeviceSessionLogInfo{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.DeviceSessionLogInfo); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownDeviceSessionLogInfo is a DeviceSessionLogInfo whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsDeviceSessionLogInfoFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownDeviceSessionLogInfo struct {
+	DeviceSessionLogInfo
+	// Tag is the unknown `.tag` of the DeviceSessionLogInfo.
+	Tag string
+	// Raw is the JSON the DeviceSessionLogInfo was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownDeviceSessionLogInfo) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.DeviceSessionLogInfo)
 }
 
 // DesktopDeviceSessionLogInfo : Information about linked Dropbox desktop client
@@ -2387,15 +2420,10 @@ const (
 
 // UnmarshalJSON deserializes into a sessionLogInfoUnion instance
 func (u *sessionLogInfoUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "web":
 		if err = json.Unmarshal(body, &u.Web); err != nil {
@@ -2433,7 +2461,33 @@ func IsSessionLogInfoFromJSON(data []byte) (IsSessionLogInfo, error) {
 		return t.Mobile, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownSessionLogInfo{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.SessionLogInfo); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownSessionLogInfo is a SessionLogInfo whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsSessionLogInfoFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownSessionLogInfo struct {
+	SessionLogInfo
+	// Tag is the unknown `.tag` of the SessionLogInfo.
+	Tag string
+	// Raw is the JSON the SessionLogInfo was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownSessionLogInfo) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.SessionLogInfo)
 }
 
 // DesktopSessionLogInfo : Desktop session.
@@ -5419,15 +5473,10 @@ const (
 
 // UnmarshalJSON deserializes into a EventDetails instance
 func (u *EventDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "admin_alerting_alert_state_changed_details":
 		if err = json.Unmarshal(body, &u.AdminAlertingAlertStateChangedDetails); err != nil {
@@ -9598,15 +9647,10 @@ const (
 
 // UnmarshalJSON deserializes into a EventType instance
 func (u *EventType) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "admin_alerting_alert_state_changed":
 		if err = json.Unmarshal(body, &u.AdminAlertingAlertStateChanged); err != nil {
@@ -12862,15 +12906,10 @@ const (
 
 // UnmarshalJSON deserializes into a FedExtraDetails instance
 func (u *FedExtraDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "organization":
 		if err = json.Unmarshal(body, &u.Organization); err != nil {
@@ -12924,15 +12963,10 @@ const (
 
 // UnmarshalJSON deserializes into a FederationStatusChangeAdditionalInfo instance
 func (u *FederationStatusChangeAdditionalInfo) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "connected_team_name":
 		if err = json.Unmarshal(body, &u.ConnectedTeamName); err != nil {
@@ -15996,15 +16030,10 @@ const (
 
 // UnmarshalJSON deserializes into a LinkedDeviceLogInfo instance
 func (u *LinkedDeviceLogInfo) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "desktop_device_session":
 		if err = json.Unmarshal(body, &u.DesktopDeviceSession); err != nil {
@@ -17341,15 +17370,10 @@ const (
 
 // UnmarshalJSON deserializes into a userLogInfoUnion instance
 func (u *userLogInfoUnion) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "team_member":
 		if err = json.Unmarshal(body, &u.TeamMember); err != nil {
@@ -17387,7 +17411,33 @@ func IsUserLogInfoFromJSON(data []byte) (IsUserLogInfo, error) {
 		return t.NonTeamMember, nil
 
 	}
-	return nil, nil
+	if t.Tag == "" && string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	unknown := &UnknownUserLogInfo{Tag: t.Tag, Raw: append(json.RawMessage(nil), data...)}
+	if err := json.Unmarshal(data, &unknown.UserLogInfo); err != nil {
+		return nil, err
+	}
+	return unknown, nil
+}
+
+// UnknownUserLogInfo is a UserLogInfo whose tag is unknown to this version of the
+// SDK, e.g. a subtype added to the API since, as returned by
+// IsUserLogInfoFromJSON. It keeps the common fields along with the raw JSON.
+type UnknownUserLogInfo struct {
+	UserLogInfo
+	// Tag is the unknown `.tag` of the UserLogInfo.
+	Tag string
+	// Raw is the JSON the UserLogInfo was decoded from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON returns Raw, or the common fields if Raw is empty
+func (u UnknownUserLogInfo) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) > 0 {
+		return u.Raw, nil
+	}
+	return json.Marshal(u.UserLogInfo)
 }
 
 // NonTeamMemberLogInfo : Non team member's logged information.
@@ -24250,15 +24300,10 @@ const (
 
 // UnmarshalJSON deserializes into a TeamMergeRequestAcceptedExtraDetails instance
 func (u *TeamMergeRequestAcceptedExtraDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "primary_team":
 		if err = json.Unmarshal(body, &u.PrimaryTeam); err != nil {
@@ -24409,15 +24454,10 @@ const (
 
 // UnmarshalJSON deserializes into a TeamMergeRequestCanceledExtraDetails instance
 func (u *TeamMergeRequestCanceledExtraDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "primary_team":
 		if err = json.Unmarshal(body, &u.PrimaryTeam); err != nil {
@@ -24542,15 +24582,10 @@ const (
 
 // UnmarshalJSON deserializes into a TeamMergeRequestExpiredExtraDetails instance
 func (u *TeamMergeRequestExpiredExtraDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "primary_team":
 		if err = json.Unmarshal(body, &u.PrimaryTeam); err != nil {
@@ -24729,15 +24764,10 @@ const (
 
 // UnmarshalJSON deserializes into a TeamMergeRequestReminderExtraDetails instance
 func (u *TeamMergeRequestReminderExtraDetails) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "primary_team":
 		if err = json.Unmarshal(body, &u.PrimaryTeam); err != nil {
@@ -25984,15 +26014,10 @@ const (
 
 // UnmarshalJSON deserializes into a WebSessionsFixedLengthPolicy instance
 func (u *WebSessionsFixedLengthPolicy) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "defined":
 		if err = json.Unmarshal(body, &u.Defined); err != nil {
@@ -26019,15 +26044,10 @@ const (
 
 // UnmarshalJSON deserializes into a WebSessionsIdleLengthPolicy instance
 func (u *WebSessionsIdleLengthPolicy) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "defined":
 		if err = json.Unmarshal(body, &u.Defined); err != nil {
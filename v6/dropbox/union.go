@@ -0,0 +1,185 @@
+package dropbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Union is implemented by the tagged unions of all namespaces, through the
+// Tagged they embed.
+type Union interface {
+	UnionTag() string
+}
+
+// UnionTag returns the tag of the union.
+func (t Tagged) UnionTag() string {
+	return t.Tag
+}
+
+// UnhandledTagError is returned by Switch for a tag without a case.
+type UnhandledTagError struct {
+	Tag string
+}
+
+func (e UnhandledTagError) Error() string {
+	return fmt.Sprintf("unhandled union tag %q", e.Tag)
+}
+
+// Cases maps the tags of unions of type U to their handlers.
+type Cases[U Union] map[string]func(u U) error
+
+// Switch calls the case of cases for the tag of u. A tag without a case,
+// including the tags the API added after the SDK was generated, whose
+// fields are all nil, is an UnhandledTagError rather than being ignored:
+//
+//	err := dropbox.Switch(status, dropbox.Cases[*files.RelocationBatchV2JobStatus]{
+//		files.RelocationBatchV2JobStatusInProgress: func(s *files.RelocationBatchV2JobStatus) error { ... },
+//		files.RelocationBatchV2JobStatusComplete:   func(s *files.RelocationBatchV2JobStatus) error { ... },
+//	})
+func Switch[U Union](u U, cases Cases[U]) error {
+	tag := u.UnionTag()
+	if f, ok := cases[tag]; ok && f != nil {
+		return f(u)
+	}
+	return UnhandledTagError{Tag: tag}
+}
+
+// Visit returns the result of the case of cases for the tag of u, or of
+// fallback for a tag without a case, so that unknown tags have to be
+// handled.
+func Visit[U Union, R any](u U, cases map[string]func(u U) R, fallback func(u U) R) R {
+	if f, ok := cases[u.UnionTag()]; ok && f != nil {
+		return f(u)
+	}
+	return fallback(u)
+}
+
+// DecodeTag returns the .tag of body, the JSON object of a union, as
+// json.Unmarshal into a Tagged would. It scans body without reflection or
+// allocations, which matters for the unions decoded in bulk such as
+// files.Metadata and team_log.EventDetails, and falls back to json.Unmarshal
+// for the objects it can't handle. Only the tag is scanned: the member of the
+// union is still decoded with json.Unmarshal, as the SDK doesn't generate
+// static marshalers.
+func DecodeTag(body []byte) (string, error) {
+	if tag, ok := scanTag(body); ok {
+		return tag, nil
+	}
+	var t Tagged
+	err := json.Unmarshal(body, &t)
+	return t.Tag, err
+}
+
+// scanTag returns the .tag of the JSON object body, or false if body isn't
+// an object or its tag needs unescaping.
+func scanTag(body []byte) (tag string, ok bool) {
+	i := skipSpace(body, 0)
+	if i >= len(body) || body[i] != '{' {
+		return "", false
+	}
+	i = skipSpace(body, i+1)
+	if i < len(body) && body[i] == '}' {
+		return "", true
+	}
+	for {
+		if i >= len(body) || body[i] != '"' {
+			return "", false
+		}
+		end, escaped := scanString(body, i)
+		if end < 0 || escaped {
+			return "", false
+		}
+		key := body[i+1 : end-1]
+		i = skipSpace(body, end)
+		if i >= len(body) || body[i] != ':' {
+			return "", false
+		}
+		i = skipSpace(body, i+1)
+		switch {
+		case string(key) == ".tag" && i < len(body) && body[i] == '"':
+			end, escaped = scanString(body, i)
+			if end < 0 || escaped {
+				return "", false
+			}
+			tag, i = string(body[i+1:end-1]), end
+		case bytes.EqualFold(key, []byte(".tag")) && !bytes.HasPrefix(body[i:], []byte("null")):
+			// json.Unmarshal matches keys case-insensitively, and fails on
+			// tags that aren't strings.
+			return "", false
+		default:
+			if i = skipValue(body, i); i < 0 {
+				return "", false
+			}
+		}
+		i = skipSpace(body, i)
+		if i >= len(body) {
+			return "", false
+		}
+		switch body[i] {
+		case ',':
+			i = skipSpace(body, i+1)
+		case '}':
+			return tag, true
+		default:
+			return "", false
+		}
+	}
+}
+
+// scanString returns the index following the JSON string starting at i, or
+// -1 if it isn't terminated, and whether it contains escapes.
+func scanString(b []byte, i int) (end int, escaped bool) {
+	for j := i + 1; j < len(b); j++ {
+		switch b[j] {
+		case '\\':
+			escaped = true
+			j++
+		case '"':
+			return j + 1, escaped
+		}
+	}
+	return -1, escaped
+}
+
+// skipValue returns the index following the JSON value starting at i, or -1
+// if it isn't terminated.
+func skipValue(b []byte, i int) int {
+	depth := 0
+	for i < len(b) {
+		switch b[i] {
+		case '"':
+			end, _ := scanString(b, i)
+			if end < 0 || depth == 0 {
+				return end
+			}
+			i = end
+			continue
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				return i
+			}
+			if depth--; depth == 0 {
+				return i + 1
+			}
+		case ',', ' ', '\t', '\n', '\r':
+			if depth == 0 {
+				return i
+			}
+		}
+		i++
+	}
+	if depth > 0 {
+		return -1
+	}
+	return i
+}
+
+func skipSpace(b []byte, i int) int {
+	for i < len(b) && (b[i] == ' ' || b[i] == '\t' || b[i] == '\n' || b[i] == '\r') {
+		i++
+	}
+	return i
+}
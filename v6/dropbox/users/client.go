@@ -60,6 +60,11 @@ type FeaturesGetValuesAPIError struct {
 	EndpointError *UserFeaturesGetValuesBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e FeaturesGetValuesAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) FeaturesGetValuesContext(ctx context.Context, arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -102,6 +107,11 @@ type GetAccountAPIError struct {
 	EndpointError *GetAccountError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetAccountAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetAccountContext(ctx context.Context, arg *GetAccountArg) (res *BasicAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -144,6 +154,11 @@ type GetAccountBatchAPIError struct {
 	EndpointError *GetAccountBatchError `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetAccountBatchAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -186,6 +201,11 @@ type GetCurrentAccountAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetCurrentAccountAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetCurrentAccountContext(ctx context.Context) (res *FullAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
@@ -228,6 +248,11 @@ type GetSpaceUsageAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
+// Unwrap returns the dropbox.APIError of the error
+func (e GetSpaceUsageAPIError) Unwrap() error {
+	return e.APIError
+}
+
 func (dbx *apiImpl) GetSpaceUsageContext(ctx context.Context) (res *SpaceUsage, err error) {
 	req := dropbox.Request{
 		Host:         "api",
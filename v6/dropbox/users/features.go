@@ -0,0 +1,92 @@
+package users
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// FeatureSet holds the values of the features of an account, as returned by
+// `features/get_values`.
+type FeatureSet struct {
+	// PaperAsFiles is true if Paper docs are stored as files in Dropbox.
+	PaperAsFiles bool
+	// FileLocking is true if the user can lock files in shared folders.
+	FileLocking bool
+}
+
+// GetFeatures returns the feature values of the current account.
+func GetFeatures(ctx context.Context, client Client) (*FeatureSet, error) {
+	arg := NewUserFeaturesGetValuesBatchArg([]*UserFeature{
+		{Tagged: dropbox.Tagged{Tag: UserFeatureFileLocking}},
+		{Tagged: dropbox.Tagged{Tag: UserFeaturePaperAsFiles}},
+	})
+	res, err := client.FeaturesGetValuesContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	features := &FeatureSet{}
+	for _, v := range res.Values {
+		switch v.Tag {
+		case UserFeatureValueFileLocking:
+			features.FileLocking = v.FileLocking != nil && v.FileLocking.Enabled
+		case UserFeatureValuePaperAsFiles:
+			features.PaperAsFiles = v.PaperAsFiles != nil && v.PaperAsFiles.Enabled
+		}
+	}
+	return features, nil
+}
+
+// IsPaperAsFiles reports whether the Paper docs of the current account are
+// stored as files, to be managed with the files routes rather than the
+// paper ones.
+func IsPaperAsFiles(ctx context.Context, client Client) (bool, error) {
+	features, err := GetFeatures(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	return features.PaperAsFiles, nil
+}
+
+// Allocated returns the space available to the account: its quota, or
+// its quota within the team space if the team limits it, otherwise the
+// team space. It is 0 if the allocation is unknown.
+func (u *SpaceUsage) Allocated() uint64 {
+	if u.Allocation == nil {
+		return 0
+	}
+	switch {
+	case u.Allocation.Individual != nil:
+		return u.Allocation.Individual.Allocated
+	case u.Allocation.Team != nil && u.Allocation.Team.UserWithinTeamSpaceAllocated > 0:
+		return u.Allocation.Team.UserWithinTeamSpaceAllocated
+	case u.Allocation.Team != nil:
+		return u.Allocation.Team.Allocated
+	}
+	return 0
+}
+
+// Percent returns the percentage of the space returned by Allocated that is
+// used. Against the team space, the space used by the whole team counts. It
+// is 0 if the allocation is unknown.
+func (u *SpaceUsage) Percent() float64 {
+	allocated := u.Allocated()
+	if allocated == 0 {
+		return 0
+	}
+	used := u.Used
+	if t := u.Allocation.Team; t != nil && t.UserWithinTeamSpaceAllocated == 0 {
+		used = t.Used
+	}
+	return float64(used) * 100 / float64(allocated)
+}
+
+// GetSpaceUsagePercent returns the percentage of the space available to
+// the current account that is used; see SpaceUsage.Percent.
+func GetSpaceUsagePercent(ctx context.Context, client Client) (float64, error) {
+	usage, err := client.GetSpaceUsageContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return usage.Percent(), nil
+}
@@ -0,0 +1,57 @@
+package users_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+)
+
+func TestUserFeatures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/users/features/get_values":
+				_, _ = w.Write([]byte(`{"values": [{".tag": "paper_as_files", "paper_as_files": {".tag": "enabled", "enabled": true}},
+					{".tag": "file_locking", "file_locking": {".tag": "enabled", "enabled": false}}]}`))
+			case "/users/get_space_usage":
+				_, _ = w.Write([]byte(`{"used": 250, "allocation": {".tag": "individual", "allocated": 1000}}`))
+			}
+		}))
+	defer srv.Close()
+
+	config := dropbox.Config{Client: srv.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(srv.URL, namespace, route)
+		}}
+	dbx := users.New(config)
+	features, err := users.GetFeatures(context.Background(), dbx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !features.PaperAsFiles || features.FileLocking {
+		t.Errorf("Unexpected features: %+v\n", features)
+	}
+	if percent, err := users.GetSpaceUsagePercent(context.Background(), dbx); err != nil || percent != 25 {
+		t.Errorf("Unexpected usage: %v %v\n", percent, err)
+	}
+
+	var team users.SpaceUsage
+	if err = json.Unmarshal([]byte(`{"used": 10, "allocation": {".tag": "team", "used": 600, "allocated": 1200,
+		"user_within_team_space_allocated": 0, "user_within_team_space_limit_type": {".tag": "off"},
+		"user_within_team_space_used_cached": 10}}`), &team); err != nil {
+		t.Fatal(err)
+	}
+	if team.Allocated() != 1200 || team.Percent() != 50 {
+		t.Errorf("Unexpected team usage: %v %v\n", team.Allocated(), team.Percent())
+	}
+	team.Allocation.Team.UserWithinTeamSpaceAllocated = 40
+	if team.Allocated() != 40 || team.Percent() != 25 {
+		t.Errorf("Unexpected member usage: %v %v\n", team.Allocated(), team.Percent())
+	}
+}
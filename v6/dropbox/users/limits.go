@@ -0,0 +1,22 @@
+package users
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// GetLimits returns the documented limits of the API together with the
+// feature values of the current account, as returned by `FeaturesGetValues`.
+func GetLimits(ctx context.Context, client Client) (*dropbox.Limits, error) {
+	features, err := GetFeatures(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := dropbox.DefaultLimits()
+	limits.FeaturesKnown = true
+	limits.FileLocking = features.FileLocking
+	limits.PaperAsFiles = features.PaperAsFiles
+	return &limits, nil
+}
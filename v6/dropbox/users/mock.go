@@ -0,0 +1,100 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package users
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Mock is a Client for tests. Each route calls the function field of the
+// same name with a Func suffix, for both the Context and the plain method;
+// routes whose field is nil return `dropbox.ErrNotMocked`.
+type Mock struct {
+	FeaturesGetValuesFunc func(ctx context.Context, arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error)
+	GetAccountFunc        func(ctx context.Context, arg *GetAccountArg) (res *BasicAccount, err error)
+	GetAccountBatchFunc   func(ctx context.Context, arg *GetAccountBatchArg) (res []*BasicAccount, err error)
+	GetCurrentAccountFunc func(ctx context.Context) (res *FullAccount, err error)
+	GetSpaceUsageFunc     func(ctx context.Context) (res *SpaceUsage, err error)
+}
+
+var _ Client = (*Mock)(nil)
+
+func (m *Mock) FeaturesGetValuesContext(ctx context.Context, arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error) {
+	if m.FeaturesGetValuesFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.FeaturesGetValuesFunc(ctx, arg)
+}
+
+func (m *Mock) FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error) {
+	return m.FeaturesGetValuesContext(context.Background(), arg)
+}
+
+func (m *Mock) GetAccountContext(ctx context.Context, arg *GetAccountArg) (res *BasicAccount, err error) {
+	if m.GetAccountFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetAccountFunc(ctx, arg)
+}
+
+func (m *Mock) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error) {
+	return m.GetAccountContext(context.Background(), arg)
+}
+
+func (m *Mock) GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
+	if m.GetAccountBatchFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetAccountBatchFunc(ctx, arg)
+}
+
+func (m *Mock) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
+	return m.GetAccountBatchContext(context.Background(), arg)
+}
+
+func (m *Mock) GetCurrentAccountContext(ctx context.Context) (res *FullAccount, err error) {
+	if m.GetCurrentAccountFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetCurrentAccountFunc(ctx)
+}
+
+func (m *Mock) GetCurrentAccount() (res *FullAccount, err error) {
+	return m.GetCurrentAccountContext(context.Background())
+}
+
+func (m *Mock) GetSpaceUsageContext(ctx context.Context) (res *SpaceUsage, err error) {
+	if m.GetSpaceUsageFunc == nil {
+		err = dropbox.ErrNotMocked
+		return
+	}
+	return m.GetSpaceUsageFunc(ctx)
+}
+
+func (m *Mock) GetSpaceUsage() (res *SpaceUsage, err error) {
+	return m.GetSpaceUsageContext(context.Background())
+}
@@ -0,0 +1,15 @@
+package users
+
+// RequiredScopes maps the routes of this namespace, as in
+// dropbox.Request.Route, to the scope an access token needs to call them.
+//
+// It is maintained by hand from the API reference, as the SDK hasn't been
+// regenerated since the generator started emitting it from the scope
+// attribute of the routes. Regenerating the SDK replaces this file.
+var RequiredScopes = map[string]string{
+	"features/get_values": "account_info.read",
+	"get_account":         "sharing.read",
+	"get_account_batch":   "sharing.read",
+	"get_current_account": "account_info.read",
+	"get_space_usage":     "account_info.read",
+}
@@ -450,15 +450,10 @@ const (
 
 // UnmarshalJSON deserializes into a SpaceAllocation instance
 func (u *SpaceAllocation) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
 	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
+	if u.Tag, err = dropbox.DecodeTag(body); err != nil {
 		return err
 	}
-	u.Tag = w.Tag
 	switch u.Tag {
 	case "individual":
 		if err = json.Unmarshal(body, &u.Individual); err != nil {
@@ -0,0 +1,111 @@
+package dropbox
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+)
+
+// ValidationError is returned, without sending the request, for an
+// argument breaking a constraint of the API spec, e.g. a path not starting
+// with a slash.
+type ValidationError struct {
+	Route string
+	// Field is the name of the field in the API spec, e.g. "path".
+	Field  string
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	if e.Route != "" {
+		return fmt.Sprintf("%s: invalid %s: %s", e.Route, e.Field, e.Reason)
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// Validator is implemented by the arguments of the routes with constraints
+// in the API spec. Context.Execute validates them before sending the
+// request.
+type Validator interface {
+	Validate() error
+}
+
+// Number is the type of the numeric fields of the generated types.
+type Number interface {
+	~int32 | ~int64 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+var patterns sync.Map
+
+// ValidateString checks the length, in characters, and the pattern of the
+// value of field. Negative lengths and an empty pattern aren't checked. The
+// pattern must match the whole value. A pattern that doesn't compile as RE2
+// is an error rather than skipped; the generator rejects such patterns of
+// the spec.
+func ValidateString(field string, value string, minLength int, maxLength int, pattern string) error {
+	n := utf8.RuneCountInString(value)
+	if minLength >= 0 && n < minLength {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("shorter than %d characters", minLength)}
+	}
+	if maxLength >= 0 && n > maxLength {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("longer than %d characters", maxLength)}
+	}
+	if pattern == "" {
+		return nil
+	}
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile(`^(?:` + pattern + `)$`)
+		if err != nil {
+			return fmt.Errorf("pattern of %s isn't a valid RE2 expression: %v", field, err)
+		}
+		re, _ = patterns.LoadOrStore(pattern, compiled)
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("%q doesn't match %s", value, pattern)}
+	}
+	return nil
+}
+
+// ValidateMin checks that the value of field is at least min.
+func ValidateMin[N Number](field string, value N, min N) error {
+	if value < min {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("%v is less than %v", value, min)}
+	}
+	return nil
+}
+
+// ValidateMax checks that the value of field is at most max.
+func ValidateMax[N Number](field string, value N, max N) error {
+	if value > max {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("%v is greater than %v", value, max)}
+	}
+	return nil
+}
+
+// ValidateItems checks the number of items n of the list field. Negative
+// bounds aren't checked.
+func ValidateItems(field string, n int, minItems int, maxItems int) error {
+	if minItems >= 0 && n < minItems {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("fewer than %d items", minItems)}
+	}
+	if maxItems >= 0 && n > maxItems {
+		return ValidationError{Field: field, Reason: fmt.Sprintf("more than %d items", maxItems)}
+	}
+	return nil
+}
+
+// validate validates the argument of req, if it is a Validator.
+func validate(req Request) error {
+	v, ok := req.Arg.(Validator)
+	if !ok {
+		return nil
+	}
+	err := v.Validate()
+	if vErr, ok := err.(ValidationError); ok {
+		vErr.Route = req.Namespace + "/" + req.Route
+		return vErr
+	}
+	return err
+}
@@ -0,0 +1,243 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// CursorStore persists the `list_folder` cursor of each account, so that a
+// ChangeProcessor resumes from where it stopped after a restart.
+type CursorStore interface {
+	// Load returns the cursor of the account, or "" if there is none.
+	Load(accountID string) (string, error)
+	// Save stores the cursor of the account, replacing the previous one.
+	Save(accountID string, cursor string) error
+}
+
+// MemoryCursorStore is a CursorStore keeping the cursors in memory.
+type MemoryCursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]string
+}
+
+// NewMemoryCursorStore returns an empty MemoryCursorStore.
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: map[string]string{}}
+}
+
+// Load returns the cursor of the account.
+func (s *MemoryCursorStore) Load(accountID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[accountID], nil
+}
+
+// Save stores the cursor of the account.
+func (s *MemoryCursorStore) Save(accountID string, cursor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[accountID] = cursor
+	return nil
+}
+
+// Change is an entry changed in an account.
+type Change struct {
+	AccountID string
+	// Path is the lower-cased path of the entry.
+	Path string
+	// Entry is the entry as returned by `list_folder/continue`, a
+	// *files.DeletedMetadata for a deletion.
+	Entry files.IsMetadata
+}
+
+// ChangeProcessor fetches the changes of the accounts notified by a
+// webhook and delivers them to Handle, keeping a cursor per account:
+//
+//	p := &webhook.ChangeProcessor{
+//		Client: func(accountID string) (files.Client, error) { ... },
+//		Store:  store,
+//		Handle: func(ctx context.Context, changes []webhook.Change) error { ... },
+//	}
+//	http.Handle("/webhook", p.Handler(appSecret))
+//
+// The cursor of an account is saved once Handle accepted the changes of a
+// page, so changes are delivered at least once: those of a page whose
+// handling failed are delivered again by the next fetch for the account.
+type ChangeProcessor struct {
+	// Client returns the client of the account, e.g. built with the access
+	// token stored for it.
+	Client func(accountID string) (files.Client, error)
+	// Store persists the cursors. It must be set.
+	Store CursorStore
+	// Path is the folder to follow, "" for the whole account.
+	Path string
+	// SkipExisting, for the accounts without a cursor, starts from the
+	// current state instead of delivering all existing entries as changes.
+	SkipExisting bool
+	// Handle is called with the changes of each page of results, in order,
+	// an entry changing several times in a page being delivered once, and
+	// the changes of the children of a folder deleted later in the page
+	// being dropped. Calls for an account never overlap.
+	Handle func(ctx context.Context, changes []Change) error
+	// OnError, if set, is called with the errors of the fetches started by
+	// Notify.
+	OnError func(accountID string, err error)
+
+	mu      sync.Mutex
+	pending map[string]bool
+	wg      sync.WaitGroup
+}
+
+// Handler returns a Handler notifying p of the accounts with changes.
+func (p *ChangeProcessor) Handler(appSecret string) *Handler {
+	return &Handler{AppSecret: appSecret, OnAccount: p.Notify}
+}
+
+// Notify fetches the changes of the account in the background. A
+// notification arriving while the changes of the account are being fetched
+// is coalesced into a single fetch once the current one is done.
+func (p *ChangeProcessor) Notify(accountID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending == nil {
+		p.pending = map[string]bool{}
+	}
+	if _, running := p.pending[accountID]; running {
+		p.pending[accountID] = true
+		return
+	}
+	p.pending[accountID] = false
+	p.wg.Add(1)
+	go p.run(accountID)
+}
+
+// Wait waits for the fetches started by Notify to be done.
+func (p *ChangeProcessor) Wait() {
+	p.wg.Wait()
+}
+
+func (p *ChangeProcessor) run(accountID string) {
+	defer p.wg.Done()
+	for {
+		if err := p.Process(context.Background(), accountID); err != nil && p.OnError != nil {
+			p.OnError(accountID, err)
+		}
+		p.mu.Lock()
+		if !p.pending[accountID] {
+			delete(p.pending, accountID)
+			p.mu.Unlock()
+			return
+		}
+		p.pending[accountID] = false
+		p.mu.Unlock()
+	}
+}
+
+// Process fetches and delivers the changes of the account since its
+// cursor. It must not be called concurrently for the same account, which
+// Notify takes care of.
+func (p *ChangeProcessor) Process(ctx context.Context, accountID string) error {
+	client, err := p.Client(accountID)
+	if err != nil {
+		return err
+	}
+	cursor, err := p.Store.Load(accountID)
+	if err != nil {
+		return err
+	}
+	var res *files.ListFolderResult
+	if cursor != "" {
+		res, err = client.ListFolderContinueContext(ctx, files.NewListFolderContinueArg(cursor))
+		if e, ok := err.(files.ListFolderContinueAPIError); ok && e.EndpointError != nil && e.EndpointError.Tag == files.ListFolderContinueErrorReset {
+			cursor = ""
+		}
+	}
+	if cursor == "" {
+		if p.SkipExisting {
+			latest, err := client.ListFolderGetLatestCursorContext(ctx, p.listArg())
+			if err != nil {
+				return err
+			}
+			return p.Store.Save(accountID, latest.Cursor)
+		}
+		res, err = client.ListFolderContext(ctx, p.listArg())
+	}
+	for {
+		if err != nil {
+			return err
+		}
+		if changes := dedup(accountID, res.Entries); len(changes) > 0 {
+			if err = p.Handle(ctx, changes); err != nil {
+				return err
+			}
+		}
+		if err = p.Store.Save(accountID, res.Cursor); err != nil {
+			return err
+		}
+		if !res.HasMore {
+			return nil
+		}
+		res, err = client.ListFolderContinueContext(ctx, files.NewListFolderContinueArg(res.Cursor))
+	}
+}
+
+func (p *ChangeProcessor) listArg() *files.ListFolderArg {
+	return files.NewListFolderArg(p.Path).WithRecursive(true).WithIncludeDeleted(true)
+}
+
+// dedup returns the changes of entries in order, an entry changing several
+// times being delivered once, at the position of its first change, so that
+// folders still come before their children. A deletion drops the earlier
+// changes of the entry and of its children, which it supersedes, and is
+// delivered before a later change recreating the entry, as the children
+// the entry had before the page are gone.
+func dedup(accountID string, entries []files.IsMetadata) []Change {
+	changes := make([]Change, 0, len(entries))
+	// live is the index in changes of the change of each path, unless it is
+	// a deletion.
+	live := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		path := pathLower(entry)
+		change := Change{AccountID: accountID, Path: path, Entry: entry}
+		if _, deleted := entry.(*files.DeletedMetadata); !deleted {
+			if i, ok := live[path]; ok {
+				changes[i] = change
+			} else {
+				live[path] = len(changes)
+				changes = append(changes, change)
+			}
+			continue
+		}
+		kept := changes[:0]
+		for _, c := range changes {
+			if c.Path != path && !strings.HasPrefix(c.Path, path+"/") {
+				kept = append(kept, c)
+			}
+		}
+		changes = append(kept, change)
+		live = make(map[string]int, len(entries))
+		for i, c := range changes {
+			if _, deleted := c.Entry.(*files.DeletedMetadata); !deleted {
+				live[c.Path] = i
+			}
+		}
+	}
+	return changes
+}
+
+func pathLower(m files.IsMetadata) string {
+	switch m := m.(type) {
+	case *files.FileMetadata:
+		return m.PathLower
+	case *files.FolderMetadata:
+		return m.PathLower
+	case *files.DeletedMetadata:
+		return m.PathLower
+	case *files.UnknownMetadata:
+		return m.PathLower
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/webhook"
+)
+
+func TestChangeProcessor(t *testing.T) {
+	file := func(path string, rev string) files.IsMetadata {
+		return &files.FileMetadata{Metadata: files.Metadata{PathLower: path}, Rev: rev}
+	}
+	pages := map[string]*files.ListFolderResult{
+		"c1": {Entries: []files.IsMetadata{&files.DeletedMetadata{Metadata: files.Metadata{PathLower: "/a"}}}, Cursor: "c2"},
+		"c2": {Entries: []files.IsMetadata{file("/c", "3")}, Cursor: "c3"},
+	}
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			if !arg.Recursive || !arg.IncludeDeleted {
+				t.Errorf("Unexpected arg: %+v\n", arg)
+			}
+			return &files.ListFolderResult{Entries: []files.IsMetadata{file("/a", "1"), &files.FolderMetadata{Metadata: files.Metadata{PathLower: "/b"}}, file("/a", "2")}, Cursor: "c1", HasMore: true}, nil
+		},
+		ListFolderContinueFunc: func(ctx context.Context, arg *files.ListFolderContinueArg) (*files.ListFolderResult, error) {
+			if res, ok := pages[arg.Cursor]; ok {
+				return res, nil
+			}
+			return nil, files.ListFolderContinueAPIError{EndpointError: &files.ListFolderContinueError{Tagged: dropbox.Tagged{Tag: files.ListFolderContinueErrorReset}}}
+		},
+	}
+	var mu sync.Mutex
+	var delivered []string
+	failures := 1
+	store := webhook.NewMemoryCursorStore()
+	p := &webhook.ChangeProcessor{
+		Client: func(accountID string) (files.Client, error) { return dbx, nil },
+		Store:  store,
+		Handle: func(ctx context.Context, changes []webhook.Change) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if changes[0].Path == "/c" && failures > 0 {
+				failures--
+				return errors.New("handler failed")
+			}
+			for _, c := range changes {
+				rev := ""
+				if f, ok := c.Entry.(*files.FileMetadata); ok {
+					rev = f.Rev
+				}
+				delivered = append(delivered, c.AccountID+":"+c.Path+rev)
+			}
+			return nil
+		},
+	}
+	var errs []error
+	p.OnError = func(accountID string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		p.Notify("dbid:x")
+		p.Wait()
+	}
+	want := []string{"dbid:x:/a2", "dbid:x:/b", "dbid:x:/a", "dbid:x:/c3"}
+	if cursor, _ := store.Load("dbid:x"); cursor != "c3" || len(errs) != 1 || !reflect.DeepEqual(delivered, want) {
+		t.Errorf("Unexpected changes: %v %v %v\n", cursor, delivered, errs)
+	}
+
+	// The reset cursor is replaced by listing again.
+	delivered = nil
+	p.Notify("dbid:x")
+	p.Wait()
+	if cursor, _ := store.Load("dbid:x"); cursor != "c2" || len(delivered) != 3 {
+		t.Errorf("Unexpected changes: %v %v\n", cursor, delivered)
+	}
+}
+
+func TestChangeProcessorDeletedFolder(t *testing.T) {
+	folder := func(path string, id string) files.IsMetadata {
+		return &files.FolderMetadata{Metadata: files.Metadata{PathLower: path}, Id: id}
+	}
+	dbx := &files.Mock{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{
+				folder("/a", "id:1"),
+				&files.FileMetadata{Metadata: files.Metadata{PathLower: "/a/b"}, Rev: "1"},
+				folder("/c", "id:2"),
+				&files.DeletedMetadata{Metadata: files.Metadata{PathLower: "/a"}},
+				&files.FileMetadata{Metadata: files.Metadata{PathLower: "/c/d"}, Rev: "1"},
+				folder("/a", "id:3"),
+				&files.FileMetadata{Metadata: files.Metadata{PathLower: "/a/e"}, Rev: "1"},
+				folder("/c", "id:4"),
+			}, Cursor: "c1"}, nil
+		},
+	}
+	var delivered []string
+	p := &webhook.ChangeProcessor{
+		Client: func(accountID string) (files.Client, error) { return dbx, nil },
+		Store:  webhook.NewMemoryCursorStore(),
+		Handle: func(ctx context.Context, changes []webhook.Change) error {
+			for _, c := range changes {
+				switch e := c.Entry.(type) {
+				case *files.FolderMetadata:
+					delivered = append(delivered, "folder "+c.Path+" "+e.Id)
+				case *files.FileMetadata:
+					delivered = append(delivered, "file "+c.Path)
+				case *files.DeletedMetadata:
+					delivered = append(delivered, "deleted "+c.Path)
+				}
+			}
+			return nil
+		},
+	}
+	if err := p.Process(context.Background(), "dbid:x"); err != nil {
+		t.Fatal(err)
+	}
+	// The deletion of /a drops /a/b, and the folders still come before
+	// their children.
+	want := []string{"folder /c id:4", "deleted /a", "file /c/d", "folder /a id:3", "file /a/e"}
+	if !reflect.DeepEqual(delivered, want) {
+		t.Errorf("Unexpected changes: %v\n", delivered)
+	}
+}
@@ -0,0 +1,155 @@
+// Package webhook receives the notifications Dropbox sends to the webhook
+// URI of an app when the files of its users change. Handler answers the
+// verification challenge, checks the signature of notifications against the
+// app secret and calls a function for every account with changes:
+//
+//	http.Handle("/webhook", &webhook.Handler{
+//		AppSecret: appSecret,
+//		OnAccount: func(accountID string) {
+//			// call files.ListFolderContinue with the cursor of the account
+//		},
+//	})
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader is the header holding the signature of notifications.
+const SignatureHeader = "X-Dropbox-Signature"
+
+// MaxBodySize is the size of the largest notification read by Parse.
+const MaxBodySize = 1 << 20
+
+// ErrInvalidSignature is returned for a notification whose signature doesn't
+// match its body, i.e. that wasn't sent by Dropbox.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrNoAppSecret is returned when notifications are verified without an app
+// secret, as anyone can sign a notification with an empty key.
+var ErrNoAppSecret = errors.New("webhook app secret is not set")
+
+// ListFolder lists the accounts with changes in their files.
+type ListFolder struct {
+	// Accounts are the IDs of the accounts, e.g. "dbid:AAH4f99T0taONIb-OurWxbNQ6ywGRopQngc".
+	Accounts []string `json:"accounts"`
+}
+
+// Notification is the body of a webhook notification.
+type Notification struct {
+	ListFolder *ListFolder `json:"list_folder,omitempty"`
+}
+
+// Accounts returns the IDs of the accounts with changes, without duplicates.
+func (n *Notification) Accounts() []string {
+	if n.ListFolder == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(n.ListFolder.Accounts))
+	var accounts []string
+	for _, id := range n.ListFolder.Accounts {
+		if !seen[id] {
+			seen[id] = true
+			accounts = append(accounts, id)
+		}
+	}
+	return accounts
+}
+
+// Sign returns the signature of body, the hex-encoded HMAC-SHA256 of body
+// keyed with appSecret.
+func Sign(appSecret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks signature, the value of the X-Dropbox-Signature header,
+// against body, returning ErrInvalidSignature if it doesn't match, and
+// ErrNoAppSecret if appSecret is empty.
+func Verify(appSecret string, body []byte, signature string) error {
+	if appSecret == "" {
+		return ErrNoAppSecret
+	}
+	if !hmac.Equal([]byte(Sign(appSecret, body)), []byte(strings.ToLower(signature))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Parse reads the notification of r, a POST to the webhook URI, and
+// verifies its signature. It returns ErrNoAppSecret if appSecret is empty.
+func Parse(r *http.Request, appSecret string) (*Notification, error) {
+	if appSecret == "" {
+		return nil, ErrNoAppSecret
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, MaxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	if err = Verify(appSecret, body, r.Header.Get(SignatureHeader)); err != nil {
+		return nil, err
+	}
+	n := &Notification{}
+	if err = json.Unmarshal(body, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Handler is an http.Handler for the webhook URI of an app. It answers the
+// GET requests verifying the URI by echoing their challenge, and calls
+// OnAccount for the accounts of the notifications POSTed to it.
+type Handler struct {
+	// AppSecret is the secret of the app, which signs the notifications.
+	// Notifications are rejected with a 500 if it is empty.
+	AppSecret string
+	// OnAccount is called with the ID of each account of a notification,
+	// in its own goroutine. Dropbox expects an answer within 10 seconds,
+	// so the handler doesn't wait for it to return.
+	OnAccount func(accountID string)
+	// OnError, if set, is called with the notifications rejected for an
+	// invalid signature or body.
+	OnError func(r *http.Request, err error)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write([]byte(r.URL.Query().Get("challenge")))
+	case http.MethodPost:
+		n, err := Parse(r, h.AppSecret)
+		if err != nil {
+			if h.OnError != nil {
+				h.OnError(r, err)
+			}
+			status := http.StatusBadRequest
+			switch err {
+			case ErrInvalidSignature:
+				status = http.StatusForbidden
+			case ErrNoAppSecret:
+				status = http.StatusInternalServerError
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		if h.OnAccount != nil {
+			for _, id := range n.Accounts() {
+				go h.OnAccount(id)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
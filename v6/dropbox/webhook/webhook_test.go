@@ -0,0 +1,81 @@
+package webhook_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/webhook"
+)
+
+func TestWebhook(t *testing.T) {
+	var mu sync.Mutex
+	var accounts []string
+	var wg sync.WaitGroup
+	h := &webhook.Handler{
+		AppSecret: "secret",
+		OnAccount: func(accountID string) {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			accounts = append(accounts, accountID)
+		},
+	}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "?challenge=abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(b) != "abc123" {
+		t.Errorf("Unexpected challenge: %s\n", b)
+	}
+
+	body := []byte(`{"list_folder": {"accounts": ["dbid:a", "dbid:b", "dbid:a"]}, "delta": {"users": [1, 2]}}`)
+	post := func(signature string) int {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL, bytes.NewReader(body))
+		req.Header.Set(webhook.SignatureHeader, signature)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if status := post(webhook.Sign("other", body)); status != http.StatusForbidden {
+		t.Errorf("Unexpected status: %d\n", status)
+	}
+	wg.Add(2)
+	if status := post(webhook.Sign("secret", body)); status != http.StatusOK {
+		t.Errorf("Unexpected status: %d\n", status)
+	}
+	wg.Wait()
+	sort.Strings(accounts)
+	if !reflect.DeepEqual(accounts, []string{"dbid:a", "dbid:b"}) {
+		t.Errorf("Unexpected accounts: %v\n", accounts)
+	}
+
+	// Without an app secret, anyone could sign notifications.
+	var rejected error
+	h.AppSecret = ""
+	h.OnError = func(r *http.Request, err error) { rejected = err }
+	if status := post(webhook.Sign("", body)); status != http.StatusInternalServerError || rejected != webhook.ErrNoAppSecret {
+		t.Errorf("Unexpected status: %d %v\n", status, rejected)
+	}
+	if err := webhook.Verify("", body, webhook.Sign("", body)); err != webhook.ErrNoAppSecret {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign("", body))
+	if _, err := webhook.Parse(req, ""); err != webhook.ErrNoAppSecret {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+}
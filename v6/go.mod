@@ -1,5 +1,12 @@
 module github.com/dropbox/dropbox-sdk-go-unofficial/v6
 
-go 1.13
+go 1.18
 
 require golang.org/x/oauth2 v0.7.0
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+)